@@ -26,7 +26,7 @@ type ATSJobPosting struct {
 	ID             string `json:"id"`
 	JobID          string `json:"job_id"`
 	Title          string `json:"title"`
-	Description    string `json:"description"`
+	Description    string `json:"description,omitempty"`
 	Department     string `json:"department"`
 	Location       string `json:"location"`
 	EmploymentType string `json:"employment_type"`
@@ -92,6 +92,7 @@ type ATSOffer struct {
 	ID          string  `json:"id"`
 	CandidateID string  `json:"candidate_id"`
 	Candidate   string  `json:"candidate_name"`
+	JobID       string  `json:"job_id,omitempty"`
 	Position    string  `json:"position"`
 	Status      string  `json:"status"`
 	Salary      float64 `json:"salary"`
@@ -143,6 +144,15 @@ type ATSCandidatesListParams struct {
 	Cursor string
 }
 
+// CreateATSCandidateParams are params for creating a candidate
+type CreateATSCandidateParams struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+	Phone     string `json:"phone,omitempty"`
+	Location  string `json:"location,omitempty"`
+}
+
 // ATSDepartmentsListParams are params for listing departments
 type ATSDepartmentsListParams struct {
 	Limit  int
@@ -163,6 +173,15 @@ type ATSOffersListParams struct {
 	Cursor string
 }
 
+// CreateATSOfferParams are params for creating an offer
+type CreateATSOfferParams struct {
+	CandidateID string  `json:"candidate_id"`
+	JobID       string  `json:"job_id"`
+	Salary      float64 `json:"salary"`
+	Currency    string  `json:"currency"`
+	StartDate   string  `json:"start_date,omitempty"`
+}
+
 // ATSOffersListResponse is the response from list offers
 type ATSOffersListResponse = ListResponse[ATSOffer]
 
@@ -210,6 +229,27 @@ func (c *Client) ListATSOffers(ctx context.Context, params ATSOffersListParams)
 	return decodeList[ATSOffer](resp)
 }
 
+// GetATSOffer returns a single offer by ID
+func (c *Client) GetATSOffer(ctx context.Context, id string) (*ATSOffer, error) {
+	path := fmt.Sprintf("/rest/v2/ats/offers/%s", escapePath(id))
+	resp, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeData[ATSOffer](resp)
+}
+
+// CreateATSOffer creates a new ATS offer
+func (c *Client) CreateATSOffer(ctx context.Context, params CreateATSOfferParams) (*ATSOffer, error) {
+	resp, err := c.Post(ctx, "/rest/v2/ats/offers", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeData[ATSOffer](resp)
+}
+
 // ListATSJobs returns ATS jobs
 func (c *Client) ListATSJobs(ctx context.Context, params ATSJobsListParams) (*ATSJobsListResponse, error) {
 	q := url.Values{}
@@ -353,6 +393,27 @@ func (c *Client) ListATSCandidates(ctx context.Context, params ATSCandidatesList
 	return decodeList[ATSCandidate](resp)
 }
 
+// GetATSCandidate returns a single candidate by ID
+func (c *Client) GetATSCandidate(ctx context.Context, id string) (*ATSCandidate, error) {
+	path := fmt.Sprintf("/rest/v2/ats/candidates/%s", escapePath(id))
+	resp, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeData[ATSCandidate](resp)
+}
+
+// CreateATSCandidate creates a new ATS candidate
+func (c *Client) CreateATSCandidate(ctx context.Context, params CreateATSCandidateParams) (*ATSCandidate, error) {
+	resp, err := c.Post(ctx, "/rest/v2/ats/candidates", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeData[ATSCandidate](resp)
+}
+
 // ListATSDepartments returns ATS departments
 func (c *Client) ListATSDepartments(ctx context.Context, params ATSDepartmentsListParams) (*ATSDepartmentsListResponse, error) {
 	q := url.Values{}
@@ -212,6 +212,55 @@ func TestListATSCandidates(t *testing.T) {
 	assert.Equal(t, "jane.smith@example.com", result.Data[0].Email)
 }
 
+func TestGetATSCandidate(t *testing.T) {
+	response := map[string]any{
+		"data": map[string]any{
+			"id":         "cand1",
+			"first_name": "Ada",
+			"last_name":  "Lovelace",
+			"email":      "ada@example.com",
+			"location":   "London",
+		},
+	}
+	server := mockServer(t, "GET", "/rest/v2/ats/candidates/cand1", http.StatusOK, response)
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.GetATSCandidate(context.Background(), "cand1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "cand1", result.ID)
+	assert.Equal(t, "Ada", result.FirstName)
+	assert.Equal(t, "London", result.Location)
+}
+
+func TestCreateATSCandidate(t *testing.T) {
+	server := mockServerWithBody(t, "POST", "/rest/v2/ats/candidates", func(t *testing.T, body map[string]any) {
+		assert.Equal(t, "Ada", body["first_name"])
+		assert.Equal(t, "Lovelace", body["last_name"])
+		assert.Equal(t, "ada@example.com", body["email"])
+		assert.NotContains(t, body, "phone")
+	}, http.StatusCreated, map[string]any{
+		"data": map[string]any{
+			"id":         "cand-new",
+			"first_name": "Ada",
+			"last_name":  "Lovelace",
+			"email":      "ada@example.com",
+		},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.CreateATSCandidate(context.Background(), CreateATSCandidateParams{
+		FirstName: "Ada",
+		LastName:  "Lovelace",
+		Email:     "ada@example.com",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "cand-new", result.ID)
+}
+
 func TestListATSDepartments(t *testing.T) {
 	response := map[string]any{
 		"data": []map[string]any{
@@ -410,3 +459,57 @@ func TestListATSOffers(t *testing.T) {
 	assert.Equal(t, "pending", result.Data[0].Status)
 	assert.Equal(t, 150000.00, result.Data[0].Salary)
 }
+
+func TestGetATSOffer(t *testing.T) {
+	response := map[string]any{
+		"data": map[string]any{
+			"id":             "offer1",
+			"candidate_id":   "cand1",
+			"candidate_name": "Alice Johnson",
+			"job_id":         "job1",
+			"position":       "Senior Engineer",
+			"status":         "pending",
+			"salary":         150000.00,
+			"currency":       "USD",
+		},
+	}
+	server := mockServer(t, "GET", "/rest/v2/ats/offers/offer1", http.StatusOK, response)
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.GetATSOffer(context.Background(), "offer1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "offer1", result.ID)
+	assert.Equal(t, "job1", result.JobID)
+	assert.Equal(t, 150000.00, result.Salary)
+}
+
+func TestCreateATSOffer(t *testing.T) {
+	server := mockServerWithBody(t, "POST", "/rest/v2/ats/offers", func(t *testing.T, body map[string]any) {
+		assert.Equal(t, "cand1", body["candidate_id"])
+		assert.Equal(t, "job1", body["job_id"])
+		assert.Equal(t, 120000.0, body["salary"])
+		assert.Equal(t, "EUR", body["currency"])
+		assert.Equal(t, "2026-11-01", body["start_date"])
+	}, http.StatusCreated, map[string]any{
+		"data": map[string]any{
+			"id":     "offer-new",
+			"status": "draft",
+		},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.CreateATSOffer(context.Background(), CreateATSOfferParams{
+		CandidateID: "cand1",
+		JobID:       "job1",
+		Salary:      120000,
+		Currency:    "EUR",
+		StartDate:   "2026-11-01",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "offer-new", result.ID)
+	assert.Equal(t, "draft", result.Status)
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// SetCache enables the on-disk response cache used by GetCached. Entries are
+// stored under dir and keyed by account and endpoint, so different accounts
+// never share cached data. A ttl <= 0 or an empty dir disables caching.
+func (c *Client) SetCache(dir, account string, ttl time.Duration) {
+	c.cacheDir = dir
+	c.cacheAccount = account
+	c.cacheTTL = ttl
+}
+
+// GetCached performs a GET request, serving the response from the on-disk cache
+// while the stored entry is fresh. Fresh responses are written back to the
+// cache for ttl, or for their Cache-Control max-age when they give one;
+// responses marked no-store or no-cache (or Pragma: no-cache) are not stored.
+// When caching is disabled (see SetCache) or ttl <= 0 it behaves like Get.
+// Cache read/write failures never fail the request.
+func (c *Client) GetCached(ctx context.Context, path string, ttl time.Duration) (json.RawMessage, error) {
+	if c.cacheDir == "" || ttl <= 0 {
+		return c.Get(ctx, path)
+	}
+
+	file := c.cacheFile(ctx, path)
+	if data, ok := readCacheEntry(file, ttl, time.Now()); ok {
+		if c.debug {
+			slog.Info("cache hit", "path", path)
+		}
+		return data, nil
+	}
+
+	resp, header, err := c.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	policy := parseCachePolicy(header)
+	if _, ok := policy.ttl(ttl); !ok {
+		if c.debug {
+			slog.Info("response not cacheable", "path", path, "cache_control", header.Get("Cache-Control"))
+		}
+		_ = os.Remove(file)
+		return resp, nil
+	}
+	data, err := json.Marshal(cacheEntry{MaxAge: int64(policy.maxAge / time.Second), Body: resp})
+	if err == nil {
+		err = writeCacheEntry(file, data)
+	}
+	if err != nil && c.debug {
+		slog.Info("cache write failed", "path", path, "error", err)
+	}
+	return resp, nil
+}
+
+// cacheEntry is one stored response. MaxAge, in seconds, is the response's
+// Cache-Control max-age; when zero the reader's ttl applies.
+type cacheEntry struct {
+	MaxAge int64           `json:"max_age,omitempty"`
+	Body   json.RawMessage `json:"body"`
+}
+
+// getLookup fetches a slow-changing lookup endpoint through the response cache.
+func (c *Client) getLookup(ctx context.Context, path string) (json.RawMessage, error) {
+	return c.GetCached(ctx, path, c.cacheTTL)
+}
+
+func (c *Client) cacheFile(ctx context.Context, path string) string {
+	sum := sha256.Sum256([]byte(c.cacheAccount + "\n" + c.baseURL + c.withServerFields(ctx, path)))
+	return filepath.Join(c.cacheDir, hex.EncodeToString(sum[:])+".json")
+}
+
+// readCacheEntry returns the body stored in file when it was written less
+// than its max-age, or ttl, before now.
+func readCacheEntry(file string, ttl time.Duration, now time.Time) (json.RawMessage, bool) {
+	info, err := os.Stat(file)
+	if err != nil {
+		return nil, false
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || !json.Valid(entry.Body) {
+		return nil, false
+	}
+	if entry.MaxAge > 0 {
+		ttl = time.Duration(entry.MaxAge) * time.Second
+	}
+	if now.Sub(info.ModTime()) >= ttl {
+		return nil, false
+	}
+	return entry.Body, true
+}
+
+// writeCacheEntry writes via a temp file and rename so concurrent readers never
+// see a partially written entry.
+func writeCacheEntry(file string, data json.RawMessage) error {
+	dir := filepath.Dir(file)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), file); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
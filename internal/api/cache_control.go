@@ -0,0 +1,72 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cachePolicy is what a response's Cache-Control and Pragma headers allow the
+// on-disk caches to do with it.
+type cachePolicy struct {
+	// noStore forbids keeping the response anywhere (Cache-Control: no-store).
+	noStore bool
+	// revalidate forbids serving the response without asking the API again
+	// (no-cache, Pragma: no-cache, or max-age=0). The ETag cache may keep it;
+	// the TTL cache may not.
+	revalidate bool
+	// maxAge is the response's own freshness lifetime (max-age), or 0 when it
+	// gives none and the --cache-ttl default applies.
+	maxAge time.Duration
+}
+
+// parseCachePolicy reads the directives that matter to a private cache.
+// Pragma: no-cache is only honoured when Cache-Control is absent, as HTTP/1.1
+// specifies.
+func parseCachePolicy(h http.Header) cachePolicy {
+	var p cachePolicy
+	cc := h.Values("Cache-Control")
+	if len(cc) == 0 {
+		for _, v := range h.Values("Pragma") {
+			if strings.EqualFold(strings.TrimSpace(v), "no-cache") {
+				p.revalidate = true
+			}
+		}
+		return p
+	}
+	for _, line := range cc {
+		for _, directive := range strings.Split(line, ",") {
+			name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			switch strings.ToLower(strings.TrimSpace(name)) {
+			case "no-store":
+				p.noStore = true
+			case "no-cache":
+				p.revalidate = true
+			case "max-age":
+				secs, err := strconv.Atoi(strings.Trim(strings.TrimSpace(value), `"`))
+				if err != nil || secs < 0 {
+					continue
+				}
+				if secs == 0 {
+					p.revalidate = true
+				}
+				p.maxAge = time.Duration(secs) * time.Second
+			}
+		}
+	}
+	return p
+}
+
+// ttl returns how long the TTL cache may serve the response, falling back to
+// the configured default when the response gives no max-age, and false when
+// it must not be stored at all.
+func (p cachePolicy) ttl(fallback time.Duration) (time.Duration, bool) {
+	if p.noStore || p.revalidate {
+		return 0, false
+	}
+	if p.maxAge > 0 {
+		return p.maxAge, true
+	}
+	return fallback, fallback > 0
+}
@@ -0,0 +1,218 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func countingServer(t *testing.T, hits *int32) *httptest.Server {
+	return cacheControlServer(t, hits, "")
+}
+
+// cacheControlServer is countingServer answering with the given Cache-Control
+// header (none when empty).
+func cacheControlServer(t *testing.T, hits *int32, cacheControl string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(hits, 1)
+		if cacheControl != "" {
+			w.Header().Set("Cache-Control", cacheControl)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": []map[string]any{{"code": "US", "name": "United States"}},
+		})
+	}))
+}
+
+func TestGetCached_ServesFreshEntry(t *testing.T) {
+	var hits int32
+	server := countingServer(t, &hits)
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetCache(t.TempDir(), "acme", time.Hour)
+
+	for i := 0; i < 3; i++ {
+		countries, err := client.ListCountries(context.Background())
+		require.NoError(t, err)
+		require.Len(t, countries, 1)
+		assert.Equal(t, "US", countries[0].Code)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+}
+
+func TestGetCached_RefetchesStaleEntry(t *testing.T) {
+	var hits int32
+	server := countingServer(t, &hits)
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetCache(t.TempDir(), "acme", time.Hour)
+
+	_, err := client.ListCountries(context.Background())
+	require.NoError(t, err)
+
+	old := time.Now().Add(-2 * time.Hour)
+	file := client.cacheFile(context.Background(), "/rest/v2/lookups/countries")
+	require.NoError(t, os.Chtimes(file, old, old))
+
+	_, err = client.ListCountries(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+
+	info, err := os.Stat(file)
+	require.NoError(t, err)
+	assert.True(t, info.ModTime().After(old), "stale entry should be rewritten")
+}
+
+func TestGetCached_DisabledByDefault(t *testing.T) {
+	var hits int32
+	server := countingServer(t, &hits)
+	defer server.Close()
+
+	client := testClient(server)
+	for i := 0; i < 2; i++ {
+		_, err := client.ListCountries(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+}
+
+func TestGetCached_KeyedByAccount(t *testing.T) {
+	var hits int32
+	server := countingServer(t, &hits)
+	defer server.Close()
+
+	dir := t.TempDir()
+	a := testClient(server)
+	a.SetCache(dir, "acme", time.Hour)
+	b := testClient(server)
+	b.SetCache(dir, "globex", time.Hour)
+
+	_, err := a.ListCountries(context.Background())
+	require.NoError(t, err)
+	_, err = b.ListCountries(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+	assert.NotEqual(t, a.cacheFile(context.Background(), "/rest/v2/lookups/countries"), b.cacheFile(context.Background(), "/rest/v2/lookups/countries"))
+}
+
+func TestGetCached_IgnoresCorruptEntry(t *testing.T) {
+	var hits int32
+	server := countingServer(t, &hits)
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetCache(t.TempDir(), "acme", time.Hour)
+	file := client.cacheFile(context.Background(), "/rest/v2/lookups/countries")
+	require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o700))
+	require.NoError(t, os.WriteFile(file, []byte(`{"data": [`), 0o600))
+
+	countries, err := client.ListCountries(context.Background())
+	require.NoError(t, err)
+	require.Len(t, countries, 1)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+}
+
+// ageCacheEntry backdates the cached countries entry by age.
+func ageCacheEntry(t *testing.T, client *Client, age time.Duration) {
+	t.Helper()
+	written := time.Now().Add(-age)
+	require.NoError(t, os.Chtimes(client.cacheFile(context.Background(), "/rest/v2/lookups/countries"), written, written))
+}
+
+func TestGetCached_NoStoreIsNotCached(t *testing.T) {
+	var hits int32
+	server := cacheControlServer(t, &hits, "private, no-store")
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetCache(t.TempDir(), "acme", time.Hour)
+
+	for i := 0; i < 2; i++ {
+		_, err := client.ListCountries(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+	assert.NoFileExists(t, client.cacheFile(context.Background(), "/rest/v2/lookups/countries"))
+}
+
+func TestGetCached_MaxAgeOverridesTTL(t *testing.T) {
+	var hits int32
+	server := cacheControlServer(t, &hits, "max-age=60")
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetCache(t.TempDir(), "acme", time.Hour)
+
+	_, err := client.ListCountries(context.Background())
+	require.NoError(t, err)
+
+	ageCacheEntry(t, client, 59*time.Second)
+	_, err = client.ListCountries(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits), "entry is fresh within max-age")
+
+	ageCacheEntry(t, client, 61*time.Second)
+	_, err = client.ListCountries(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits), "entry is stale after max-age, even within --cache-ttl")
+}
+
+func TestGetCached_NoCacheHeadersUseTTL(t *testing.T) {
+	var hits int32
+	server := countingServer(t, &hits)
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetCache(t.TempDir(), "acme", 10*time.Minute)
+
+	_, err := client.ListCountries(context.Background())
+	require.NoError(t, err)
+
+	ageCacheEntry(t, client, 9*time.Minute)
+	_, err = client.ListCountries(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+
+	ageCacheEntry(t, client, 11*time.Minute)
+	_, err = client.ListCountries(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+}
+
+func TestParseCachePolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   http.Header
+		ttl      time.Duration
+		storable bool
+	}{
+		{"none", http.Header{}, 5 * time.Minute, true},
+		{"no-store", http.Header{"Cache-Control": {"no-store"}}, 0, false},
+		{"no-cache", http.Header{"Cache-Control": {"No-Cache"}}, 0, false},
+		{"max-age", http.Header{"Cache-Control": {"public, max-age=60"}}, time.Minute, true},
+		{"max-age zero", http.Header{"Cache-Control": {"max-age=0"}}, 0, false},
+		{"bad max-age", http.Header{"Cache-Control": {"max-age=soon"}}, 5 * time.Minute, true},
+		{"pragma", http.Header{"Pragma": {"no-cache"}}, 0, false},
+		{"cache-control wins over pragma", http.Header{"Cache-Control": {"max-age=30"}, "Pragma": {"no-cache"}}, 30 * time.Second, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ttl, ok := parseCachePolicy(tt.header).ttl(5 * time.Minute)
+			assert.Equal(t, tt.storable, ok)
+			assert.Equal(t, tt.ttl, ttl)
+		})
+	}
+}
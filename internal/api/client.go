@@ -3,6 +3,7 @@ package api
 import (
 	"bytes"
 	"context"
+	crand "crypto/rand"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,7 +12,9 @@ import (
 	"math/rand/v2"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,8 +31,23 @@ const (
 	defaultMaxRetries    = 3
 	defaultBaseBackoff   = 1 * time.Second
 	defaultMaxBackoff    = 30 * time.Second
-	circuitBreakerLimit  = 5
-	circuitBreakerWindow = 30 * time.Second
+	defaultCircuitLimit  = 5
+	defaultCircuitWindow = 30 * time.Second
+)
+
+// RetryPolicy selects which requests are retried after a transport error or
+// a 5xx response. Rate-limited (429) responses are retried under every policy
+// because the server did not process them.
+type RetryPolicy int
+
+const (
+	// RetryIdempotent retries GET, HEAD, OPTIONS, PUT, and DELETE, and POST
+	// or PATCH only when an idempotency key is set. This is the default.
+	RetryIdempotent RetryPolicy = iota
+	// RetryAll retries every method.
+	RetryAll
+	// RetryNone never retries.
+	RetryNone
 )
 
 // Client is the Deel API client
@@ -42,11 +60,35 @@ type Client struct {
 	maxRetries     int
 	baseBackoff    time.Duration
 	maxBackoff     time.Duration
+	retryPolicy    RetryPolicy
+	serverFields   []string
 
-	// Circuit breaker state
+	// Circuit breaker settings and state
+	circuitLimit     int
+	circuitWindow    time.Duration
 	mu               sync.Mutex
 	consecutiveFails int
 	circuitOpenedAt  time.Time
+
+	// Idempotency keys sent with write requests, in first-sent order
+	// (guarded by mu)
+	sentKeys []SentIdempotencyKey
+
+	// Most recent X-RateLimit headers (guarded by mu)
+	rateLimit *RateLimitInfo
+
+	// Per-request tracing (see SetTrace; guarded by mu)
+	tracer       *slog.Logger
+	traceSummary TraceSummary
+
+	// On-disk cache for lookup endpoints (see SetCache)
+	cacheDir     string
+	cacheAccount string
+	cacheTTL     time.Duration
+
+	// Conditional GET store (see SetETagCache)
+	etagDir     string
+	etagAccount string
 }
 
 // NewClient creates a new Deel API client
@@ -66,6 +108,9 @@ func NewClient(token string) *Client {
 		maxRetries:  defaultMaxRetries,
 		baseBackoff: defaultBaseBackoff,
 		maxBackoff:  defaultMaxBackoff,
+
+		circuitLimit:  defaultCircuitLimit,
+		circuitWindow: defaultCircuitWindow,
 	}
 }
 
@@ -79,6 +124,28 @@ func (c *Client) SetIdempotencyKey(key string) {
 	c.idempotencyKey = key
 }
 
+// NewIdempotencyKey returns a random (version 4) UUID for use as an
+// idempotency key.
+func NewIdempotencyKey() string {
+	var b [16]byte
+	_, _ = crand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// ScopedIdempotencyKey returns a UUID-formatted idempotency key derived from
+// scope and the JSON encoding of params. The same scope and params always
+// give the same key, so a re-run is de-duplicated; a different scope gives a
+// different key, so intentionally distinct runs are not.
+func ScopedIdempotencyKey(scope string, params any) (string, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal params: %w", err)
+	}
+	return hashedUUID(scope, string(body)), nil
+}
+
 // SetTimeout sets the HTTP client timeout.
 func (c *Client) SetTimeout(timeout time.Duration) {
 	if timeout <= 0 {
@@ -87,6 +154,38 @@ func (c *Client) SetTimeout(timeout time.Duration) {
 	c.httpClient.Timeout = timeout
 }
 
+// SetProxy routes API requests through the given proxy. Supported schemes are
+// http, https, and socks5; credentials may be embedded as user:pass@host.
+func (c *Client) SetProxy(proxyURL string) error {
+	u, err := url.Parse(strings.TrimSpace(proxyURL))
+	if err != nil {
+		return fmt.Errorf("invalid proxy URL: %w", err)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return fmt.Errorf("invalid proxy URL %q: scheme must be http, https, or socks5", u.Redacted())
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid proxy URL %q: missing host", u.Redacted())
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyURL(u)
+	c.httpClient.Transport = transport
+	return nil
+}
+
+// WrapTransport replaces the client's transport with wrap(current). Call it
+// after SetProxy so the wrapper sees the proxied transport.
+func (c *Client) WrapTransport(wrap func(http.RoundTripper) http.RoundTripper) {
+	current := c.httpClient.Transport
+	if current == nil {
+		current = http.DefaultTransport
+	}
+	c.httpClient.Transport = wrap(current)
+}
+
 // SetRetryConfig configures retry/backoff for requests.
 func (c *Client) SetRetryConfig(maxRetries int, baseBackoff, maxBackoff time.Duration) {
 	if maxRetries < 0 {
@@ -101,6 +200,99 @@ func (c *Client) SetRetryConfig(maxRetries int, baseBackoff, maxBackoff time.Dur
 	}
 }
 
+// SetServerFields asks the API to return only the named fields on the GET
+// requests marked with WithServerFields, via a fields query parameter.
+// Endpoints that do not support it ignore the parameter and return the full
+// payload.
+func (c *Client) SetServerFields(fields []string) {
+	c.serverFields = nil
+	for _, field := range fields {
+		if field = strings.TrimSpace(field); field != "" {
+			c.serverFields = append(c.serverFields, field)
+		}
+	}
+}
+
+// serverFieldsKey is the context key for WithServerFields.
+type serverFieldsKey struct{}
+
+// WithServerFields marks the GET requests made with ctx as the command's
+// primary read, the one whose output --fields describes. Only marked reads
+// carry the SetServerFields allowlist; lookups and enrichment fetches made
+// along the way still receive full payloads. needed names the fields the
+// command itself reads from the response (to filter, count, or sort it);
+// they are added to the allowlist so the request never drops them.
+func WithServerFields(ctx context.Context, needed ...string) context.Context {
+	return context.WithValue(ctx, serverFieldsKey{}, needed)
+}
+
+// withServerFields appends the fields query parameter set by SetServerFields,
+// plus the fields ctx needs, to path when ctx is marked with
+// WithServerFields, unless path already carries one. The existing query is
+// kept byte for byte.
+func (c *Client) withServerFields(ctx context.Context, path string) string {
+	if len(c.serverFields) == 0 {
+		return path
+	}
+	needed, marked := ctx.Value(serverFieldsKey{}).([]string)
+	if !marked {
+		return path
+	}
+	fields := c.serverFields
+	for _, field := range needed {
+		if !slices.Contains(fields, field) {
+			fields = append(slices.Clip(fields), field)
+		}
+	}
+	_, rawQuery, hasQuery := strings.Cut(path, "?")
+	if q, err := url.ParseQuery(rawQuery); err != nil || q.Has("fields") {
+		return path
+	}
+	sep := "?"
+	if hasQuery && rawQuery != "" {
+		sep = "&"
+	} else if hasQuery {
+		sep = ""
+	}
+	return path + sep + "fields=" + url.QueryEscape(strings.Join(fields, ","))
+}
+
+// SetRetryPolicy overrides which requests are retried (default RetryIdempotent).
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.retryPolicy = policy
+}
+
+// retryable reports whether a failed request with the given method may be
+// sent again under the client's retry policy. POST and PATCH can create
+// duplicates when the server processed the request but the response was
+// lost, so by default they are only retried when an idempotency key lets the
+// server de-duplicate them.
+func (c *Client) retryable(method string) bool {
+	switch c.retryPolicy {
+	case RetryAll:
+		return true
+	case RetryNone:
+		return false
+	}
+	switch method {
+	case http.MethodPost, http.MethodPatch:
+		return c.idempotencyKey != ""
+	}
+	return true
+}
+
+// SetCircuitBreaker configures how many consecutive server failures open the
+// circuit breaker and how long it stays open. A limit <= 0 disables the breaker;
+// a non-positive window keeps the current window.
+func (c *Client) SetCircuitBreaker(limit int, window time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.circuitLimit = limit
+	if window > 0 {
+		c.circuitWindow = window
+	}
+}
+
 // SetBaseURL sets the base URL for API requests.
 // Note: For tests, prefer using testClient() from client_test.go
 // which handles this automatically.
@@ -134,19 +326,47 @@ func (c *Client) Delete(ctx context.Context, path string) (json.RawMessage, erro
 }
 
 func (c *Client) do(ctx context.Context, method, path string, body any) (json.RawMessage, error) {
+	if method == http.MethodGet {
+		resp, _, err := c.get(ctx, path)
+		return resp, err
+	}
 	url := c.baseURL + path
-	return c.doWithRetry(ctx, func() (*http.Response, error) {
-		return c.doRequest(ctx, method, url, body)
+	return c.doWithRetry(ctx, method, path, func() (*http.Response, error) {
+		return c.doRequest(ctx, method, url, body, nil)
 	}, nil)
 }
 
+// get performs a GET and also returns the headers of the final response, for
+// callers that honour Cache-Control.
+func (c *Client) get(ctx context.Context, path string) (json.RawMessage, http.Header, error) {
+	path = c.withServerFields(ctx, path)
+	if c.etagDir != "" {
+		return c.getConditional(ctx, path)
+	}
+	var last *http.Response
+	url := c.baseURL + path
+	body, err := c.doWithRetry(ctx, http.MethodGet, path, func() (*http.Response, error) {
+		resp, err := c.doRequest(ctx, http.MethodGet, url, nil, nil)
+		last = resp
+		return resp, err
+	}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return body, last.Header, nil
+}
+
 // doWithRetry executes an HTTP request function with retry logic, circuit breaker,
-// rate limit handling, and response processing. The optional onRetry callback is
-// called before each retry attempt (e.g., to reset seekable request bodies).
-func (c *Client) doWithRetry(ctx context.Context, reqFn func() (*http.Response, error), onRetry func() error) (json.RawMessage, error) {
+// rate limit handling, and response processing. method and path identify the
+// request for the retry policy and tracing; when the policy does not allow a
+// retry, transport errors and 5xx responses are returned without another
+// attempt. The optional onRetry callback is called before each retry attempt
+// (e.g., to reset seekable request bodies).
+func (c *Client) doWithRetry(ctx context.Context, method, path string, reqFn func() (*http.Response, error), onRetry func() error) (json.RawMessage, error) {
 	if err := c.checkCircuitBreaker(); err != nil {
 		return nil, err
 	}
+	retry := c.retryable(method)
 
 	var lastErr error
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
@@ -173,21 +393,31 @@ func (c *Client) doWithRetry(ctx context.Context, reqFn func() (*http.Response,
 			}
 		}
 
+		start := time.Now()
 		resp, err := reqFn()
 		if err != nil {
+			c.traceAttempt(method, path, attempt, 0, 0, start, err)
+			if !retry {
+				return nil, err
+			}
 			lastErr = err
 			continue
 		}
+		c.recordRateLimit(resp.Header)
 
 		// Handle rate limiting
 		if resp.StatusCode == http.StatusTooManyRequests {
-			retryAfter := c.parseRetryAfter(resp)
+			c.traceAttempt(method, path, attempt, resp.StatusCode, 0, start, nil)
+			retryAfter, retryErr := c.parseRetryAfter(resp)
 			if c.debug {
-				slog.Info("rate limited", "retry_after", retryAfter)
+				slog.Info("rate limited", "retry_after", retryAfter, "retry_after_header", resp.Header.Get("Retry-After"))
 			}
 			if err := resp.Body.Close(); err != nil {
 				slog.Debug("failed to close response body", "error", err)
 			}
+			if retryErr != nil {
+				return nil, retryErr
+			}
 			select {
 			case <-ctx.Done():
 				return nil, ctx.Err()
@@ -204,10 +434,14 @@ func (c *Client) doWithRetry(ctx context.Context, reqFn func() (*http.Response,
 			if err := resp.Body.Close(); err != nil {
 				slog.Debug("failed to close response body", "error", err)
 			}
+			c.traceAttempt(method, path, attempt, resp.StatusCode, len(errBody), start, nil)
 			if c.debug && len(errBody) > 0 {
 				slog.Info("server error response", "status", resp.StatusCode, "body", string(errBody))
 			}
 			lastErr = fmt.Errorf("server error: %d: %s", resp.StatusCode, string(errBody))
+			if !retry {
+				return nil, lastErr
+			}
 			continue
 		}
 
@@ -216,6 +450,7 @@ func (c *Client) doWithRetry(ctx context.Context, reqFn func() (*http.Response,
 
 		respBody, err := io.ReadAll(resp.Body)
 		closeErr := resp.Body.Close()
+		c.traceAttempt(method, path, attempt, resp.StatusCode, len(respBody), start, err)
 		if err != nil {
 			return nil, fmt.Errorf("failed to read response: %w", err)
 		}
@@ -240,7 +475,9 @@ func (c *Client) doWithRetry(ctx context.Context, reqFn func() (*http.Response,
 	return nil, fmt.Errorf("max retries exceeded: %w", lastErr)
 }
 
-func (c *Client) doRequest(ctx context.Context, method, url string, body any) (*http.Response, error) {
+// doRequest sends one request. header, when non-nil, adds headers on top of
+// the defaults (e.g. If-None-Match).
+func (c *Client) doRequest(ctx context.Context, method, url string, body any, header http.Header) (*http.Response, error) {
 	var bodyReader io.Reader
 	if body != nil {
 		bodyBytes, err := json.Marshal(body)
@@ -258,8 +495,15 @@ func (c *Client) doRequest(ctx context.Context, method, url string, body any) (*
 	req.Header.Set("Authorization", "Bearer "+c.token.Value())
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
-	if c.idempotencyKey != "" && method != http.MethodGet {
-		req.Header.Set("Idempotency-Key", c.idempotencyKey)
+	if method != http.MethodGet {
+		if key := c.idempotencyKeyFor(ctx); key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+	}
+	for name, values := range header {
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
 	}
 
 	if c.debug {
@@ -283,10 +527,14 @@ func (c *Client) calculateBackoff(attempt int) time.Duration {
 	return backoff + jitter
 }
 
-func (c *Client) parseRetryAfter(resp *http.Response) time.Duration {
+// parseRetryAfter returns how long to wait before retrying a 429. A missing or
+// malformed Retry-After header falls back to the base backoff. A wait longer
+// than maxBackoff (--retry-max) is not honored: sleeping for whatever the
+// server asks could hang the CLI, so that returns a rate-limit error instead.
+func (c *Client) parseRetryAfter(resp *http.Response) (time.Duration, error) {
 	retryAfter := resp.Header.Get("Retry-After")
 	if retryAfter == "" {
-		return c.baseBackoff
+		return c.baseBackoff, nil
 	}
 
 	var d time.Duration
@@ -298,17 +546,19 @@ func (c *Client) parseRetryAfter(resp *http.Response) time.Duration {
 		// Try parsing as HTTP date
 		d = time.Until(t)
 	} else {
-		return c.baseBackoff
+		return c.baseBackoff, nil
 	}
 
-	// Cap at maxBackoff to prevent a server from blocking us indefinitely
 	if d > c.maxBackoff {
-		d = c.maxBackoff
+		return 0, &APIError{
+			StatusCode: http.StatusTooManyRequests,
+			Message:    fmt.Sprintf("rate limited: server asked to retry after %s, longer than the %s retry cap (--retry-max)", d.Round(time.Second), c.maxBackoff),
+		}
 	}
 	if d < 0 {
 		d = c.baseBackoff
 	}
-	return d
+	return d, nil
 }
 
 func (c *Client) parseError(statusCode int, body []byte) error {
@@ -319,10 +569,10 @@ func (c *Client) parseError(statusCode int, body []byte) error {
 	}
 	if err := json.Unmarshal(body, &simpleErr); err == nil {
 		if simpleErr.Error != "" {
-			return &APIError{StatusCode: statusCode, Message: simpleErr.Error}
+			return &APIError{StatusCode: statusCode, Message: simpleErr.Error, Body: body}
 		}
 		if simpleErr.Message != "" {
-			return &APIError{StatusCode: statusCode, Message: simpleErr.Message}
+			return &APIError{StatusCode: statusCode, Message: simpleErr.Message, Body: body}
 		}
 	}
 
@@ -344,23 +594,23 @@ func (c *Client) parseError(statusCode int, body []byte) error {
 			}
 		}
 		if len(messages) == 1 {
-			return &APIError{StatusCode: statusCode, Message: messages[0]}
+			return &APIError{StatusCode: statusCode, Message: messages[0], Body: body}
 		}
 		if len(messages) > 1 {
-			return &APIError{StatusCode: statusCode, Message: fmt.Sprintf("%d errors: %v", len(messages), messages)}
+			return &APIError{StatusCode: statusCode, Message: fmt.Sprintf("%d errors: %v", len(messages), messages), Body: body}
 		}
 	}
 
-	return &APIError{StatusCode: statusCode, Message: string(body)}
+	return &APIError{StatusCode: statusCode, Message: string(body), Body: body}
 }
 
 func (c *Client) checkCircuitBreaker() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.consecutiveFails >= circuitBreakerLimit {
-		if time.Since(c.circuitOpenedAt) < circuitBreakerWindow {
-			return fmt.Errorf("circuit breaker open: too many consecutive failures")
+	if c.circuitTripped() {
+		if remaining := c.circuitWindow - time.Since(c.circuitOpenedAt); remaining > 0 {
+			return &CircuitOpenError{Failures: c.consecutiveFails, ResetIn: remaining}
 		}
 		// Reset circuit breaker
 		c.consecutiveFails = 0
@@ -368,12 +618,25 @@ func (c *Client) checkCircuitBreaker() error {
 	return nil
 }
 
+// CircuitOpen reports whether the circuit breaker is currently rejecting requests.
+func (c *Client) CircuitOpen() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.circuitTripped() && time.Since(c.circuitOpenedAt) < c.circuitWindow
+}
+
+// circuitTripped reports whether the failure count has reached the limit.
+// Callers must hold c.mu.
+func (c *Client) circuitTripped() bool {
+	return c.circuitLimit > 0 && c.consecutiveFails >= c.circuitLimit
+}
+
 func (c *Client) recordFailure() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	c.consecutiveFails++
-	if c.consecutiveFails >= circuitBreakerLimit {
+	if c.circuitTripped() {
 		c.circuitOpenedAt = time.Now()
 	}
 }
@@ -388,7 +651,7 @@ func (c *Client) recordSuccess() {
 // using the same retry logic, circuit breaker, and error handling as do().
 func (c *Client) doMultipart(ctx context.Context, method, path string, body io.Reader, contentType string) (json.RawMessage, error) {
 	url := c.baseURL + path
-	return c.doWithRetry(ctx, func() (*http.Response, error) {
+	return c.doWithRetry(ctx, method, path, func() (*http.Response, error) {
 		return c.doMultipartRequest(ctx, method, url, body, contentType)
 	}, func() error {
 		// For retries, we need to be able to re-read the body.
@@ -412,8 +675,10 @@ func (c *Client) doMultipartRequest(ctx context.Context, method, url string, bod
 	req.Header.Set("Authorization", "Bearer "+c.token.Value())
 	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("Accept", "application/json")
-	if c.idempotencyKey != "" && method != http.MethodGet {
-		req.Header.Set("Idempotency-Key", c.idempotencyKey)
+	if method != http.MethodGet {
+		if key := c.idempotencyKeyFor(ctx); key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
 	}
 
 	if c.debug {
@@ -423,12 +688,24 @@ func (c *Client) doMultipartRequest(ctx context.Context, method, url string, bod
 	return c.httpClient.Do(req)
 }
 
+// CircuitOpenError is returned while the circuit breaker rejects requests.
+type CircuitOpenError struct {
+	Failures int
+	ResetIn  time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker open after %d consecutive failures; requests resume in %s", e.Failures, e.ResetIn.Round(time.Second))
+}
+
 // APIError represents an API error response.
 //
 //revive:disable-next-line:exported
 type APIError struct {
 	StatusCode int
 	Message    string
+	// Body is the raw response body, when the error came from the API.
+	Body []byte
 }
 
 func (e *APIError) Error() string {
@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -158,3 +161,346 @@ func TestClient_Post_Success(t *testing.T) {
 	require.NoError(t, err)
 	assert.Contains(t, string(resp), "123")
 }
+
+func TestClient_CircuitOpen(t *testing.T) {
+	client := NewClient("test-token")
+	assert.False(t, client.CircuitOpen())
+
+	for i := 0; i < defaultCircuitLimit; i++ {
+		client.recordFailure()
+	}
+	assert.True(t, client.CircuitOpen())
+
+	client.recordSuccess()
+	assert.False(t, client.CircuitOpen())
+}
+
+func TestClient_SetCircuitBreaker_OpenAndClose(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetRetryConfig(0, time.Millisecond, time.Millisecond)
+	client.SetCircuitBreaker(2, 50*time.Millisecond)
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Get(context.Background(), "/test")
+		require.Error(t, err)
+	}
+	assert.True(t, client.CircuitOpen())
+
+	_, err := client.Get(context.Background(), "/test")
+	var openErr *CircuitOpenError
+	require.ErrorAs(t, err, &openErr)
+	assert.Equal(t, 2, openErr.Failures)
+	assert.Greater(t, openErr.ResetIn, time.Duration(0))
+	assert.LessOrEqual(t, openErr.ResetIn, 50*time.Millisecond)
+	assert.Contains(t, err.Error(), "requests resume in")
+	assert.Equal(t, 2, calls, "open breaker must not hit the server")
+
+	time.Sleep(60 * time.Millisecond)
+	assert.False(t, client.CircuitOpen())
+	_, err = client.Get(context.Background(), "/test")
+	require.Error(t, err)
+	assert.NotErrorAs(t, err, &openErr)
+	assert.Equal(t, 3, calls)
+}
+
+func TestClient_SetCircuitBreaker_Disabled(t *testing.T) {
+	client := NewClient("test-token")
+	client.SetCircuitBreaker(0, time.Minute)
+	for i := 0; i < 2*defaultCircuitLimit; i++ {
+		client.recordFailure()
+	}
+	assert.False(t, client.CircuitOpen())
+	assert.NoError(t, client.checkCircuitBreaker())
+}
+
+func TestClient_SetProxy_RoutesRequests(t *testing.T) {
+	var gotURL, gotAuth string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotURL = r.URL.String()
+		gotAuth = r.Header.Get("Proxy-Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":"via-proxy"}`))
+	}))
+	defer proxy.Close()
+
+	client := NewClient("test-token")
+	client.SetBaseURL("http://api.deel.invalid")
+	require.NoError(t, client.SetProxy(strings.Replace(proxy.URL, "http://", "http://user:secret@", 1)))
+
+	resp, err := client.Get(context.Background(), "/rest/v2/people")
+	require.NoError(t, err)
+	assert.Contains(t, string(resp), "via-proxy")
+	assert.Equal(t, "http://api.deel.invalid/rest/v2/people", gotURL)
+	assert.Equal(t, "Basic dXNlcjpzZWNyZXQ=", gotAuth)
+}
+
+func TestClient_SetProxy_Validation(t *testing.T) {
+	client := NewClient("test-token")
+
+	for _, valid := range []string{"http://proxy:8080", "https://proxy.example.com", "socks5://127.0.0.1:1080"} {
+		assert.NoError(t, client.SetProxy(valid), valid)
+	}
+
+	err := client.SetProxy("ftp://proxy:21")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "scheme must be http, https, or socks5")
+
+	err = client.SetProxy("http://user:secret@")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing host")
+	assert.NotContains(t, err.Error(), "secret")
+
+	assert.Error(t, client.SetProxy("://bad"))
+}
+
+func TestClient_Post_NotRetriedWithoutIdempotencyKey(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetRetryConfig(3, time.Millisecond, time.Millisecond)
+
+	_, err := client.Post(context.Background(), "/test", map[string]string{"key": "value"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "server error: 503")
+	assert.Equal(t, 1, calls, "POST without an idempotency key must not be retried")
+}
+
+func TestClient_RetryPolicy(t *testing.T) {
+	cases := []struct {
+		name      string
+		method    string
+		key       string
+		policy    RetryPolicy
+		wantCalls int
+	}{
+		{"get retried", http.MethodGet, "", RetryIdempotent, 3},
+		{"put retried", http.MethodPut, "", RetryIdempotent, 3},
+		{"delete retried", http.MethodDelete, "", RetryIdempotent, 3},
+		{"patch without key", http.MethodPatch, "", RetryIdempotent, 1},
+		{"post with key", http.MethodPost, "idem-1", RetryIdempotent, 3},
+		{"post with retry all", http.MethodPost, "", RetryAll, 3},
+		{"get with retry none", http.MethodGet, "", RetryNone, 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.WriteHeader(http.StatusBadGateway)
+			}))
+			defer server.Close()
+
+			client := testClient(server)
+			client.SetRetryConfig(2, time.Millisecond, time.Millisecond)
+			client.SetCircuitBreaker(0, 0)
+			client.SetIdempotencyKey(tc.key)
+			client.SetRetryPolicy(tc.policy)
+
+			_, err := client.do(context.Background(), tc.method, "/test", nil)
+			require.Error(t, err)
+			assert.Equal(t, tc.wantCalls, calls)
+		})
+	}
+}
+
+func TestNewIdempotencyKey(t *testing.T) {
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	a, b := NewIdempotencyKey(), NewIdempotencyKey()
+	assert.Regexp(t, uuid, a)
+	assert.NotEqual(t, a, b)
+}
+
+func TestScopedIdempotencyKey(t *testing.T) {
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	params := CreateContractParams{Title: "Test", Type: "payg_tasks", WorkerEmail: "a@b.co"}
+
+	a, err := ScopedIdempotencyKey("build-1", params)
+	require.NoError(t, err)
+	assert.Regexp(t, uuid, a)
+
+	again, err := ScopedIdempotencyKey("build-1", params)
+	require.NoError(t, err)
+	assert.Equal(t, a, again, "same scope and params give the same key")
+
+	other, err := ScopedIdempotencyKey("build-2", params)
+	require.NoError(t, err)
+	assert.NotEqual(t, a, other, "different scopes give different keys")
+
+	params.Title = "Other"
+	changed, err := ScopedIdempotencyKey("build-1", params)
+	require.NoError(t, err)
+	assert.NotEqual(t, a, changed, "different params give different keys")
+}
+
+func TestClient_IdempotencyKeySentOnlyWithWrites(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Idempotency-Key"))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetIdempotencyKey("idem-1")
+
+	_, err := client.Get(context.Background(), "/test")
+	require.NoError(t, err)
+	assert.False(t, client.IdempotencyKeySent())
+
+	_, err = client.Post(context.Background(), "/test", map[string]any{})
+	require.NoError(t, err)
+	assert.True(t, client.IdempotencyKeySent())
+	assert.Equal(t, []string{"", "idem-1"}, got)
+}
+
+func TestClient_IdempotencyPartsSendDistinctKeys(t *testing.T) {
+	var got []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Idempotency-Key"))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetIdempotencyKey("idem-1")
+	ctx := context.Background()
+
+	for _, part := range []string{"row-1", "row-2", "row-1"} {
+		_, err := client.Post(WithIdempotencyPart(ctx, part), "/test", map[string]any{})
+		require.NoError(t, err)
+	}
+
+	row1, row2 := DerivedIdempotencyKey("idem-1", "row-1"), DerivedIdempotencyKey("idem-1", "row-2")
+	assert.NotEqual(t, row1, row2)
+	assert.NotEqual(t, "idem-1", row1)
+	assert.Equal(t, []string{row1, row2, row1}, got, "same part, same key")
+	assert.Equal(t, []SentIdempotencyKey{{Part: "row-1", Key: row1}, {Part: "row-2", Key: row2}}, client.IdempotencyKeysSent())
+}
+
+func TestClient_Post_RetriesRateLimit(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"1"}`))
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetRetryConfig(1, time.Millisecond, time.Millisecond)
+
+	_, err := client.Post(context.Background(), "/test", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestClient_Get_APIErrorKeepsBody(t *testing.T) {
+	body := map[string]any{"error": "not found", "suggestions": []string{"c-124"}}
+	server := mockServer(t, "GET", "/test", http.StatusNotFound, body)
+	defer server.Close()
+
+	_, err := testClient(server).Get(context.Background(), "/test")
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "not found", apiErr.Message)
+	assert.JSONEq(t, `{"error":"not found","suggestions":["c-124"]}`, string(apiErr.Body))
+}
+
+func TestClient_SetServerFields(t *testing.T) {
+	var gotFields, gotLimit string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotFields = r.URL.Query().Get("fields")
+		gotLimit = r.URL.Query().Get("limit")
+		w.Header().Set("Content-Type", "application/json")
+		// The server honors the allowlist and omits everything else.
+		_, _ = w.Write([]byte(`{"data":[{"hris_profile_id":"p1","first_name":"Ada","last_name":"Lovelace"}],"page":{"next":""}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetServerFields([]string{"hris_profile_id", " first_name ", "last_name", ""})
+
+	resp, err := client.ListPeople(WithServerFields(context.Background()), PeopleListParams{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "hris_profile_id,first_name,last_name", gotFields)
+	assert.Equal(t, "10", gotLimit, "existing query parameters are kept")
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, "p1", resp.Data[0].HRISProfileID)
+	assert.Equal(t, "Ada Lovelace", resp.Data[0].Name)
+	assert.Empty(t, resp.Data[0].Email)
+}
+
+func TestClient_WithServerFields(t *testing.T) {
+	ctx := WithServerFields(context.Background())
+	client := NewClient("test-token")
+	assert.Equal(t, "/rest/v2/people", client.withServerFields(ctx, "/rest/v2/people"))
+
+	client.SetServerFields([]string{"id", "status"})
+	assert.Equal(t, "/rest/v2/people?fields=id%2Cstatus", client.withServerFields(ctx, "/rest/v2/people"))
+	assert.Equal(t, "/rest/v2/people?limit=5&fields=id%2Cstatus", client.withServerFields(ctx, "/rest/v2/people?limit=5"))
+	assert.Equal(t, "/x?fields=name", client.withServerFields(ctx, "/x?fields=name"), "an explicit fields param wins")
+	assert.Equal(t, "/rest/v2/people", client.withServerFields(context.Background(), "/rest/v2/people"), "unmarked reads are left alone")
+
+	// The existing query is not re-encoded: order and escaping are kept.
+	assert.Equal(t, "/x?z=1&a=b%20c&fields=id%2Cstatus", client.withServerFields(ctx, "/x?z=1&a=b%20c"))
+
+	// Fields the command reads itself are always requested.
+	needs := WithServerFields(context.Background(), "status", "start_date")
+	assert.Equal(t, "/rest/v2/people?fields=id%2Cstatus%2Cstart_date", client.withServerFields(needs, "/rest/v2/people"))
+	assert.Equal(t, "/rest/v2/people", NewClient("test-token").withServerFields(needs, "/rest/v2/people"), "needed fields alone do not narrow a full read")
+
+	// Reduced payloads are cached separately from full ones.
+	full := NewClient("test-token")
+	assert.NotEqual(t, full.cacheFile(ctx, "/rest/v2/lookups/countries"), client.cacheFile(ctx, "/rest/v2/lookups/countries"))
+	assert.Equal(t, full.cacheFile(ctx, "/rest/v2/lookups/countries"), client.cacheFile(context.Background(), "/rest/v2/lookups/countries"))
+}
+
+func TestClient_ServerFields_OnlyOnMarkedReads(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetServerFields([]string{"id"})
+	_, err := client.Get(context.Background(), "/lookup?country=DE")
+	require.NoError(t, err)
+	_, err = client.Get(WithServerFields(context.Background()), "/list?country=DE")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"country=DE", "country=DE&fields=id"}, queries)
+}
+
+func TestClient_ServerFields_NotSentOnWrites(t *testing.T) {
+	var rawQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawQuery = r.URL.RawQuery
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetServerFields([]string{"id"})
+	_, err := client.Post(context.Background(), "/test", map[string]string{})
+	require.NoError(t, err)
+	assert.Empty(t, rawQuery)
+}
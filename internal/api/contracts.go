@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime"
+	"net/http"
 	"net/url"
 	"strconv"
 )
@@ -18,11 +21,13 @@ type Contract struct {
 	WorkerEmail        string         `json:"worker_email"`
 	Worker             ContractWorker `json:"worker"` // ergonomic alias for scripting (jq: .worker.name)
 	Entity             string         `json:"entity"`
-	EntityID           string         `json:"entity_id"`
+	EntityID           string         `json:"entity_id,omitempty"`
 	StartDate          string         `json:"start_date"`
-	EndDate            string         `json:"end_date"`
+	EndDate            string         `json:"end_date,omitempty"`
 	Currency           string         `json:"currency"`
 	CompensationAmount float64        `json:"compensation_amount"`
+	CompensationScale  string         `json:"compensation_scale,omitempty"` // e.g. annual, monthly, hourly
+	PayFrequency       string         `json:"pay_frequency,omitempty"`
 	Country            string         `json:"country"`
 }
 
@@ -58,6 +63,8 @@ type rawContract struct {
 	CompensationDetails struct {
 		CurrencyCode string `json:"currency_code"`
 		Amount       string `json:"amount"`
+		Scale        string `json:"scale"`
+		Frequency    string `json:"frequency"`
 	} `json:"compensation_details"`
 }
 
@@ -89,6 +96,8 @@ func (c *Contract) UnmarshalJSON(data []byte) error {
 	}
 	c.Country = raw.Worker.Country
 	c.Currency = raw.CompensationDetails.CurrencyCode
+	c.CompensationScale = raw.CompensationDetails.Scale
+	c.PayFrequency = raw.CompensationDetails.Frequency
 
 	if raw.CompensationDetails.Amount != "" {
 		if amount, err := strconv.ParseFloat(raw.CompensationDetails.Amount, 64); err == nil {
@@ -104,10 +113,12 @@ type ContractsListResponse = ListResponse[Contract]
 
 // ContractsListParams are params for listing contracts
 type ContractsListParams struct {
-	Limit  int
-	Cursor string
-	Status string
-	Type   string
+	Limit       int
+	Cursor      string
+	Status      string
+	Type        string
+	WorkerEmail string
+	Country     string
 }
 
 // ListContracts returns a list of contracts
@@ -125,6 +136,12 @@ func (c *Client) ListContracts(ctx context.Context, params ContractsListParams)
 	if params.Type != "" {
 		q.Set("type", params.Type)
 	}
+	if params.WorkerEmail != "" {
+		q.Set("worker_email", params.WorkerEmail)
+	}
+	if params.Country != "" {
+		q.Set("country", params.Country)
+	}
 
 	path := "/rest/v2/contracts"
 	if len(q) > 0 {
@@ -158,10 +175,16 @@ type ContractAmendment struct {
 	CreatedAt string `json:"created_at"`
 }
 
-// CreateContractAmendmentParams are parameters for creating a contractor amendment
+// CreateContractAmendmentParams are parameters for creating a contractor
+// amendment. Only the fields being changed need to be set.
 type CreateContractAmendmentParams struct {
-	ScopeOfWork    string `json:"scope_of_work,omitempty"`
-	PaymentDueType string `json:"payment_due_type,omitempty"` // REGULAR, etc.
+	ScopeOfWork    string  `json:"scope_of_work,omitempty"`
+	Amount         float64 `json:"amount,omitempty"` // new compensation rate
+	Title          string  `json:"title,omitempty"`
+	JobTitleName   string  `json:"job_title_name,omitempty"`
+	PaymentDueType string  `json:"payment_due_type,omitempty"` // REGULAR, etc.
+	EffectiveDate  string  `json:"effective_date,omitempty"`   // YYYY-MM-DD; empty takes effect once signed
+	Reason         string  `json:"reason,omitempty"`
 }
 
 // CreateContractAmendment creates a new amendment for a contractor contract
@@ -399,6 +422,55 @@ func (c *Client) CreateContract(ctx context.Context, params CreateContractParams
 	return decodeData[Contract](resp)
 }
 
+// UpdateContractParams are params for updating a contractor contract.
+// Nil fields are omitted, so only the fields that are set are changed; a
+// field set to an empty string is sent as one, which clears it.
+type UpdateContractParams struct {
+	Title        *string
+	Rate         *float64
+	JobTitle     *string
+	ScopeOfWork  *string
+	EndDate      *string
+	PaymentCycle *string
+}
+
+type updateContractRequest struct {
+	Title               *string                    `json:"title,omitempty"`
+	JobTitle            *jobTitleObj               `json:"job_title,omitempty"`
+	ScopeOfWork         *string                    `json:"scope_of_work,omitempty"`
+	EndDate             *string                    `json:"end_date,omitempty"`
+	PaymentCycle        *string                    `json:"payment_cycle,omitempty"`
+	CompensationDetails *updateCompensationDetails `json:"compensation_details,omitempty"`
+}
+
+type updateCompensationDetails struct {
+	Amount float64 `json:"amount"`
+}
+
+// UpdateContract updates an existing contractor contract
+func (c *Client) UpdateContract(ctx context.Context, contractID string, params UpdateContractParams) (*Contract, error) {
+	req := updateContractRequest{
+		Title:        params.Title,
+		ScopeOfWork:  params.ScopeOfWork,
+		EndDate:      params.EndDate,
+		PaymentCycle: params.PaymentCycle,
+	}
+	if params.JobTitle != nil {
+		req.JobTitle = &jobTitleObj{Name: *params.JobTitle}
+	}
+	if params.Rate != nil {
+		req.CompensationDetails = &updateCompensationDetails{Amount: *params.Rate}
+	}
+
+	path := fmt.Sprintf("/rest/v2/contracts/%s", escapePath(contractID))
+	resp, err := c.Patch(ctx, path, wrapData(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeData[Contract](resp)
+}
+
 // SignContract signs a contract (as the client/employer)
 // signerName is the full name of the person signing on behalf of the client
 func (c *Client) SignContract(ctx context.Context, contractID string, signerName string) (*Contract, error) {
@@ -479,6 +551,62 @@ func (c *Client) GetContractPDF(ctx context.Context, contractID string) (string,
 	return data.URL, nil
 }
 
+// DownloadContractPDF streams the PDF at downloadURL, a pre-signed URL from
+// GetContractPDF, to w. The URL carries its own credentials, so no
+// Authorization header is sent. progress, when set, is called as bytes arrive
+// with the running count and the Content-Length (-1 when unknown). A response
+// that is not application/pdf is an error and nothing is written.
+func (c *Client) DownloadContractPDF(ctx context.Context, downloadURL string, w io.Writer, progress func(written, total int64)) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/pdf")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			return
+		}
+	}()
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return 0, fmt.Errorf("download error %d: %s", resp.StatusCode, string(body))
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType != "application/pdf" {
+		return 0, fmt.Errorf("download is not a PDF (content type %q)", contentType)
+	}
+
+	if progress != nil {
+		w = &progressWriter{w: w, total: resp.ContentLength, progress: progress}
+	}
+	n, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return n, fmt.Errorf("failed to read PDF: %w", err)
+	}
+	return n, nil
+}
+
+// progressWriter reports the running byte count after every write.
+type progressWriter struct {
+	w        io.Writer
+	written  int64
+	total    int64
+	progress func(written, total int64)
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.written += int64(n)
+	p.progress(p.written, p.total)
+	return n, err
+}
+
 // InviteWorkerParams contains parameters for inviting a worker
 type InviteWorkerParams struct {
 	Email   string `json:"email"`
@@ -538,3 +666,20 @@ func (c *Client) ListContractTemplates(ctx context.Context) ([]ContractTemplate,
 	}
 	return *templates, nil
 }
+
+// GetContractTemplate returns one contract template with every field it
+// carries. Templates preset whichever contract fields they need, so the result
+// is left as a generic object rather than a ContractTemplate.
+func (c *Client) GetContractTemplate(ctx context.Context, templateID string) (map[string]any, error) {
+	path := fmt.Sprintf("/rest/v2/contract-templates/%s", escapePath(templateID))
+	resp, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := decodeData[map[string]any](resp)
+	if err != nil {
+		return nil, err
+	}
+	return *template, nil
+}
@@ -1,9 +1,11 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -31,7 +33,9 @@ func TestContract_UnmarshalJSON_PopulatesWorkerAlias(t *testing.T) {
   },
   "compensation_details": {
     "currency_code": "USD",
-    "amount": "100.50"
+    "amount": "100.50",
+    "scale": "hourly",
+    "frequency": "monthly"
   }
 }`)
 
@@ -44,6 +48,8 @@ func TestContract_UnmarshalJSON_PopulatesWorkerAlias(t *testing.T) {
 	assert.Equal(t, "active", c.Status)
 	assert.Equal(t, "2024-01-01", c.StartDate)
 	assert.Equal(t, "2024-03-01", c.EndDate)
+	assert.Equal(t, "hourly", c.CompensationScale)
+	assert.Equal(t, "monthly", c.PayFrequency)
 
 	// Backwards compatible flat fields.
 	assert.Equal(t, "Zora Example", c.WorkerName)
@@ -104,6 +110,23 @@ func TestListContracts(t *testing.T) {
 	assert.Equal(t, "cursor123", result.Page.Next)
 }
 
+func TestListContracts_WorkerFilters(t *testing.T) {
+	server := mockServerWithQuery(t, "/rest/v2/contracts", func(t *testing.T, query map[string]string) {
+		assert.Equal(t, "jane@example.com", query["worker_email"])
+		assert.Equal(t, "TW", query["country"])
+		assert.Equal(t, "abc", query["cursor"])
+	}, map[string]any{"data": []map[string]any{}, "page": map[string]any{}})
+	defer server.Close()
+
+	client := testClient(server)
+	_, err := client.ListContracts(context.Background(), ContractsListParams{
+		Cursor:      "abc",
+		WorkerEmail: "jane@example.com",
+		Country:     "TW",
+	})
+	require.NoError(t, err)
+}
+
 func TestGetContract(t *testing.T) {
 	response := map[string]any{
 		"data": map[string]any{
@@ -153,6 +176,51 @@ func TestCreateContract(t *testing.T) {
 	assert.Equal(t, "c-new", result.ID)
 }
 
+func TestUpdateContract_SendsOnlySetFields(t *testing.T) {
+	server := mockServerWithBody(t, "PATCH", "/rest/v2/contracts/c1", func(t *testing.T, body map[string]any) {
+		data, ok := body["data"].(map[string]any)
+		require.True(t, ok, "body should have 'data' wrapper")
+		assert.Equal(t, map[string]any{
+			"title":                "Senior Engineer",
+			"job_title":            map[string]any{"name": "Staff Engineer"},
+			"compensation_details": map[string]any{"amount": float64(95)},
+		}, data)
+	}, http.StatusOK, map[string]any{
+		"data": map[string]any{"id": "c1", "title": "Senior Engineer", "status": "in_progress"},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	title, jobTitle, rate := "Senior Engineer", "Staff Engineer", 95.0
+	result, err := client.UpdateContract(context.Background(), "c1", UpdateContractParams{
+		Title:    &title,
+		JobTitle: &jobTitle,
+		Rate:     &rate,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Senior Engineer", result.Title)
+}
+
+func TestUpdateContract_SendsExplicitEmptyValues(t *testing.T) {
+	server := mockServerWithBody(t, "PATCH", "/rest/v2/contracts/c1", func(t *testing.T, body map[string]any) {
+		data, ok := body["data"].(map[string]any)
+		require.True(t, ok, "body should have 'data' wrapper")
+		assert.Equal(t, map[string]any{"end_date": "", "scope_of_work": ""}, data)
+	}, http.StatusOK, map[string]any{
+		"data": map[string]any{"id": "c1", "status": "in_progress"},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	empty := ""
+	_, err := client.UpdateContract(context.Background(), "c1", UpdateContractParams{
+		EndDate:     &empty,
+		ScopeOfWork: &empty,
+	})
+	require.NoError(t, err)
+}
+
 func TestSignContract(t *testing.T) {
 	server := mockServerWithBody(t, "POST", "/rest/v2/contracts/c1/signatures", func(t *testing.T, body map[string]any) {
 		data, ok := body["data"].(map[string]any)
@@ -213,6 +281,45 @@ func TestGetContractPDF(t *testing.T) {
 	assert.Contains(t, url, "c1.pdf")
 }
 
+func TestDownloadContractPDF(t *testing.T) {
+	pdf := []byte("%PDF-1.7 mock contract")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Pre-signed URLs carry their own credentials.
+		assert.Empty(t, r.Header.Get("Authorization"))
+		assert.Equal(t, "sig", r.URL.Query().Get("X-Signature"))
+		w.Header().Set("Content-Type", "application/pdf; charset=binary")
+		_, _ = w.Write(pdf)
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	var written, total int64
+	n, err := testClient(server).DownloadContractPDF(context.Background(), server.URL+"/c1.pdf?X-Signature=sig", &out, func(w, t int64) {
+		written, total = w, t
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(pdf)), n)
+	assert.Equal(t, pdf, out.Bytes())
+	assert.Equal(t, n, written)
+	assert.Equal(t, n, total)
+}
+
+func TestDownloadContractPDF_RejectsNonPDF(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte("<html>expired</html>"))
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	_, err := testClient(server).DownloadContractPDF(context.Background(), server.URL+"/c1.pdf", &out, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a PDF")
+	assert.Zero(t, out.Len())
+}
+
 func TestInviteWorker(t *testing.T) {
 	server := mockServerWithBody(t, "POST", "/rest/v2/contracts/c1/invitations", func(t *testing.T, body map[string]any) {
 		data, ok := body["data"].(map[string]any)
@@ -266,6 +373,20 @@ func TestListContractTemplates(t *testing.T) {
 	assert.Equal(t, "tpl1", result[0].ID)
 }
 
+func TestGetContractTemplate(t *testing.T) {
+	server := mockServer(t, "GET", "/rest/v2/contract-templates/tpl1", http.StatusOK, map[string]any{
+		"data": map[string]any{"id": "tpl1", "name": "Standard Contractor", "type": "fixed_rate", "currency": "USD"},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.GetContractTemplate(context.Background(), "tpl1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "fixed_rate", result["type"])
+	assert.Equal(t, "USD", result["currency"])
+}
+
 func TestCreateContractWithExtendedFields(t *testing.T) {
 	server := mockServerWithBody(t, "POST", "/rest/v2/contracts", func(t *testing.T, body map[string]any) {
 		data, ok := body["data"].(map[string]any)
@@ -321,3 +442,48 @@ func TestCreateContractWithExtendedFields(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "c-new", result.ID)
 }
+
+func TestCreateContractAmendment_EffectiveDate(t *testing.T) {
+	server := mockServerWithBody(t, "POST", "/rest/v2/contracts/c-1/amendments", func(t *testing.T, body map[string]any) {
+		data, ok := body["data"].(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, "New scope", data["scope_of_work"])
+		assert.Equal(t, "2030-07-01", data["effective_date"])
+	}, http.StatusOK, map[string]any{
+		"data": map[string]any{"id": "am-1", "status": "pending"},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	amendment, err := client.CreateContractAmendment(context.Background(), "c-1", CreateContractAmendmentParams{
+		ScopeOfWork:    "New scope",
+		PaymentDueType: "REGULAR",
+		EffectiveDate:  "2030-07-01",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "am-1", amendment.ID)
+}
+
+func TestCreateContractAmendment_RateAndTitles(t *testing.T) {
+	server := mockServerWithBody(t, "POST", "/rest/v2/contracts/c-1/amendments", func(t *testing.T, body map[string]any) {
+		data, ok := body["data"].(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, 120.5, data["amount"])
+		assert.Equal(t, "Senior Engineer", data["job_title_name"])
+		assert.Equal(t, "Promotion", data["reason"])
+		assert.NotContains(t, data, "scope_of_work")
+		assert.NotContains(t, data, "title")
+	}, http.StatusOK, map[string]any{
+		"data": map[string]any{"id": "am-2", "status": "pending"},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	amendment, err := client.CreateContractAmendment(context.Background(), "c-1", CreateContractAmendmentParams{
+		Amount:       120.5,
+		JobTitleName: "Senior Engineer",
+		Reason:       "Promotion",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "am-2", amendment.ID)
+}
@@ -11,9 +11,9 @@ type EORTermination struct {
 	ContractID       string  `json:"contract_id"`
 	Type             string  `json:"type"` // "resignation" or "termination"
 	Status           string  `json:"status"`
-	Reason           string  `json:"reason"`
+	Reason           string  `json:"reason,omitempty"`
 	EffectiveDate    string  `json:"effective_date"`
-	LastWorkingDay   string  `json:"last_working_day"`
+	LastWorkingDay   string  `json:"last_working_day,omitempty"`
 	NoticePeriodDays int     `json:"notice_period_days"`
 	SeveranceAmount  float64 `json:"severance_amount,omitempty"`
 	Currency         string  `json:"currency,omitempty"`
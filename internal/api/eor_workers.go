@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"net/url"
 )
 
 // EORWorker represents an employee managed through Deel's EOR service
@@ -53,6 +54,16 @@ type EORBankAccount struct {
 	Swift         string `json:"swift,omitempty"`
 	Currency      string `json:"currency"`
 	IsPrimary     bool   `json:"is_primary"`
+	Status        string `json:"status,omitempty"`
+}
+
+// EORWorkersListResponse is the response from list EOR workers
+type EORWorkersListResponse = ListResponse[EORWorker]
+
+// EORWorkersListParams are params for listing EOR workers
+type EORWorkersListParams struct {
+	Limit  int
+	Cursor string
 }
 
 // CreateEORWorkerParams are parameters for creating an EOR worker
@@ -96,6 +107,40 @@ func (c *Client) CreateEORWorker(ctx context.Context, params CreateEORWorkerPara
 	return decodeData[EORWorker](resp)
 }
 
+// GetEORWorker returns a single EOR worker
+func (c *Client) GetEORWorker(ctx context.Context, id string) (*EORWorker, error) {
+	path := fmt.Sprintf("/rest/v2/eor/workers/%s", escapePath(id))
+	resp, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeData[EORWorker](resp)
+}
+
+// ListEORWorkers returns EOR workers
+func (c *Client) ListEORWorkers(ctx context.Context, params EORWorkersListParams) (*EORWorkersListResponse, error) {
+	q := url.Values{}
+	if params.Limit > 0 {
+		q.Set("limit", fmt.Sprintf("%d", params.Limit))
+	}
+	if params.Cursor != "" {
+		q.Set("cursor", params.Cursor)
+	}
+
+	path := "/rest/v2/eor/workers"
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	resp, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeList[EORWorker](resp)
+}
+
 // UpdateEORWorker updates an existing EOR worker
 func (c *Client) UpdateEORWorker(ctx context.Context, id string, params UpdateEORWorkerParams) (*EORWorker, error) {
 	path := fmt.Sprintf("/rest/v2/eor/workers/%s", escapePath(id))
@@ -147,3 +192,25 @@ func (c *Client) AddEORWorkerBankAccount(ctx context.Context, workerID string, p
 
 	return decodeData[EORBankAccount](resp)
 }
+
+// ListEORWorkerBankAccounts returns the bank accounts of an EOR worker
+func (c *Client) ListEORWorkerBankAccounts(ctx context.Context, workerID string) ([]EORBankAccount, error) {
+	path := fmt.Sprintf("/rest/v2/eor/workers/%s/bank-accounts", escapePath(workerID))
+	resp, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := decodeData[[]EORBankAccount](resp)
+	if err != nil {
+		return nil, err
+	}
+	return *accounts, nil
+}
+
+// DeleteEORWorkerBankAccount removes a bank account from an EOR worker
+func (c *Client) DeleteEORWorkerBankAccount(ctx context.Context, workerID, accountID string) error {
+	path := fmt.Sprintf("/rest/v2/eor/workers/%s/bank-accounts/%s", escapePath(workerID), escapePath(accountID))
+	_, err := c.Delete(ctx, path)
+	return err
+}
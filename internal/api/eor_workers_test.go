@@ -51,6 +51,65 @@ func TestCreateEORWorker(t *testing.T) {
 	assert.Equal(t, "pending", result.Status)
 }
 
+func TestGetEORWorker(t *testing.T) {
+	server := mockServer(t, "GET", "/rest/v2/eor/workers/worker-789", http.StatusOK, map[string]any{
+		"data": map[string]any{
+			"id":         "worker-789",
+			"email":      "jane.smith@example.com",
+			"first_name": "Jane",
+			"last_name":  "Smith",
+			"country":    "GB",
+			"status":     "active",
+		},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.GetEORWorker(context.Background(), "worker-789")
+
+	require.NoError(t, err)
+	assert.Equal(t, "worker-789", result.ID)
+	assert.Equal(t, "jane.smith@example.com", result.Email)
+	assert.Equal(t, "active", result.Status)
+}
+
+func TestGetEORWorker_NotFound(t *testing.T) {
+	server := mockServer(t, "GET", "/rest/v2/eor/workers/missing", http.StatusNotFound, map[string]string{"error": "not found"})
+	defer server.Close()
+
+	client := testClient(server)
+	_, err := client.GetEORWorker(context.Background(), "missing")
+
+	require.Error(t, err)
+	apiErr, ok := err.(*APIError)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
+
+func TestListEORWorkers(t *testing.T) {
+	server := mockServerWithQuery(t, "/rest/v2/eor/workers", func(t *testing.T, query map[string]string) {
+		assert.Equal(t, "25", query["limit"])
+		assert.Equal(t, "abc", query["cursor"])
+	}, map[string]any{
+		"data": []map[string]any{
+			{"id": "worker-1", "email": "a@example.com", "first_name": "Ann", "last_name": "Lee", "country": "GB", "status": "active"},
+			{"id": "worker-2", "email": "b@example.com", "first_name": "Bo", "last_name": "Kim", "country": "DE", "status": "onboarding"},
+		},
+		"page": map[string]any{"next": "def", "total": 7},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.ListEORWorkers(context.Background(), EORWorkersListParams{Limit: 25, Cursor: "abc"})
+
+	require.NoError(t, err)
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, "worker-1", result.Data[0].ID)
+	assert.Equal(t, "DE", result.Data[1].Country)
+	assert.Equal(t, "def", result.Page.Next)
+	assert.Equal(t, 7, result.Page.Total)
+}
+
 func TestCreateEORWorker_ValidationError(t *testing.T) {
 	server := mockServer(t, "POST", "/rest/v2/eor/workers", http.StatusBadRequest, map[string]string{"error": "invalid email"})
 	defer server.Close()
@@ -300,3 +359,33 @@ func TestAddEORWorkerBankAccount_WorkerNotFound(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
 }
+
+func TestListEORWorkerBankAccounts(t *testing.T) {
+	server := mockServer(t, "GET", "/rest/v2/eor/workers/worker-789/bank-accounts", http.StatusOK, map[string]any{
+		"data": []map[string]any{
+			{"id": "ba-1", "account_holder": "Jane Smith", "bank_name": "Barclays", "account_number": "****5678", "currency": "GBP", "is_primary": true, "status": "active"},
+			{"id": "ba-2", "account_holder": "Jane Smith", "bank_name": "Monzo", "account_number": "****1234", "currency": "GBP"},
+		},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.ListEORWorkerBankAccounts(context.Background(), "worker-789")
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "ba-1", result[0].ID)
+	assert.True(t, result[0].IsPrimary)
+	assert.Equal(t, "active", result[0].Status)
+	assert.False(t, result[1].IsPrimary)
+}
+
+func TestDeleteEORWorkerBankAccount(t *testing.T) {
+	server := mockServer(t, "DELETE", "/rest/v2/eor/workers/worker-789/bank-accounts/ba-2", http.StatusNoContent, nil)
+	defer server.Close()
+
+	client := testClient(server)
+	err := client.DeleteEORWorkerBankAccount(context.Background(), "worker-789", "ba-2")
+
+	require.NoError(t, err)
+}
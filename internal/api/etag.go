@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// etagEntry is one stored conditional-request entry: the validator the API
+// sent and the body it described.
+type etagEntry struct {
+	ETag string          `json:"etag"`
+	Body json.RawMessage `json:"body"`
+}
+
+// SetETagCache enables conditional GET requests. Responses that carry an ETag
+// are stored under dir, keyed by account and endpoint; later GETs of the same
+// endpoint send If-None-Match and a 304 is answered from the stored body. Unlike
+// SetCache there is no TTL: the API decides when the body is stale. An empty
+// dir disables it.
+func (c *Client) SetETagCache(dir, account string) {
+	c.etagDir = dir
+	c.etagAccount = account
+}
+
+func (c *Client) etagFile(path string) string {
+	sum := sha256.Sum256([]byte(c.etagAccount + "\n" + c.baseURL + path))
+	return filepath.Join(c.etagDir, hex.EncodeToString(sum[:])+".json")
+}
+
+// readETagEntry returns the stored entry in file, or nil when there is none
+// or it cannot be used.
+func readETagEntry(file string) *etagEntry {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil
+	}
+	var entry etagEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.ETag == "" || !json.Valid(entry.Body) {
+		return nil
+	}
+	return &entry
+}
+
+// getConditional performs a GET of path (already including server fields)
+// with If-None-Match when an ETag is stored for it. A 304 returns the stored
+// body; a fresh response with an ETag replaces the entry, unless it is marked
+// Cache-Control: no-store, which drops the entry instead. The final response's
+// headers are returned with the body. Store read/write failures never fail the
+// request.
+func (c *Client) getConditional(ctx context.Context, path string) (json.RawMessage, http.Header, error) {
+	file := c.etagFile(path)
+	entry := readETagEntry(file)
+
+	header := http.Header{}
+	if entry != nil {
+		header.Set("If-None-Match", entry.ETag)
+	}
+
+	var last *http.Response
+	url := c.baseURL + path
+	body, err := c.doWithRetry(ctx, http.MethodGet, path, func() (*http.Response, error) {
+		resp, err := c.doRequest(ctx, http.MethodGet, url, nil, header)
+		last = resp
+		return resp, err
+	}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if last.StatusCode == http.StatusNotModified && entry != nil {
+		if c.debug {
+			slog.Info("etag not modified", "path", path)
+		}
+		return entry.Body, last.Header, nil
+	}
+	if parseCachePolicy(last.Header).noStore {
+		if entry != nil {
+			_ = os.Remove(file)
+		}
+		return body, last.Header, nil
+	}
+	if etag := last.Header.Get("ETag"); etag != "" && json.Valid(body) {
+		data, err := json.Marshal(etagEntry{ETag: etag, Body: body})
+		if err == nil {
+			err = writeCacheEntry(file, data)
+		}
+		if err != nil && c.debug {
+			slog.Info("etag store write failed", "path", path, "error", err)
+		}
+	}
+	return body, last.Header, nil
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// etagServer serves body with etag, answering 304 when If-None-Match matches.
+// The served body and etag can be swapped between requests.
+type etagServer struct {
+	mu          sync.Mutex
+	etag        string
+	body        string
+	ifNoneMatch []string
+}
+
+func (s *etagServer) set(etag, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.etag, s.body = etag, body
+}
+
+func (s *etagServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ifNoneMatch = append(s.ifNoneMatch, r.Header.Get("If-None-Match"))
+	w.Header().Set("ETag", s.etag)
+	if r.Header.Get("If-None-Match") == s.etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(s.body))
+}
+
+func TestGetConditional_NotModifiedServesStoredBody(t *testing.T) {
+	es := &etagServer{}
+	es.set(`"v1"`, `{"data":[{"id":"a"}]}`)
+	server := httptest.NewServer(es)
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetETagCache(t.TempDir(), "acme")
+
+	first, err := client.Get(context.Background(), "/rest/v2/people")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":[{"id":"a"}]}`, string(first))
+
+	second, err := client.Get(context.Background(), "/rest/v2/people")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":[{"id":"a"}]}`, string(second))
+
+	assert.Equal(t, []string{"", `"v1"`}, es.ifNoneMatch)
+}
+
+func TestGetConditional_NewBodyUpdatesStoredETag(t *testing.T) {
+	es := &etagServer{}
+	es.set(`"v1"`, `{"data":[{"id":"a"}]}`)
+	server := httptest.NewServer(es)
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetETagCache(t.TempDir(), "acme")
+
+	_, err := client.Get(context.Background(), "/rest/v2/people")
+	require.NoError(t, err)
+
+	es.set(`"v2"`, `{"data":[{"id":"b"}]}`)
+	changed, err := client.Get(context.Background(), "/rest/v2/people")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":[{"id":"b"}]}`, string(changed))
+
+	entry := readETagEntry(client.etagFile("/rest/v2/people"))
+	require.NotNil(t, entry)
+	assert.Equal(t, `"v2"`, entry.ETag)
+	assert.JSONEq(t, `{"data":[{"id":"b"}]}`, string(entry.Body))
+
+	again, err := client.Get(context.Background(), "/rest/v2/people")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":[{"id":"b"}]}`, string(again))
+	assert.Equal(t, []string{"", `"v1"`, `"v2"`}, es.ifNoneMatch)
+}
+
+func TestGetConditional_AccountsDoNotShareEntries(t *testing.T) {
+	es := &etagServer{}
+	es.set(`"v1"`, `{"data":[]}`)
+	server := httptest.NewServer(es)
+	defer server.Close()
+
+	dir := t.TempDir()
+	acme := testClient(server)
+	acme.SetETagCache(dir, "acme")
+	_, err := acme.Get(context.Background(), "/rest/v2/people")
+	require.NoError(t, err)
+
+	other := testClient(server)
+	other.SetETagCache(dir, "other")
+	_, err = other.Get(context.Background(), "/rest/v2/people")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"", ""}, es.ifNoneMatch)
+}
+
+func TestGetConditional_NoStoreIsNotStored(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetETagCache(t.TempDir(), "acme")
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Get(context.Background(), "/rest/v2/people")
+		require.NoError(t, err)
+	}
+	assert.NoFileExists(t, client.etagFile("/rest/v2/people"))
+}
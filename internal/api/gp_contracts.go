@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"net/url"
 )
 
 // GPContract represents a Global Payroll contract
@@ -53,6 +54,15 @@ type GPAllowance struct {
 	Currency string  `json:"currency"`
 }
 
+// GPContractsListResponse is the response from list GP contracts
+type GPContractsListResponse = ListResponse[GPContract]
+
+// GPContractsListParams are params for listing GP contracts
+type GPContractsListParams struct {
+	Limit  int
+	Cursor string
+}
+
 // CreateGPContractParams are parameters for creating a GP contract
 type CreateGPContractParams struct {
 	WorkerEmail  string  `json:"worker_email"`
@@ -94,6 +104,29 @@ func (c *Client) CreateGPContract(ctx context.Context, params CreateGPContractPa
 	return decodeData[GPContract](resp)
 }
 
+// ListGPContracts returns Global Payroll contracts
+func (c *Client) ListGPContracts(ctx context.Context, params GPContractsListParams) (*GPContractsListResponse, error) {
+	q := url.Values{}
+	if params.Limit > 0 {
+		q.Set("limit", fmt.Sprintf("%d", params.Limit))
+	}
+	if params.Cursor != "" {
+		q.Set("cursor", params.Cursor)
+	}
+
+	path := "/rest/v2/gp/contracts"
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	resp, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeList[GPContract](resp)
+}
+
 // UpdateGPWorker updates a Global Payroll worker's information
 func (c *Client) UpdateGPWorker(ctx context.Context, workerID string, params UpdateGPWorkerParams) (*GPWorker, error) {
 	path := fmt.Sprintf("/rest/v2/gp/workers/%s", escapePath(workerID))
@@ -86,6 +86,30 @@ func TestCreateGPContract_ValidationError(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
 }
 
+func TestListGPContracts(t *testing.T) {
+	server := mockServerWithQuery(t, "/rest/v2/gp/contracts", func(t *testing.T, query map[string]string) {
+		assert.Equal(t, "50", query["limit"])
+		assert.Equal(t, "abc", query["cursor"])
+	}, map[string]any{
+		"data": []map[string]any{
+			{"id": "gp-1", "worker_id": "w-1", "worker_name": "Ann Lee", "country": "GB", "status": "active"},
+			{"id": "gp-2", "worker_id": "w-2", "worker_name": "Bo Kim", "country": "DE", "status": "active"},
+		},
+		"page": map[string]any{"next": "def", "total": 4},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.ListGPContracts(context.Background(), GPContractsListParams{Limit: 50, Cursor: "abc"})
+
+	require.NoError(t, err)
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, "w-1", result.Data[0].WorkerID)
+	assert.Equal(t, "Bo Kim", result.Data[1].WorkerName)
+	assert.Equal(t, "def", result.Page.Next)
+	assert.Equal(t, 4, result.Page.Total)
+}
+
 func TestUpdateGPWorker(t *testing.T) {
 	server := mockServerWithBody(t, "PATCH", "/rest/v2/gp/workers/w-789", func(t *testing.T, body map[string]any) {
 		assert.Equal(t, "Jane", body["first_name"])
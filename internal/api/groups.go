@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"net/url"
 )
 
 // Group represents a Deel group
@@ -14,6 +15,14 @@ type Group struct {
 	CreatedAt   string `json:"created_at"`
 }
 
+// GroupMember is a person belonging to a group
+type GroupMember struct {
+	ID            string `json:"id"`
+	HRISProfileID string `json:"hris_profile_id"`
+	Name          string `json:"name"`
+	Email         string `json:"email,omitempty"`
+}
+
 // CreateGroupParams are params for creating a group
 type CreateGroupParams struct {
 	Name        string `json:"name"`
@@ -26,18 +35,36 @@ type UpdateGroupParams struct {
 	Description string `json:"description,omitempty"`
 }
 
-// ListGroups returns all groups
-func (c *Client) ListGroups(ctx context.Context) ([]Group, error) {
-	resp, err := c.Get(ctx, "/rest/v2/groups")
-	if err != nil {
-		return nil, err
+// GroupsListResponse is the response from list groups
+type GroupsListResponse = ListResponse[Group]
+
+// GroupsListParams are params for listing groups
+type GroupsListParams struct {
+	Limit  int
+	Cursor string
+}
+
+// ListGroups returns one page of groups
+func (c *Client) ListGroups(ctx context.Context, params GroupsListParams) (*GroupsListResponse, error) {
+	q := url.Values{}
+	if params.Limit > 0 {
+		q.Set("limit", fmt.Sprintf("%d", params.Limit))
+	}
+	if params.Cursor != "" {
+		q.Set("cursor", params.Cursor)
 	}
 
-	groups, err := decodeData[[]Group](resp)
+	path := "/rest/v2/groups"
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	resp, err := c.Get(ctx, path)
 	if err != nil {
 		return nil, err
 	}
-	return *groups, nil
+
+	return decodeList[Group](resp)
 }
 
 // GetGroup returns a single group by ID
@@ -51,6 +78,21 @@ func (c *Client) GetGroup(ctx context.Context, id string) (*Group, error) {
 	return decodeData[Group](resp)
 }
 
+// ListGroupMembers returns the people in a group
+func (c *Client) ListGroupMembers(ctx context.Context, id string) ([]GroupMember, error) {
+	path := fmt.Sprintf("/rest/v2/groups/%s/members", escapePath(id))
+	resp, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := decodeData[[]GroupMember](resp)
+	if err != nil {
+		return nil, err
+	}
+	return *members, nil
+}
+
 // CreateGroup creates a new group
 func (c *Client) CreateGroup(ctx context.Context, params CreateGroupParams) (*Group, error) {
 	resp, err := c.Post(ctx, "/rest/v2/groups", params)
@@ -89,3 +131,17 @@ func (c *Client) CloneGroup(ctx context.Context, id string) (*Group, error) {
 
 	return decodeData[Group](resp)
 }
+
+// AddGroupMember adds a person to a group by HRIS profile ID
+func (c *Client) AddGroupMember(ctx context.Context, groupID, profileID string) error {
+	path := fmt.Sprintf("/rest/v2/groups/%s/members", escapePath(groupID))
+	_, err := c.Post(ctx, path, map[string]string{"hris_profile_id": profileID})
+	return err
+}
+
+// RemoveGroupMember removes a person from a group by HRIS profile ID
+func (c *Client) RemoveGroupMember(ctx context.Context, groupID, profileID string) error {
+	path := fmt.Sprintf("/rest/v2/groups/%s/members/%s", escapePath(groupID), escapePath(profileID))
+	_, err := c.Delete(ctx, path)
+	return err
+}
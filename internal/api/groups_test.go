@@ -31,13 +31,33 @@ func TestListGroups(t *testing.T) {
 	defer server.Close()
 
 	client := testClient(server)
-	result, err := client.ListGroups(context.Background())
+	result, err := client.ListGroups(context.Background(), GroupsListParams{})
 
 	require.NoError(t, err)
-	assert.Len(t, result, 2)
-	assert.Equal(t, "grp1", result[0].ID)
-	assert.Equal(t, "Engineering", result[0].Name)
-	assert.Equal(t, 15, result[0].MemberCount)
+	assert.Len(t, result.Data, 2)
+	assert.Equal(t, "grp1", result.Data[0].ID)
+	assert.Equal(t, "Engineering", result.Data[0].Name)
+	assert.Equal(t, 15, result.Data[0].MemberCount)
+}
+
+func TestListGroups_Pagination(t *testing.T) {
+	server := mockServerWithQuery(t, "/rest/v2/groups", func(t *testing.T, query map[string]string) {
+		assert.Equal(t, "25", query["limit"])
+		assert.Equal(t, "abc", query["cursor"])
+	}, map[string]any{
+		"data": []map[string]any{{"id": "grp3", "name": "Support"}},
+		"page": map[string]any{"next": "def", "total": 30},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.ListGroups(context.Background(), GroupsListParams{Limit: 25, Cursor: "abc"})
+
+	require.NoError(t, err)
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, "grp3", result.Data[0].ID)
+	assert.Equal(t, "def", result.Page.Next)
+	assert.Equal(t, 30, result.Page.Total)
 }
 
 func TestGetGroup(t *testing.T) {
@@ -64,6 +84,26 @@ func TestGetGroup(t *testing.T) {
 	assert.Equal(t, "2024-01-01T00:00:00Z", result.CreatedAt)
 }
 
+func TestListGroupMembers(t *testing.T) {
+	response := map[string]any{
+		"data": []map[string]any{
+			{"id": "w1", "hris_profile_id": "p1", "name": "Ann Lee", "email": "ann@example.com"},
+			{"id": "w2", "hris_profile_id": "p2", "name": "Bo Kim"},
+		},
+	}
+	server := mockServer(t, "GET", "/rest/v2/groups/grp1/members", http.StatusOK, response)
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.ListGroupMembers(context.Background(), "grp1")
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "p1", result[0].HRISProfileID)
+	assert.Equal(t, "Ann Lee", result[0].Name)
+	assert.Equal(t, "p2", result[1].HRISProfileID)
+}
+
 func TestCreateGroup(t *testing.T) {
 	server := mockServerWithBody(t, "POST", "/rest/v2/groups", func(t *testing.T, body map[string]any) {
 		assert.Equal(t, "Marketing", body["name"])
@@ -150,3 +190,21 @@ func TestCloneGroup(t *testing.T) {
 	assert.Equal(t, "Engineering (Copy)", result.Name)
 	assert.Equal(t, 0, result.MemberCount)
 }
+
+func TestAddGroupMember(t *testing.T) {
+	server := mockServerWithBody(t, "POST", "/rest/v2/groups/grp1/members", func(t *testing.T, body map[string]any) {
+		assert.Equal(t, "hris-1", body["hris_profile_id"])
+	}, http.StatusCreated, map[string]any{"data": map[string]any{"hris_profile_id": "hris-1"}})
+	defer server.Close()
+
+	client := testClient(server)
+	require.NoError(t, client.AddGroupMember(context.Background(), "grp1", "hris-1"))
+}
+
+func TestRemoveGroupMember(t *testing.T) {
+	server := mockServer(t, "DELETE", "/rest/v2/groups/grp1/members/hris-1", http.StatusNoContent, nil)
+	defer server.Close()
+
+	client := testClient(server)
+	require.NoError(t, client.RemoveGroupMember(context.Background(), "grp1", "hris-1"))
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+)
+
+// idempotencyPartKey is the context key for WithIdempotencyPart.
+type idempotencyPartKey struct{}
+
+// SentIdempotencyKey is an idempotency key a write request carried. Part is
+// empty for the client's own key.
+type SentIdempotencyKey struct {
+	Part string `json:"part,omitempty"`
+	Key  string `json:"key"`
+}
+
+// WithIdempotencyPart marks the writes made with ctx as one named part of a
+// command that sends several distinct writes, such as a bulk row ("row-3")
+// or a chained step ("then-sign"). Each part carries its own key derived
+// from the client's key and part, so the API does not de-duplicate distinct
+// writes into the first one. The derivation is deterministic: retrying the
+// command with the same --idempotency-key resends the same per-part keys.
+func WithIdempotencyPart(ctx context.Context, part string) context.Context {
+	return context.WithValue(ctx, idempotencyPartKey{}, part)
+}
+
+// DerivedIdempotencyKey returns the key a write in part sends when the
+// client's key is base.
+func DerivedIdempotencyKey(base, part string) string {
+	return hashedUUID(base, part)
+}
+
+// IdempotencyKeySent reports whether any write request carried an
+// idempotency key.
+func (c *Client) IdempotencyKeySent() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.sentKeys) > 0
+}
+
+// IdempotencyKeysSent returns every idempotency key write requests carried,
+// in the order first sent. Retries of a request are not listed again.
+func (c *Client) IdempotencyKeysSent() []SentIdempotencyKey {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]SentIdempotencyKey(nil), c.sentKeys...)
+}
+
+// idempotencyKeyFor returns the Idempotency-Key for a write made with ctx,
+// or "" when the client has no key, and records it as sent.
+func (c *Client) idempotencyKeyFor(ctx context.Context) string {
+	if c.idempotencyKey == "" {
+		return ""
+	}
+	sent := SentIdempotencyKey{Key: c.idempotencyKey}
+	if part, _ := ctx.Value(idempotencyPartKey{}).(string); part != "" {
+		sent = SentIdempotencyKey{Part: part, Key: DerivedIdempotencyKey(c.idempotencyKey, part)}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, k := range c.sentKeys {
+		if k == sent {
+			return sent.Key
+		}
+	}
+	c.sentKeys = append(c.sentKeys, sent)
+	return sent.Key
+}
+
+// hashedUUID returns a UUID-formatted (version 5 layout) SHA-256 digest of
+// a and b.
+func hashedUUID(a, b string) string {
+	h := sha256.New()
+	h.Write([]byte(a))
+	h.Write([]byte{0})
+	h.Write([]byte(b))
+	sum := h.Sum(nil)[:16]
+	sum[6] = sum[6]&0x0f | 0x50
+	sum[8] = sum[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:])
+}
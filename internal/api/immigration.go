@@ -14,7 +14,7 @@ type ImmigrationCase struct {
 	Status     string `json:"status"`
 	Country    string `json:"country"`
 	StartDate  string `json:"start_date"`
-	ExpiryDate string `json:"expiry_date"`
+	ExpiryDate string `json:"expiry_date,omitempty"`
 	CaseNumber string `json:"case_number"`
 }
 
@@ -36,7 +36,7 @@ type ImmigrationDoc struct {
 	Name      string `json:"name"`
 	Type      string `json:"type"`
 	Status    string `json:"status"`
-	ExpiresAt string `json:"expires_at"`
+	ExpiresAt string `json:"expires_at,omitempty"`
 }
 
 // ListImmigrationDocs returns documents for a case
@@ -82,9 +82,9 @@ func (c *Client) ListVisaTypes(ctx context.Context, country string) ([]VisaType,
 // VisaRequirement represents a visa requirement check
 type VisaRequirement struct {
 	Required bool   `json:"visa_required"`
-	Type     string `json:"suggested_type"`
+	Type     string `json:"suggested_type,omitempty"`
 	Duration string `json:"max_stay"`
-	Notes    string `json:"notes"`
+	Notes    string `json:"notes,omitempty"`
 }
 
 // CheckVisaRequirement checks if visa is required
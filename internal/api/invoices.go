@@ -16,10 +16,10 @@ type Invoice struct {
 	Amount      FlexFloat64 `json:"amount"`
 	Currency    string      `json:"currency"`
 	DueDate     string      `json:"due_date"`
-	PaidDate    string      `json:"paid_date"`
+	PaidDate    string      `json:"paid_date,omitempty"`
 	ContractID  string      `json:"contract_id"`
 	WorkerName  string      `json:"worker_name"`
-	Description string      `json:"description"`
+	Description string      `json:"description,omitempty"`
 }
 
 // InvoicesListResponse is the response from list invoices
@@ -79,7 +79,7 @@ type InvoiceAdjustment struct {
 	Type        string      `json:"type"`
 	Amount      FlexFloat64 `json:"amount"`
 	Currency    string      `json:"currency"`
-	Description string      `json:"description"`
+	Description string      `json:"description,omitempty"`
 	Status      string      `json:"status"`
 	CreatedAt   string      `json:"created_at"`
 }
@@ -112,11 +112,11 @@ type AllInvoiceAdjustment struct {
 	Type                 string      `json:"type"`
 	Amount               FlexFloat64 `json:"amount"`
 	Currency             string      `json:"currency"`
-	Description          string      `json:"description"`
+	Description          string      `json:"description,omitempty"`
 	Status               string      `json:"status"`
-	DateSubmitted        string      `json:"date_submitted"`
-	ContractID           string      `json:"contract_id"`
-	CreatedAt            string      `json:"created_at"`
+	DateSubmitted        string      `json:"date_submitted,omitempty"`
+	ContractID           string      `json:"contract_id,omitempty"`
+	CreatedAt            string      `json:"created_at,omitempty"`
 	Title                string      `json:"title,omitempty"`
 	AdjustmentCategoryID string      `json:"adjustment_category_id,omitempty"`
 	DateOfAdjustment     string      `json:"date_of_adjustment,omitempty"`
@@ -241,6 +241,7 @@ func (c *Client) GetInvoicePDF(ctx context.Context, invoiceID string) ([]byte, e
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
+	c.recordRateLimit(resp.Header)
 	defer func() {
 		if err := resp.Body.Close(); err != nil {
 			return
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogRecord is one line written by LogTransport.
+type LogRecord struct {
+	Time      string `json:"time"`
+	Account   string `json:"account,omitempty"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Status    int    `json:"status,omitempty"`
+	LatencyMS int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// LogTransport is an http.RoundTripper that appends one JSON line per request
+// to w. Headers and request and response bodies are never logged; query
+// parameters named like a token or secret are redacted.
+type LogTransport struct {
+	next    http.RoundTripper
+	account string
+	now     func() time.Time
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewLogTransport wraps next (http.DefaultTransport when nil) so every request
+// is recorded to w under the given account name. Each record is written with a
+// single Write call, so an unbuffered writer such as an *os.File opened with
+// O_APPEND keeps every completed line even if the process dies.
+func NewLogTransport(next http.RoundTripper, w io.Writer, account string) *LogTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &LogTransport{next: next, account: account, now: time.Now, w: w}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *LogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := t.now()
+	resp, err := t.next.RoundTrip(req)
+
+	rec := LogRecord{
+		Time:      start.UTC().Format(time.RFC3339Nano),
+		Account:   t.account,
+		Method:    req.Method,
+		Path:      redactedRequestPath(req.URL),
+		LatencyMS: t.now().Sub(start).Milliseconds(),
+	}
+	if resp != nil {
+		rec.Status = resp.StatusCode
+	}
+	if err != nil {
+		rec.Error = err.Error()
+	}
+	t.write(rec)
+	return resp, err
+}
+
+func (t *LogTransport) write(rec LogRecord) {
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return
+	}
+	line = append(line, '\n')
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, _ = t.w.Write(line)
+}
+
+// isSecretName reports whether a query parameter name looks sensitive.
+func isSecretName(name string) bool {
+	lower := strings.ToLower(name)
+	return strings.Contains(lower, "token") || strings.Contains(lower, "secret")
+}
+
+func redactedRequestPath(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.Path
+	}
+	q := u.Query()
+	for name := range q {
+		if isSecretName(name) {
+			q.Set(name, "[REDACTED]")
+		}
+	}
+	return u.Path + "?" + strings.ReplaceAll(q.Encode(), url.QueryEscape("[REDACTED]"), "[REDACTED]")
+}
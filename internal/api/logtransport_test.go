@@ -0,0 +1,107 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogTransport_WritesRedactedRecord(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"token":"response-secret"}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := testClient(server)
+	client.SetIdempotencyKey("idem-1")
+	client.WrapTransport(func(next http.RoundTripper) http.RoundTripper {
+		return NewLogTransport(next, &buf, "acme")
+	})
+	// Added outside the log transport, so it sees them on the request.
+	client.WrapTransport(func(next http.RoundTripper) http.RoundTripper {
+		return headerTransport{next: next, header: http.Header{
+			"Cookie":              {"session=cookie-secret"},
+			"X-Api-Key":           {"api-key-secret"},
+			"Proxy-Authorization": {"Basic proxy-secret"},
+		}}
+	})
+
+	_, err := client.Post(context.Background(), "/rest/v2/webhooks?secret=s3cret&limit=5", map[string]string{"secret": "body-secret"})
+	require.NoError(t, err)
+
+	line := buf.String()
+	assert.NotContains(t, line, "test-token")
+	assert.NotContains(t, line, "s3cret")
+	assert.NotContains(t, line, "body-secret")
+	assert.NotContains(t, line, "response-secret")
+	assert.NotContains(t, line, "cookie-secret")
+	assert.NotContains(t, line, "api-key-secret")
+	assert.NotContains(t, line, "proxy-secret")
+	assert.NotContains(t, line, "idem-1", "no headers are logged")
+
+	var rec LogRecord
+	require.NoError(t, json.Unmarshal([]byte(line), &rec))
+	assert.Equal(t, "acme", rec.Account)
+	assert.Equal(t, http.MethodPost, rec.Method)
+	assert.Equal(t, "/rest/v2/webhooks?limit=5&secret=[REDACTED]", rec.Path)
+	assert.Equal(t, http.StatusOK, rec.Status)
+	assert.NotEmpty(t, rec.Time)
+}
+
+func TestLogTransport_AppendsOneLinePerRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte("{\"existing\":true}\n"), 0o600))
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	client := testClient(server)
+	client.WrapTransport(func(next http.RoundTripper) http.RoundTripper {
+		return NewLogTransport(next, file, "acme")
+	})
+	for range 2 {
+		_, err := client.Get(context.Background(), "/test")
+		require.NoError(t, err)
+	}
+	require.NoError(t, file.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, `{"existing":true}`, lines[0])
+	for _, line := range lines[1:] {
+		var rec LogRecord
+		require.NoError(t, json.Unmarshal([]byte(line), &rec))
+		assert.Equal(t, "/test", rec.Path)
+	}
+}
+
+// headerTransport adds header to each request before passing it on.
+type headerTransport struct {
+	next   http.RoundTripper
+	header http.Header
+}
+
+func (t headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for name, values := range t.header {
+		req.Header[name] = values
+	}
+	return t.next.RoundTrip(req)
+}
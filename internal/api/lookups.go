@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 )
 
@@ -67,7 +68,7 @@ type TimeOffType struct {
 
 // ListCurrencies returns all available currencies
 func (c *Client) ListCurrencies(ctx context.Context) ([]Currency, error) {
-	resp, err := c.Get(ctx, "/rest/v2/lookups/currencies")
+	resp, err := c.getLookup(ctx, "/rest/v2/lookups/currencies")
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +82,7 @@ func (c *Client) ListCurrencies(ctx context.Context) ([]Currency, error) {
 
 // ListCountries returns all available countries
 func (c *Client) ListCountries(ctx context.Context) ([]Country, error) {
-	resp, err := c.Get(ctx, "/rest/v2/lookups/countries")
+	resp, err := c.getLookup(ctx, "/rest/v2/lookups/countries")
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +96,7 @@ func (c *Client) ListCountries(ctx context.Context) ([]Country, error) {
 
 // ListJobTitles returns all available job titles
 func (c *Client) ListJobTitles(ctx context.Context) ([]JobTitle, error) {
-	resp, err := c.Get(ctx, "/rest/v2/lookups/job-titles")
+	resp, err := c.getLookup(ctx, "/rest/v2/lookups/job-titles")
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +110,23 @@ func (c *Client) ListJobTitles(ctx context.Context) ([]JobTitle, error) {
 
 // ListSeniorityLevels returns all available seniority levels
 func (c *Client) ListSeniorityLevels(ctx context.Context) ([]SeniorityLevel, error) {
-	resp, err := c.Get(ctx, "/rest/v2/lookups/seniorities")
+	resp, err := c.getLookup(ctx, "/rest/v2/lookups/seniorities")
+	if err != nil {
+		return nil, err
+	}
+
+	levels, err := decodeData[[]SeniorityLevel](resp)
+	if err != nil {
+		return nil, err
+	}
+	return *levels, nil
+}
+
+// ListSeniorityLevelsForJob returns the seniority levels valid for a job title
+func (c *Client) ListSeniorityLevelsForJob(ctx context.Context, jobTitleID string) ([]SeniorityLevel, error) {
+	q := url.Values{}
+	q.Set("job_title_id", jobTitleID)
+	resp, err := c.getLookup(ctx, "/rest/v2/lookups/seniorities?"+q.Encode())
 	if err != nil {
 		return nil, err
 	}
@@ -92,6 +92,26 @@ func TestListSeniorityLevels(t *testing.T) {
 	assert.Equal(t, "Mid-Level", result[1].Name)
 }
 
+func TestListSeniorityLevelsForJob(t *testing.T) {
+	server := mockServerWithQuery(t, "/rest/v2/lookups/seniorities", func(t *testing.T, query map[string]string) {
+		assert.Equal(t, "jt 7", query["job_title_id"])
+	}, map[string]any{
+		"data": []map[string]any{
+			{"id": 2, "name": "Mid-Level"},
+			{"id": 3, "name": "Senior"},
+		},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.ListSeniorityLevelsForJob(context.Background(), "jt 7")
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "2", result[0].ID)
+	assert.Equal(t, "Senior", result[1].Name)
+}
+
 func TestListSeniorityLevels_NumericIDs(t *testing.T) {
 	// API returns numeric IDs - test that they're converted to strings
 	response := map[string]any{
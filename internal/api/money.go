@@ -0,0 +1,101 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Money pairs an amount with its currency. Types that carry an amount and a
+// currency in separate fields also expose them as Money in JSON output, so the
+// currency never has to be inferred from a neighbouring key.
+type Money struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+// MoneyFormat controls how Money values are rendered in JSON.
+type MoneyFormat string
+
+const (
+	// MoneyAsObject renders {"amount": 1234.56, "currency": "USD"}.
+	MoneyAsObject MoneyFormat = "object"
+	// MoneyAsString renders "1234.56 USD".
+	MoneyAsString MoneyFormat = "string"
+)
+
+// moneyFormat is process-wide because json.Marshaler has no access to the
+// command context; it is set once from --money-as before any output.
+var moneyFormat = MoneyAsObject
+
+// SetMoneyFormat selects how Money values are rendered in JSON.
+func SetMoneyFormat(format MoneyFormat) error {
+	switch format {
+	case MoneyAsObject, MoneyAsString:
+		moneyFormat = format
+		return nil
+	default:
+		return fmt.Errorf("invalid money format %q (expected object or string)", format)
+	}
+}
+
+// String formats the amount with two decimals followed by the currency.
+func (m Money) String() string {
+	return strings.TrimSpace(fmt.Sprintf("%.2f %s", m.Amount, m.Currency))
+}
+
+// MarshalJSON renders m according to the configured MoneyFormat.
+func (m Money) MarshalJSON() ([]byte, error) {
+	if moneyFormat == MoneyAsString {
+		return json.Marshal(m.String())
+	}
+	type plain Money
+	return json.Marshal(plain(m))
+}
+
+// MarshalJSON adds compensation as a Money value.
+func (c Contract) MarshalJSON() ([]byte, error) {
+	type alias Contract
+	return json.Marshal(struct {
+		alias
+		Compensation Money `json:"compensation"`
+	}{alias(c), Money{c.CompensationAmount, c.Currency}})
+}
+
+// MarshalJSON adds money as a Money value.
+func (a Adjustment) MarshalJSON() ([]byte, error) {
+	type alias Adjustment
+	return json.Marshal(struct {
+		alias
+		Money Money `json:"money"`
+	}{alias(a), Money{a.Amount, a.Currency}})
+}
+
+// MarshalJSON adds money as a Money value.
+func (w Withdrawal) MarshalJSON() ([]byte, error) {
+	type alias Withdrawal
+	return json.Marshal(struct {
+		alias
+		Money Money `json:"money"`
+	}{alias(w), Money{w.Amount, w.Currency}})
+}
+
+// MarshalJSON adds available and pending as Money values.
+func (b ContractorBalance) MarshalJSON() ([]byte, error) {
+	type alias ContractorBalance
+	return json.Marshal(struct {
+		alias
+		Available Money `json:"available"`
+		Pending   Money `json:"pending"`
+	}{alias(b), Money{b.Balance, b.Currency}, Money{b.PendingAmount, b.Currency}})
+}
+
+// MarshalJSON adds gross and net as Money values.
+func (r G2NReport) MarshalJSON() ([]byte, error) {
+	type alias G2NReport
+	return json.Marshal(struct {
+		alias
+		Gross Money `json:"gross"`
+		Net   Money `json:"net"`
+	}{alias(r), Money{r.GrossAmount, r.Currency}, Money{r.NetAmount, r.Currency}})
+}
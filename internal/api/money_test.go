@@ -0,0 +1,67 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func marshalMap(t *testing.T, v any) map[string]any {
+	t.Helper()
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(b, &out))
+	return out
+}
+
+func TestContract_MarshalJSON_Compensation(t *testing.T) {
+	var c Contract
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "c1",
+		"compensation_details": {"currency_code": "EUR", "amount": "1234.56", "scale": "monthly"}
+	}`), &c))
+
+	out := marshalMap(t, c)
+	assert.Equal(t, map[string]any{"amount": 1234.56, "currency": "EUR"}, out["compensation"])
+	// Flat fields stay for existing scripts.
+	assert.Equal(t, 1234.56, out["compensation_amount"])
+	assert.Equal(t, "EUR", out["currency"])
+
+	// Pointers and list envelopes use the same shape.
+	list := marshalMap(t, ContractsListResponse{Data: []Contract{c}})
+	first := list["data"].([]any)[0].(map[string]any)
+	assert.Equal(t, map[string]any{"amount": 1234.56, "currency": "EUR"}, first["compensation"])
+	assert.Equal(t, out, marshalMap(t, &c))
+}
+
+func TestAdjustment_MarshalJSON_Money(t *testing.T) {
+	out := marshalMap(t, Adjustment{ID: "adj-1", Amount: 250, Currency: "USD"})
+	assert.Equal(t, map[string]any{"amount": float64(250), "currency": "USD"}, out["money"])
+	assert.Equal(t, "adj-1", out["id"])
+}
+
+func TestMoney_AsString(t *testing.T) {
+	require.NoError(t, SetMoneyFormat(MoneyAsString))
+	t.Cleanup(func() { _ = SetMoneyFormat(MoneyAsObject) })
+
+	out := marshalMap(t, Adjustment{Amount: 1234.5, Currency: "USD"})
+	assert.Equal(t, "1234.50 USD", out["money"])
+
+	out = marshalMap(t, G2NReport{GrossAmount: 5000, NetAmount: 3800, Currency: "GBP"})
+	assert.Equal(t, "5000.00 GBP", out["gross"])
+	assert.Equal(t, "3800.00 GBP", out["net"])
+}
+
+func TestSetMoneyFormat_Invalid(t *testing.T) {
+	assert.Error(t, SetMoneyFormat("cents"))
+	assert.Equal(t, MoneyAsObject, moneyFormat)
+}
+
+func TestContractorBalance_MarshalJSON(t *testing.T) {
+	out := marshalMap(t, ContractorBalance{Balance: 10, PendingAmount: 2.5, Currency: "CAD"})
+	assert.Equal(t, map[string]any{"amount": float64(10), "currency": "CAD"}, out["available"])
+	assert.Equal(t, map[string]any{"amount": 2.5, "currency": "CAD"}, out["pending"])
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"net/url"
 )
 
 // Organization represents organization info
@@ -55,21 +56,50 @@ type LegalEntity struct {
 	Country            string `json:"country"`
 	Type               string `json:"type"`
 	Status             string `json:"status"`
-	RegistrationNumber string `json:"registration_number"`
+	RegistrationNumber string `json:"registration_number,omitempty"`
+}
+
+// LegalEntitiesListResponse is the response from list legal entities
+type LegalEntitiesListResponse = ListResponse[LegalEntity]
+
+// LegalEntitiesListParams are params for listing legal entities
+type LegalEntitiesListParams struct {
+	Limit  int
+	Cursor string
 }
 
-// ListLegalEntities returns legal entities
-func (c *Client) ListLegalEntities(ctx context.Context) ([]LegalEntity, error) {
-	resp, err := c.Get(ctx, "/rest/v2/legal-entities")
+// ListLegalEntities returns one page of legal entities
+func (c *Client) ListLegalEntities(ctx context.Context, params LegalEntitiesListParams) (*LegalEntitiesListResponse, error) {
+	q := url.Values{}
+	if params.Limit > 0 {
+		q.Set("limit", fmt.Sprintf("%d", params.Limit))
+	}
+	if params.Cursor != "" {
+		q.Set("cursor", params.Cursor)
+	}
+
+	path := "/rest/v2/legal-entities"
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	resp, err := c.Get(ctx, path)
 	if err != nil {
 		return nil, err
 	}
 
-	entities, err := decodeData[[]LegalEntity](resp)
+	return decodeList[LegalEntity](resp)
+}
+
+// GetLegalEntity returns a single legal entity
+func (c *Client) GetLegalEntity(ctx context.Context, id string) (*LegalEntity, error) {
+	path := fmt.Sprintf("/rest/v2/legal-entities/%s", escapePath(id))
+	resp, err := c.Get(ctx, path)
 	if err != nil {
 		return nil, err
 	}
-	return *entities, nil
+
+	return decodeData[LegalEntity](resp)
 }
 
 // CreateLegalEntityParams are params for creating a legal entity
@@ -139,3 +169,26 @@ func (c *Client) GetPayrollSettings(ctx context.Context, id string) (*PayrollSet
 
 	return decodeData[PayrollSettings](resp)
 }
+
+// UpdatePayrollSettingsParams are params for updating payroll settings.
+// Entity-specific values (tax ID, bank account) are deliberately absent so
+// settings can be copied between entities.
+type UpdatePayrollSettingsParams struct {
+	PayrollFrequency  string `json:"payroll_frequency,omitempty"`
+	PaymentMethod     string `json:"payment_method,omitempty"`
+	Currency          string `json:"currency,omitempty"`
+	PayrollProvider   string `json:"payroll_provider,omitempty"`
+	AutoApproval      bool   `json:"auto_approval"`
+	NotificationEmail string `json:"notification_email,omitempty"`
+}
+
+// UpdatePayrollSettings updates payroll settings for a legal entity
+func (c *Client) UpdatePayrollSettings(ctx context.Context, id string, params UpdatePayrollSettingsParams) (*PayrollSettings, error) {
+	path := fmt.Sprintf("/rest/v2/legal-entities/%s/payroll-settings", escapePath(id))
+	resp, err := c.Patch(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeData[PayrollSettings](resp)
+}
@@ -296,10 +296,65 @@ func TestListLegalEntities(t *testing.T) {
 	defer server.Close()
 
 	client := testClient(server)
-	result, err := client.ListLegalEntities(context.Background())
+	result, err := client.ListLegalEntities(context.Background(), LegalEntitiesListParams{})
 
 	require.NoError(t, err)
-	assert.Len(t, result, 2)
-	assert.Equal(t, "le-1", result[0].ID)
-	assert.Equal(t, "Delicious Milk Corporation", result[0].Name)
+	assert.Len(t, result.Data, 2)
+	assert.Equal(t, "le-1", result.Data[0].ID)
+	assert.Equal(t, "Delicious Milk Corporation", result.Data[0].Name)
+}
+
+func TestListLegalEntities_Pagination(t *testing.T) {
+	server := mockServerWithQuery(t, "/rest/v2/legal-entities", func(t *testing.T, query map[string]string) {
+		assert.Equal(t, "50", query["limit"])
+		assert.Equal(t, "c1", query["cursor"])
+	}, map[string]any{
+		"data": []map[string]any{{"id": "le-3", "name": "Acme GmbH", "country": "DE"}},
+		"page": map[string]any{"next": "c2"},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.ListLegalEntities(context.Background(), LegalEntitiesListParams{Limit: 50, Cursor: "c1"})
+
+	require.NoError(t, err)
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, "le-3", result.Data[0].ID)
+	assert.Equal(t, "c2", result.Page.Next)
+}
+
+func TestGetLegalEntity(t *testing.T) {
+	server := mockServer(t, "GET", "/rest/v2/legal-entities/le-123", http.StatusOK, map[string]any{
+		"data": map[string]any{"id": "le-123", "name": "Acme Corp", "country": "US", "type": "llc"},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.GetLegalEntity(context.Background(), "le-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, "le-123", result.ID)
+	assert.Equal(t, "llc", result.Type)
+}
+
+func TestUpdatePayrollSettings(t *testing.T) {
+	server := mockServerWithBody(t, "PATCH", "/rest/v2/legal-entities/le-456/payroll-settings", func(t *testing.T, body map[string]any) {
+		assert.Equal(t, "monthly", body["payroll_frequency"])
+		assert.Equal(t, "USD", body["currency"])
+		assert.Equal(t, false, body["auto_approval"])
+		assert.NotContains(t, body, "tax_id")
+		assert.NotContains(t, body, "bank_account")
+	}, http.StatusOK, map[string]any{
+		"data": map[string]any{"id": "ps-2", "legal_entity_id": "le-456", "payroll_frequency": "monthly"},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.UpdatePayrollSettings(context.Background(), "le-456", UpdatePayrollSettingsParams{
+		PayrollFrequency: "monthly",
+		Currency:         "USD",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "le-456", result.LegalEntityID)
 }
@@ -39,6 +39,8 @@ type Person struct {
 	DepartmentRaw      any          `json:"department"` // API returns string or object
 	Status             string       `json:"status"`
 	StartDate          string       `json:"start_date"`
+	TerminationDate    string       `json:"termination_date,omitempty"` // Not always returned; see Employments[].IsEnded
+	EndDate            string       `json:"end_date,omitempty"`         // Not always returned; see Employments[].IsEnded
 	Country            string       `json:"country"`
 	HiringType         string       `json:"hiring_type,omitempty"`
 	Employments        []Employment `json:"employments"`
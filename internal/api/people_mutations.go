@@ -8,11 +8,14 @@ import (
 // PersonalInfo represents personal information for a person
 type PersonalInfo struct {
 	ID          string `json:"id,omitempty"`
-	FirstName   string `json:"first_name"`
-	LastName    string `json:"last_name"`
+	FirstName   string `json:"first_name,omitempty"`
+	LastName    string `json:"last_name,omitempty"`
 	DateOfBirth string `json:"date_of_birth,omitempty"`
 	Phone       string `json:"phone,omitempty"`
 	Nationality string `json:"nationality,omitempty"`
+	// EffectiveDate schedules the change for a future date (YYYY-MM-DD);
+	// empty applies it immediately.
+	EffectiveDate string `json:"effective_date,omitempty"`
 }
 
 // WorkingLocation represents the working location of a person
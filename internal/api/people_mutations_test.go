@@ -86,6 +86,36 @@ func TestUpdatePersonalInfo(t *testing.T) {
 	assert.Equal(t, "US", result.Nationality)
 }
 
+func TestUpdatePersonalInfo_EffectiveDate(t *testing.T) {
+	server := mockServerWithBody(t, "PATCH", "/rest/v2/people/p-123/personal-info", func(t *testing.T, body map[string]any) {
+		assert.Equal(t, "Jane", body["first_name"])
+		assert.Equal(t, "2030-01-01", body["effective_date"])
+	}, http.StatusOK, map[string]any{
+		"data": map[string]any{"id": "p-123", "first_name": "Jane"},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	_, err := client.UpdatePersonalInfo(context.Background(), "p-123", PersonalInfo{
+		FirstName:     "Jane",
+		EffectiveDate: "2030-01-01",
+	})
+	require.NoError(t, err)
+}
+
+func TestUpdatePersonalInfo_OmitsUnsetNames(t *testing.T) {
+	server := mockServerWithBody(t, "PATCH", "/rest/v2/people/p-123/personal-info", func(t *testing.T, body map[string]any) {
+		assert.Equal(t, map[string]any{"phone": "+1234567890"}, body)
+	}, http.StatusOK, map[string]any{
+		"data": map[string]any{"id": "p-123", "phone": "+1234567890"},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	_, err := client.UpdatePersonalInfo(context.Background(), "p-123", PersonalInfo{Phone: "+1234567890"})
+	require.NoError(t, err)
+}
+
 func TestUpdateWorkingLocation(t *testing.T) {
 	server := mockServerWithBody(t, "PUT", "/rest/v2/people/p-123/working-location", func(t *testing.T, body map[string]any) {
 		assert.Equal(t, "US", body["country"])
@@ -165,3 +165,21 @@ func TestPeopleEndpoints_CallCorrectPaths(t *testing.T) {
 		})
 	}
 }
+
+func TestListPeople_PassesCursorAndReturnsNext(t *testing.T) {
+	server := mockServerWithQuery(t, "/rest/v2/people", func(t *testing.T, query map[string]string) {
+		assert.Equal(t, "page-2", query["cursor"])
+		assert.Equal(t, "50", query["limit"])
+	}, map[string]any{
+		"data": []map[string]any{{"id": "p1", "hris_profile_id": "p1"}},
+		"page": map[string]any{"next": "page-3", "total_rows": 120},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	resp, err := client.ListPeople(context.Background(), PeopleListParams{Limit: 50, Cursor: "page-2"})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, "page-3", resp.Page.Next)
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimitInfo holds the X-RateLimit-* headers from the most recent API response.
+type RateLimitInfo struct {
+	Limit     int
+	Remaining int
+	// Reset is when the quota window resets; zero if the header was absent.
+	Reset time.Time
+}
+
+// LastRateLimit returns the rate-limit info from the most recent response that
+// carried X-RateLimit headers. ok is false if no such response has been seen.
+func (c *Client) LastRateLimit() (info RateLimitInfo, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.rateLimit == nil {
+		return RateLimitInfo{}, false
+	}
+	return *c.rateLimit, true
+}
+
+func (c *Client) recordRateLimit(h http.Header) {
+	info, ok := parseRateLimitHeaders(h, time.Now())
+	if !ok {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rateLimit = &info
+}
+
+// parseRateLimitHeaders reads X-RateLimit-Limit, -Remaining, and -Reset.
+// Reset is accepted either as a Unix timestamp or as seconds until reset.
+func parseRateLimitHeaders(h http.Header, now time.Time) (RateLimitInfo, bool) {
+	remaining, err := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return RateLimitInfo{}, false
+	}
+	info := RateLimitInfo{Remaining: remaining}
+	if limit, err := strconv.Atoi(h.Get("X-RateLimit-Limit")); err == nil {
+		info.Limit = limit
+	}
+	if reset, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		// Values this large can only be epoch seconds, not a relative delay.
+		if reset > 1_000_000_000 {
+			info.Reset = time.Unix(reset, 0)
+		} else {
+			info.Reset = now.Add(time.Duration(reset) * time.Second)
+		}
+	}
+	return info, true
+}
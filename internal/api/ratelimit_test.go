@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_LastRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "87")
+		w.Header().Set("X-RateLimit-Reset", "1767225600")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	_, ok := client.LastRateLimit()
+	assert.False(t, ok)
+
+	_, err := client.Get(context.Background(), "/test")
+	require.NoError(t, err)
+
+	info, ok := client.LastRateLimit()
+	require.True(t, ok)
+	assert.Equal(t, 100, info.Limit)
+	assert.Equal(t, 87, info.Remaining)
+	assert.Equal(t, time.Unix(1767225600, 0), info.Reset)
+}
+
+func TestClient_LastRateLimit_UpdatedAfter429(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("X-RateLimit-Remaining", "59")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetRetryConfig(1, time.Millisecond, time.Millisecond)
+	_, err := client.Get(context.Background(), "/test")
+	require.NoError(t, err)
+
+	info, ok := client.LastRateLimit()
+	require.True(t, ok)
+	assert.Equal(t, 59, info.Remaining)
+}
+
+func TestParseRateLimitHeaders(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	h := http.Header{}
+	_, ok := parseRateLimitHeaders(h, now)
+	assert.False(t, ok)
+
+	h.Set("X-RateLimit-Remaining", "5")
+	h.Set("X-RateLimit-Reset", "30")
+	info, ok := parseRateLimitHeaders(h, now)
+	require.True(t, ok)
+	assert.Equal(t, 5, info.Remaining)
+	assert.Equal(t, 0, info.Limit)
+	assert.Equal(t, now.Add(30*time.Second), info.Reset)
+}
+
+func TestClient_RetryAfterBeyondCapAborts(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	client.SetRetryConfig(3, time.Millisecond, 2*time.Second)
+
+	start := time.Now()
+	_, err := client.Get(context.Background(), "/test")
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second, "must not sleep for the requested Retry-After")
+	assert.Equal(t, 1, calls)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+	assert.Contains(t, apiErr.Message, "1h0m0s")
+	assert.Contains(t, apiErr.Message, "--retry-max")
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	client := NewClient("test-token")
+	client.SetRetryConfig(1, 250*time.Millisecond, 10*time.Second)
+	resp := func(v string) *http.Response {
+		h := http.Header{}
+		if v != "" {
+			h.Set("Retry-After", v)
+		}
+		return &http.Response{Header: h}
+	}
+
+	d, err := client.parseRetryAfter(resp("5"))
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, d)
+
+	d, err = client.parseRetryAfter(resp("10"))
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, d, "a wait equal to the cap is honored")
+
+	d, err = client.parseRetryAfter(resp(""))
+	require.NoError(t, err)
+	assert.Equal(t, 250*time.Millisecond, d)
+
+	d, err = client.parseRetryAfter(resp("soon"))
+	require.NoError(t, err)
+	assert.Equal(t, 250*time.Millisecond, d)
+
+	_, err = client.parseRetryAfter(resp("11"))
+	assert.ErrorContains(t, err, "longer than the 10s retry cap")
+
+	_, err = client.parseRetryAfter(resp(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)))
+	assert.ErrorContains(t, err, "retry cap")
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"context"
+)
+
+// ReportParameter describes one parameter a report type accepts.
+type ReportParameter struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"` // string, date, number, integer, boolean, currency, or array
+	Required    bool     `json:"required"`
+	Description string   `json:"description,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+}
+
+// ReportSchema lists the parameters a report type accepts.
+type ReportSchema struct {
+	ReportType string            `json:"report_type"`
+	Parameters []ReportParameter `json:"parameters"`
+}
+
+// ReportRun is a submitted report generation request.
+type ReportRun struct {
+	ID          string `json:"id"`
+	ReportType  string `json:"report_type"`
+	Status      string `json:"status"`
+	CreatedAt   string `json:"created_at"`
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+// GetReportSchema returns the parameter schema for a report type.
+func (c *Client) GetReportSchema(ctx context.Context, reportType string) (*ReportSchema, error) {
+	resp, err := c.Get(ctx, "/rest/v2/reports/"+escapePath(reportType)+"/schema")
+	if err != nil {
+		return nil, err
+	}
+	return decodeData[ReportSchema](resp)
+}
+
+// RunReport submits a report of the given type with its parameters.
+func (c *Client) RunReport(ctx context.Context, reportType string, params map[string]any) (*ReportRun, error) {
+	body := wrapData(map[string]any{"parameters": params})
+	resp, err := c.Post(ctx, "/rest/v2/reports/"+escapePath(reportType), body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeData[ReportRun](resp)
+}
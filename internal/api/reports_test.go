@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReportSchema(t *testing.T) {
+	server := mockServer(t, "GET", "/rest/v2/reports/payroll-summary/schema", http.StatusOK, map[string]any{
+		"data": map[string]any{
+			"report_type": "payroll-summary",
+			"parameters": []map[string]any{
+				{"name": "start_date", "type": "date", "required": true},
+				{"name": "currency", "type": "currency"},
+			},
+		},
+	})
+	defer server.Close()
+
+	schema, err := testClient(server).GetReportSchema(context.Background(), "payroll-summary")
+	require.NoError(t, err)
+	assert.Equal(t, "payroll-summary", schema.ReportType)
+	require.Len(t, schema.Parameters, 2)
+	assert.Equal(t, ReportParameter{Name: "start_date", Type: "date", Required: true}, schema.Parameters[0])
+	assert.False(t, schema.Parameters[1].Required)
+}
+
+func TestRunReport(t *testing.T) {
+	server := mockServerWithBody(t, "POST", "/rest/v2/reports/payroll-summary", func(t *testing.T, body map[string]any) {
+		data := body["data"].(map[string]any)
+		params := data["parameters"].(map[string]any)
+		assert.Equal(t, "2026-01-01", params["start_date"])
+	}, http.StatusCreated, map[string]any{
+		"data": map[string]any{"id": "rep-1", "report_type": "payroll-summary", "status": "queued"},
+	})
+	defer server.Close()
+
+	run, err := testClient(server).RunReport(context.Background(), "payroll-summary", map[string]any{"start_date": "2026-01-01"})
+	require.NoError(t, err)
+	assert.Equal(t, "rep-1", run.ID)
+	assert.Equal(t, "queued", run.Status)
+}
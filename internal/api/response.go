@@ -23,6 +23,11 @@ type ListResponse[T any] struct {
 	Page Page `json:"page"`
 }
 
+// NextCursor returns the cursor for the next page, or "" on the last page.
+func (r ListResponse[T]) NextCursor() string {
+	return r.Page.Next
+}
+
 // DataRequest wraps a request body in a data envelope.
 type DataRequest[T any] struct {
 	Data T `json:"data"`
@@ -14,7 +14,7 @@ import (
 type Task struct {
 	ID          string  `json:"id"`
 	Title       string  `json:"title"`
-	Description string  `json:"description"`
+	Description string  `json:"description,omitempty"`
 	Amount      float64 `json:"amount"`
 	Currency    string  `json:"currency"`
 	Status      string  `json:"status"`
@@ -10,7 +10,7 @@ import (
 type Team struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
-	Description string `json:"description"`
+	Description string `json:"description,omitempty"`
 	ManagerID   string `json:"manager_id"`
 	ManagerName string `json:"manager_name"`
 	MemberCount int    `json:"member_count"`
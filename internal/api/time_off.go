@@ -1,9 +1,14 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
 	"net/url"
+	"strings"
 )
 
 // TimeOffRequest represents a time off request
@@ -14,9 +19,11 @@ type TimeOffRequest struct {
 	StartDate  string  `json:"start_date"`
 	EndDate    string  `json:"end_date"`
 	Days       float64 `json:"days"`
-	Reason     string  `json:"reason"`
+	Reason     string  `json:"reason,omitempty"`
 	WorkerName string  `json:"worker_name"`
-	PolicyName string  `json:"policy_name"`
+	PolicyName string  `json:"policy_name,omitempty"`
+	// ApproverComment is the note left by whoever approved or rejected the request.
+	ApproverComment string `json:"approver_comment,omitempty"`
 }
 
 // TimeOffListParams are params for listing time off
@@ -59,6 +66,17 @@ func (c *Client) ListTimeOffRequests(ctx context.Context, params TimeOffListPara
 	return decodeList[TimeOffRequest](resp)
 }
 
+// GetTimeOffRequest returns a single time off request
+func (c *Client) GetTimeOffRequest(ctx context.Context, id string) (*TimeOffRequest, error) {
+	path := fmt.Sprintf("/rest/v2/time-off/%s", escapePath(id))
+	resp, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeData[TimeOffRequest](resp)
+}
+
 // TimeOffPolicy represents a time off policy
 type TimeOffPolicy struct {
 	ID          string `json:"id"`
@@ -100,6 +118,50 @@ func (c *Client) CreateTimeOffRequest(ctx context.Context, params CreateTimeOffP
 	return decodeData[TimeOffRequest](resp)
 }
 
+// TimeOffAttachment is a supporting document (e.g. a medical certificate)
+// attached to a time off request.
+type TimeOffAttachment struct {
+	ID          string `json:"id"`
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type,omitempty"`
+	Size        int64  `json:"size,omitempty"`
+}
+
+// UploadTimeOffAttachment uploads a document and attaches it to a time off request.
+func (c *Client) UploadTimeOffAttachment(ctx context.Context, requestID, fileName, contentType string, data []byte) (*TimeOffAttachment, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, escapeQuotes(fileName)))
+	h.Set("Content-Type", contentType)
+	part, err := writer.CreatePart(h)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build form: %w", err)
+	}
+	if _, err := part.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to build form: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finalize form: %w", err)
+	}
+
+	path := fmt.Sprintf("/rest/v2/time-off/%s/attachments", escapePath(requestID))
+	resp, err := c.doMultipart(ctx, http.MethodPost, path, bytes.NewReader(buf.Bytes()), writer.FormDataContentType())
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeData[TimeOffAttachment](resp)
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// escapeQuotes escapes a multipart filename the same way mime/multipart does.
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
+
 // CancelTimeOffRequest cancels a time off request
 func (c *Client) CancelTimeOffRequest(ctx context.Context, requestID string) error {
 	path := fmt.Sprintf("/rest/v2/time-off/%s/cancel", escapePath(requestID))
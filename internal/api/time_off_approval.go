@@ -45,6 +45,9 @@ type ApproveRejectParams struct {
 	RequestID string `json:"request_id"`
 	Action    string `json:"action"` // "approve" or "reject"
 	Comment   string `json:"comment,omitempty"`
+	// Notify controls whether the worker is emailed about the decision; nil
+	// leaves it to the API's default.
+	Notify *bool `json:"notify,omitempty"`
 }
 
 // ValidateTimeOffParams are parameters for validating a time off request
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTimeOffRequest(t *testing.T) {
+	server := mockServer(t, "GET", "/rest/v2/time-off/req-123", http.StatusOK, map[string]any{
+		"data": map[string]any{
+			"id":               "req-123",
+			"status":           "rejected",
+			"type":             "vacation",
+			"start_date":       "2026-03-02",
+			"end_date":         "2026-03-06",
+			"days":             5,
+			"reason":           "Family trip",
+			"worker_name":      "Jane Smith",
+			"approver_comment": "Overlaps the release freeze",
+		},
+	})
+	defer server.Close()
+
+	client := testClient(server)
+	result, err := client.GetTimeOffRequest(context.Background(), "req-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, "req-123", result.ID)
+	assert.Equal(t, "rejected", result.Status)
+	assert.Equal(t, 5.0, result.Days)
+	assert.Equal(t, "Jane Smith", result.WorkerName)
+	assert.Equal(t, "Overlaps the release freeze", result.ApproverComment)
+}
+
+func TestGetTimeOffRequest_NotFound(t *testing.T) {
+	server := mockServer(t, "GET", "/rest/v2/time-off/missing", http.StatusNotFound, map[string]string{"error": "not found"})
+	defer server.Close()
+
+	client := testClient(server)
+	_, err := client.GetTimeOffRequest(context.Background(), "missing")
+
+	require.Error(t, err)
+	apiErr, ok := err.(*APIError)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
@@ -24,7 +24,7 @@ type TimesheetEntry struct {
 	TimesheetID string  `json:"timesheet_id"`
 	Date        string  `json:"date"`
 	Hours       float64 `json:"hours"`
-	Description string  `json:"description"`
+	Description string  `json:"description,omitempty"`
 }
 
 // TimesheetsListParams are parameters for listing timesheets
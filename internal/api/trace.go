@@ -0,0 +1,61 @@
+package api
+
+import (
+	"io"
+	"log/slog"
+	"time"
+)
+
+// TraceSummary totals the requests recorded while tracing is enabled.
+type TraceSummary struct {
+	Requests int
+	// APITime is the summed latency of every attempt, including retries.
+	APITime time.Duration
+}
+
+// SetTrace writes one structured line per request attempt to w: method,
+// path, status, response bytes, attempt number, and latency. A nil w turns
+// tracing off.
+func (c *Client) SetTrace(w io.Writer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if w == nil {
+		c.tracer = nil
+		return
+	}
+	c.tracer = slog.New(slog.NewTextHandler(w, nil))
+}
+
+// Trace returns the totals recorded since tracing was enabled.
+func (c *Client) Trace() TraceSummary {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.traceSummary
+}
+
+// traceAttempt records one attempt. status is 0 when no response arrived.
+func (c *Client) traceAttempt(method, path string, attempt, status, bytes int, start time.Time, err error) {
+	latency := time.Since(start)
+	c.mu.Lock()
+	tracer := c.tracer
+	if tracer != nil {
+		c.traceSummary.Requests++
+		c.traceSummary.APITime += latency
+	}
+	c.mu.Unlock()
+	if tracer == nil {
+		return
+	}
+	attrs := []any{
+		"method", method,
+		"path", path,
+		"status", status,
+		"bytes", bytes,
+		"attempt", attempt + 1,
+		"latency", latency.Round(time.Millisecond),
+	}
+	if err != nil {
+		attrs = append(attrs, "error", err.Error())
+	}
+	tracer.Info("api request", attrs...)
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_TraceLogsEachAttempt(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := testClient(server)
+	client.SetRetryConfig(2, time.Millisecond, time.Millisecond)
+	client.SetTrace(&buf)
+
+	_, err := client.Get(context.Background(), "/rest/v2/people")
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], "method=GET path=/rest/v2/people status=503 bytes=0 attempt=1")
+	assert.Contains(t, lines[1], "method=GET path=/rest/v2/people status=200 bytes=11 attempt=2")
+	assert.Contains(t, lines[1], "latency=")
+
+	summary := client.Trace()
+	assert.Equal(t, 2, summary.Requests)
+}
+
+func TestClient_TraceDisabledByDefault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := testClient(server)
+	_, err := client.Get(context.Background(), "/test")
+	require.NoError(t, err)
+	assert.Equal(t, TraceSummary{}, client.Trace())
+}
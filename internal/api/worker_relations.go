@@ -11,9 +11,9 @@ type WorkerRelation struct {
 	ProfileID    string `json:"profile_id"`
 	ManagerID    string `json:"manager_id"`
 	RelationType string `json:"relation_type"` // "direct_report", "dotted_line"
-	StartDate    string `json:"start_date"`
+	StartDate    string `json:"start_date,omitempty"`
 	EndDate      string `json:"end_date,omitempty"`
-	Status       string `json:"status"`
+	Status       string `json:"status,omitempty"`
 	CreatedAt    string `json:"created_at"`
 }
 
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// UseCaseScopes maps each CLI command group to the Deel token scopes its
+// commands need. 'deel auth login --intended-use' recommends the union for the
+// groups a user plans to run, so they can create a least-privilege token.
+var UseCaseScopes = map[string][]string{
+	"ats":               {"ats:read", "ats:write"},
+	"background-checks": {"background-checks:read", "background-checks:write"},
+	"benefits":          {"benefits:read"},
+	"calc":              {"organizations:read"},
+	"candidates":        {"candidates:write"},
+	"compliance":        {"contracts:read"},
+	"contracts":         {"contracts:read", "contracts:write"},
+	"cost-centers":      {"organizations:read", "organizations:write"},
+	"eor":               {"contracts:read", "contracts:write", "people:read", "people:write"},
+	"gp":                {"global-payroll:read", "global-payroll:write"},
+	"immigration":       {"immigration:read", "immigration:write"},
+	"invoices":          {"invoice-adjustments:read", "invoice-adjustments:write", "accounting:read"},
+	"it":                {"it-assets:read"},
+	"milestones":        {"milestones:read", "milestones:write"},
+	"offboarding":       {"people:read", "contracts:write"},
+	"onboarding":        {"people:read"},
+	"org":               {"organizations:read", "organizations:write", "groups:read"},
+	"payments":          {"accounting:read"},
+	"payouts":           {"accounting:read", "accounting:write"},
+	"payroll":           {"payslips:read", "accounting:read"},
+	"people":            {"people:read", "people:write"},
+	"reports":           {"accounting:read"},
+	"screenings":        {"screenings:read", "screenings:write"},
+	"shifts":            {"timesheets:read", "timesheets:write"},
+	"tasks":             {"tasks:read", "tasks:write"},
+	"teams":             {"groups:read", "groups:write"},
+	"time-off":          {"time-off:read", "time-off:write"},
+	"timesheets":        {"timesheets:read", "timesheets:write"},
+	"tokens":            {"worker:write"},
+	"webhooks":          {"webhooks:read", "webhooks:write"},
+}
+
+// RecommendedScopes returns the sorted, de-duplicated scopes needed for uses.
+// A use is a command group name, optionally suffixed with ":read" to keep only
+// that group's read scopes (e.g. "contracts:read").
+func RecommendedScopes(uses []string) ([]string, error) {
+	seen := map[string]bool{}
+	var scopes []string
+	for _, use := range uses {
+		use = strings.ToLower(strings.TrimSpace(use))
+		if use == "" {
+			continue
+		}
+		group, readOnly := strings.CutSuffix(use, ":read")
+		groupScopes, ok := UseCaseScopes[group]
+		if !ok {
+			return nil, fmt.Errorf("unknown intended use %q (valid: %s)", use, strings.Join(UseCases(), ", "))
+		}
+		for _, s := range groupScopes {
+			if readOnly && !strings.HasSuffix(s, ":read") {
+				continue
+			}
+			if !seen[s] {
+				seen[s] = true
+				scopes = append(scopes, s)
+			}
+		}
+	}
+	sort.Strings(scopes)
+	return scopes, nil
+}
+
+// UseCases lists the known command groups in sorted order.
+func UseCases() []string {
+	out := make([]string, 0, len(UseCaseScopes))
+	for k := range UseCaseScopes {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRecommendedScopes(t *testing.T) {
+	tests := []struct {
+		uses []string
+		want []string
+	}{
+		{nil, nil},
+		{[]string{"contracts", "time-off"}, []string{"contracts:read", "contracts:write", "time-off:read", "time-off:write"}},
+		{[]string{"people:read", "People:Read"}, []string{"people:read"}},
+		{[]string{"timesheets", "shifts:read"}, []string{"timesheets:read", "timesheets:write"}},
+	}
+	for _, tt := range tests {
+		got, err := RecommendedScopes(tt.uses)
+		if err != nil {
+			t.Fatalf("RecommendedScopes(%v): %v", tt.uses, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("RecommendedScopes(%v) = %v, want %v", tt.uses, got, tt.want)
+		}
+	}
+}
+
+func TestRecommendedScopes_Unknown(t *testing.T) {
+	_, err := RecommendedScopes([]string{"contracts", "bogus"})
+	if err == nil || !strings.Contains(err.Error(), `"bogus"`) {
+		t.Fatalf("err = %v, want unknown intended use", err)
+	}
+}
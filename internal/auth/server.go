@@ -9,6 +9,7 @@ import (
 	"flag"
 	"fmt"
 	"html/template"
+	"io"
 	"log/slog"
 	"net"
 	"net/http"
@@ -142,6 +143,21 @@ func ValidateAccountName(name string) error {
 	return nil
 }
 
+// ValidateProfileName validates the name of an account's token profile. The
+// rules match account names.
+func ValidateProfileName(name string) error {
+	if len(name) == 0 {
+		return fmt.Errorf("profile name cannot be empty")
+	}
+	if len(name) > 64 {
+		return fmt.Errorf("profile name too long (max 64 characters)")
+	}
+	if !validAccountName.MatchString(name) {
+		return fmt.Errorf("profile name contains invalid characters (use only letters, numbers, dash, underscore)")
+	}
+	return nil
+}
+
 // ValidateToken validates a Deel PAT token
 func ValidateToken(token string) error {
 	if len(token) == 0 {
@@ -170,7 +186,9 @@ func SanitizeToken(token string) string {
 // SetupResult contains the result of a browser-based setup
 type SetupResult struct {
 	AccountName string
-	Error       error
+	// Profile is the token profile the token was saved under, if any.
+	Profile string
+	Error   error
 }
 
 // ServerMode defines the mode of operation for the setup server
@@ -195,6 +213,7 @@ type SetupServer struct {
 	store         secrets.Store
 	limiter       *rateLimiter
 	mode          ServerMode
+	scopes        []string
 }
 
 // NewSetupServer creates a new setup server
@@ -225,6 +244,12 @@ func NewSetupServerWithMode(store secrets.Store, mode ServerMode) (*SetupServer,
 	}, nil
 }
 
+// SetRecommendedScopes lists token scopes on the success page so the user can
+// check their token is no broader than needed (see RecommendedScopes).
+func (s *SetupServer) SetRecommendedScopes(scopes []string) {
+	s.scopes = scopes
+}
+
 // Start starts the setup server and opens the browser
 func (s *SetupServer) Start(ctx context.Context) (*SetupResult, error) {
 	// Ensure cleanup goroutine is stopped when server exits
@@ -459,6 +484,7 @@ func (s *SetupServer) handleSubmit(w http.ResponseWriter, r *http.Request) {
 
 	var req struct {
 		AccountName string `json:"account_name"`
+		Profile     string `json:"profile"`
 		Token       string `json:"token"`
 	}
 
@@ -472,6 +498,7 @@ func (s *SetupServer) handleSubmit(w http.ResponseWriter, r *http.Request) {
 
 	// Normalize inputs
 	req.AccountName = strings.ToLower(strings.TrimSpace(req.AccountName))
+	req.Profile = strings.ToLower(strings.TrimSpace(req.Profile))
 	req.Token = SanitizeToken(req.Token)
 
 	// Validate input format
@@ -482,6 +509,15 @@ func (s *SetupServer) handleSubmit(w http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	if req.Profile != "" {
+		if err := ValidateProfileName(req.Profile); err != nil {
+			writeJSON(w, http.StatusOK, map[string]any{
+				"success": false,
+				"error":   err.Error(),
+			})
+			return
+		}
+	}
 	if err := ValidateToken(req.Token); err != nil {
 		writeJSON(w, http.StatusOK, map[string]any{
 			"success": false,
@@ -499,10 +535,13 @@ func (s *SetupServer) handleSubmit(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Save to keychain
-	err := s.store.Set(req.AccountName, secrets.Credentials{
-		Token: req.Token,
-	})
+	// Save to keychain, keeping the account's other tokens
+	var err error
+	if req.Profile != "" {
+		err = secrets.SetProfile(s.store, req.AccountName, req.Profile, req.Token)
+	} else {
+		err = secrets.SetToken(s.store, req.AccountName, req.Token)
+	}
 	if err != nil {
 		writeJSON(w, http.StatusOK, map[string]any{
 			"success": false,
@@ -515,6 +554,7 @@ func (s *SetupServer) handleSubmit(w http.ResponseWriter, r *http.Request) {
 	s.pendingMu.Lock()
 	s.pendingResult = &SetupResult{
 		AccountName: req.AccountName,
+		Profile:     req.Profile,
 	}
 	s.pendingMu.Unlock()
 
@@ -526,12 +566,6 @@ func (s *SetupServer) handleSubmit(w http.ResponseWriter, r *http.Request) {
 
 // handleSuccess serves the success page
 func (s *SetupServer) handleSuccess(w http.ResponseWriter, r *http.Request) {
-	tmpl, err := template.New("success").Parse(successTemplate)
-	if err != nil {
-		http.Error(w, "Internal error", http.StatusInternalServerError)
-		return
-	}
-
 	// Use server state instead of URL parameter to prevent spoofing
 	s.pendingMu.Lock()
 	accountName := ""
@@ -540,9 +574,13 @@ func (s *SetupServer) handleSuccess(w http.ResponseWriter, r *http.Request) {
 	}
 	s.pendingMu.Unlock()
 
-	data := map[string]string{
-		"AccountName": accountName,
-		"CSRFToken":   s.csrfToken,
+	// handleSubmit already validated the name; check again so nothing outside
+	// the allowed charset can reach the page even if that path changes.
+	if accountName != "" {
+		if err := ValidateAccountName(accountName); err != nil {
+			slog.Warn("refusing to render invalid account name on success page", "error", err)
+			accountName = ""
+		}
 	}
 
 	// Set security headers
@@ -552,11 +590,25 @@ func (s *SetupServer) handleSuccess(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("X-Frame-Options", "DENY")
 	w.Header().Set("Referrer-Policy", "no-referrer")
 
-	if err := tmpl.Execute(w, data); err != nil {
+	if err := renderSuccessPage(w, accountName, s.csrfToken, s.scopes); err != nil {
 		slog.Error("success template execution failed", "error", err)
 	}
 }
 
+// renderSuccessPage executes the success template. html/template escapes
+// AccountName for each context it appears in.
+func renderSuccessPage(w io.Writer, accountName, csrfToken string, scopes []string) error {
+	tmpl, err := template.New("success").Parse(successTemplate)
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(w, map[string]any{
+		"AccountName": accountName,
+		"CSRFToken":   csrfToken,
+		"Scopes":      scopes,
+	})
+}
+
 // handleComplete signals that setup is done
 func (s *SetupServer) handleComplete(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -574,6 +626,14 @@ func (s *SetupServer) handleComplete(w http.ResponseWriter, r *http.Request) {
 	// Limit request body size to prevent memory exhaustion
 	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodySize)
 
+	s.signalComplete()
+	writeJSON(w, http.StatusOK, map[string]any{"success": true})
+}
+
+// signalComplete delivers the pending result and closes the shutdown channel.
+// It is safe to call more than once (e.g. a double-clicked "Done" button);
+// only the first call has any effect.
+func (s *SetupServer) signalComplete() {
 	s.shutdownOnce.Do(func() {
 		s.pendingMu.Lock()
 		if s.pendingResult != nil {
@@ -582,7 +642,6 @@ func (s *SetupServer) handleComplete(w http.ResponseWriter, r *http.Request) {
 		s.pendingMu.Unlock()
 		close(s.shutdown)
 	})
-	writeJSON(w, http.StatusOK, map[string]any{"success": true})
 }
 
 // handleListAccounts returns all stored accounts
@@ -602,10 +661,17 @@ func (s *SetupServer) handleListAccounts(w http.ResponseWriter, r *http.Request)
 
 	accounts := make([]map[string]any, 0, len(creds))
 	for _, c := range creds {
-		accounts = append(accounts, map[string]any{
+		account := map[string]any{
 			"name":      c.Name,
 			"createdAt": c.CreatedAt.Format(time.RFC3339),
-		})
+		}
+		if len(c.Metadata) > 0 {
+			account["metadata"] = c.Metadata
+		}
+		if len(c.Profiles) > 0 {
+			account["profiles"] = c.ProfileNames()
+		}
+		accounts = append(accounts, account)
 	}
 
 	writeJSON(w, http.StatusOK, map[string]any{
@@ -1263,6 +1329,10 @@ var setupTemplate = `<!DOCTYPE html>
                         <input type="text" id="accountName" placeholder="Account name (e.g., production)" required>
                         <p class="hint">Local label only - pick any name to identify this account</p>
                     </div>
+                    <div class="form-group">
+                        <input type="text" id="profileName" placeholder="Profile (optional, e.g., readonly)">
+                        <p class="hint">Leave empty for the account's default token, or name an extra token for the same account</p>
+                    </div>
                     <div class="form-group">
                         <input type="password" id="token" placeholder="Deel API Token" required>
                         <p class="hint">Create a token in the <a href="https://app.deel.com/developer-center" target="_blank">Deel Developer Center</a></p>
@@ -1296,6 +1366,7 @@ var setupTemplate = `<!DOCTYPE html>
         const closeSetupBtn = document.getElementById('closeSetupBtn');
         const form = document.getElementById('setupForm');
         const accountNameInput = document.getElementById('accountName');
+        const profileNameInput = document.getElementById('profileName');
         const tokenInput = document.getElementById('token');
         const testBtn = document.getElementById('testBtn');
         const saveBtn = document.getElementById('saveBtn');
@@ -1347,6 +1418,8 @@ var setupTemplate = `<!DOCTYPE html>
                         '<div class="account-info">' +
                         '<div class="account-name">' + safeName + '</div>' +
                         '<div class="account-date">Added ' + dateStr + '</div>' +
+                        (acc.metadata ? '<div class="account-date">' + Object.keys(acc.metadata).sort().map(k => escapeHtml(k) + ': ' + escapeHtml(acc.metadata[k])).join(' · ') + '</div>' : '') +
+                        (acc.profiles ? '<div class="account-date">Profiles: ' + acc.profiles.map(escapeHtml).join(', ') + '</div>' : '') +
                         '</div>' +
                         '<button class="remove-btn" onclick="removeAccount(' + JSON.stringify(acc.name) + ')" title="Remove account">' +
                         '<svg viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2"><line x1="18" y1="6" x2="6" y2="18"/><line x1="6" y1="6" x2="18" y2="18"/></svg>' +
@@ -1425,7 +1498,7 @@ var setupTemplate = `<!DOCTYPE html>
                 const resp = await fetch('/validate', {
                     method: 'POST',
                     headers: { 'Content-Type': 'application/json', 'X-CSRF-Token': csrfToken },
-                    body: JSON.stringify({ account_name: accountNameInput.value.trim(), token: tokenInput.value.trim() })
+                    body: JSON.stringify({ account_name: accountNameInput.value.trim(), profile: profileNameInput.value.trim(), token: tokenInput.value.trim() })
                 });
                 const data = await resp.json();
                 if (data.success) {
@@ -1452,7 +1525,7 @@ var setupTemplate = `<!DOCTYPE html>
                 const resp = await fetch('/submit', {
                     method: 'POST',
                     headers: { 'Content-Type': 'application/json', 'X-CSRF-Token': csrfToken },
-                    body: JSON.stringify({ account_name: accountNameInput.value.trim(), token: tokenInput.value.trim() })
+                    body: JSON.stringify({ account_name: accountNameInput.value.trim(), profile: profileNameInput.value.trim(), token: tokenInput.value.trim() })
                 });
                 const data = await resp.json();
                 if (data.success) {
@@ -1856,6 +1929,11 @@ var successTemplate = `<!DOCTYPE html>
             <div class="tip-box">
                 <strong>Pro tip:</strong> Set <code>export DEEL_ACCOUNT={{.AccountName}}</code> to skip the <code>--account</code> flag.
             </div>
+            {{if .Scopes}}<div class="tip-box">
+                <strong>Least privilege:</strong> for your intended use this token only needs
+                {{range $i, $scope := .Scopes}}{{if $i}}, {{end}}<code>{{$scope}}</code>{{end}}.
+                If it has more, create a narrower token in Deel and run <code>deel auth login</code> again.
+            </div>{{end}}
         </div>
 
         <div class="footer">
@@ -2381,6 +2459,10 @@ var manageTemplate = `<!DOCTYPE html>
                         <input type="text" id="accountName" placeholder="Account name (e.g., production)" required>
                         <p class="hint">Local label only - pick any name to identify this account</p>
                     </div>
+                    <div class="form-group">
+                        <input type="text" id="profileName" placeholder="Profile (optional, e.g., readonly)">
+                        <p class="hint">Leave empty for the account's default token, or name an extra token for the same account</p>
+                    </div>
                     <div class="form-group">
                         <input type="password" id="token" placeholder="Deel API Token" required>
                         <p class="hint">Create a token in the <a href="https://app.deel.com/developer-center" target="_blank">Deel Developer Center</a></p>
@@ -2414,6 +2496,7 @@ var manageTemplate = `<!DOCTYPE html>
         const closeSetupBtn = document.getElementById('closeSetupBtn');
         const form = document.getElementById('setupForm');
         const accountNameInput = document.getElementById('accountName');
+        const profileNameInput = document.getElementById('profileName');
         const tokenInput = document.getElementById('token');
         const testBtn = document.getElementById('testBtn');
         const saveBtn = document.getElementById('saveBtn');
@@ -2465,6 +2548,8 @@ var manageTemplate = `<!DOCTYPE html>
                         '<div class="account-info">' +
                         '<div class="account-name">' + safeName + '</div>' +
                         '<div class="account-date">Added ' + dateStr + '</div>' +
+                        (acc.metadata ? '<div class="account-date">' + Object.keys(acc.metadata).sort().map(k => escapeHtml(k) + ': ' + escapeHtml(acc.metadata[k])).join(' · ') + '</div>' : '') +
+                        (acc.profiles ? '<div class="account-date">Profiles: ' + acc.profiles.map(escapeHtml).join(', ') + '</div>' : '') +
                         '</div>' +
                         '<button class="remove-btn" onclick="removeAccount(' + JSON.stringify(acc.name) + ')" title="Remove account">' +
                         '<svg viewBox="0 0 24 24" fill="none" stroke="currentColor" stroke-width="2"><line x1="18" y1="6" x2="6" y2="18"/><line x1="6" y1="6" x2="18" y2="18"/></svg>' +
@@ -2543,7 +2628,7 @@ var manageTemplate = `<!DOCTYPE html>
                 const resp = await fetch('/validate', {
                     method: 'POST',
                     headers: { 'Content-Type': 'application/json', 'X-CSRF-Token': csrfToken },
-                    body: JSON.stringify({ account_name: accountNameInput.value.trim(), token: tokenInput.value.trim() })
+                    body: JSON.stringify({ account_name: accountNameInput.value.trim(), profile: profileNameInput.value.trim(), token: tokenInput.value.trim() })
                 });
                 const data = await resp.json();
                 if (data.success) {
@@ -2570,7 +2655,7 @@ var manageTemplate = `<!DOCTYPE html>
                 const resp = await fetch('/submit', {
                     method: 'POST',
                     headers: { 'Content-Type': 'application/json', 'X-CSRF-Token': csrfToken },
-                    body: JSON.stringify({ account_name: accountNameInput.value.trim(), token: tokenInput.value.trim() })
+                    body: JSON.stringify({ account_name: accountNameInput.value.trim(), profile: profileNameInput.value.trim(), token: tokenInput.value.trim() })
                 });
                 const data = await resp.json();
                 if (data.success) {
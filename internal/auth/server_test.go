@@ -1,8 +1,13 @@
 package auth
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/salmonumbrella/deel-cli/internal/secrets"
 )
 
 func TestValidateAccountName(t *testing.T) {
@@ -22,6 +27,9 @@ func TestValidateAccountName(t *testing.T) {
 		{"invalid space", "my account", true},
 		{"invalid special char", "account!", true},
 		{"invalid dot", "my.account", true},
+		{"invalid html", "</script><img src=x onerror=alert(1)>", true},
+		{"invalid quote", "prod'", true},
+		{"invalid trailing newline", "prod\n", true},
 	}
 
 	for _, tt := range tests {
@@ -140,3 +148,228 @@ func TestRateLimiter(t *testing.T) {
 		}
 	})
 }
+
+// memStore is an in-memory secrets.Store for handler tests.
+type memStore struct {
+	creds map[string]secrets.Credentials
+}
+
+func (m *memStore) Keys() ([]string, error) {
+	return nil, nil
+}
+
+func (m *memStore) Set(name string, creds secrets.Credentials) error {
+	m.creds[name] = creds
+	return nil
+}
+
+func (m *memStore) Get(name string) (secrets.Credentials, error) {
+	return m.creds[name], nil
+}
+
+func (m *memStore) Delete(name string) error {
+	delete(m.creds, name)
+	return nil
+}
+
+func (m *memStore) List() ([]secrets.Credentials, error) {
+	return nil, nil
+}
+
+func (m *memStore) SetMetadata(name string, metadata map[string]string) error {
+	c := m.creds[name]
+	c.Metadata = metadata
+	m.creds[name] = c
+	return nil
+}
+
+func newTestSetupServer(t *testing.T) (*SetupServer, *memStore) {
+	t.Helper()
+	store := &memStore{creds: map[string]secrets.Credentials{}}
+	s, err := NewSetupServer(store)
+	if err != nil {
+		t.Fatalf("NewSetupServer: %v", err)
+	}
+	t.Cleanup(func() { close(s.stopCleanup) })
+	return s, store
+}
+
+func TestHandleComplete_DoubleSubmit(t *testing.T) {
+	s, _ := newTestSetupServer(t)
+	s.pendingResult = &SetupResult{AccountName: "prod"}
+
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(http.MethodPost, "/complete", nil)
+		req.Header.Set("X-CSRF-Token", s.csrfToken)
+		rec := httptest.NewRecorder()
+		s.handleComplete(rec, req) // must not panic on the second call
+		if rec.Code != http.StatusOK {
+			t.Fatalf("call %d: status = %d, want 200", i+1, rec.Code)
+		}
+	}
+
+	if got := len(s.result); got != 1 {
+		t.Fatalf("results delivered = %d, want 1", got)
+	}
+	if r := <-s.result; r.AccountName != "prod" {
+		t.Errorf("AccountName = %q, want %q", r.AccountName, "prod")
+	}
+	select {
+	case <-s.shutdown:
+	default:
+		t.Error("shutdown channel not closed")
+	}
+}
+
+func TestHandleComplete_InvalidCSRF(t *testing.T) {
+	s, _ := newTestSetupServer(t)
+	s.pendingResult = &SetupResult{AccountName: "prod"}
+
+	req := httptest.NewRequest(http.MethodPost, "/complete", nil)
+	req.Header.Set("X-CSRF-Token", "not-the-token")
+	rec := httptest.NewRecorder()
+	s.handleComplete(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want 403", rec.Code)
+	}
+	if got := len(s.result); got != 0 {
+		t.Fatalf("results delivered = %d, want 0", got)
+	}
+}
+
+func TestHandleSubmit_RejectsDifferentCSRFToken(t *testing.T) {
+	s, store := newTestSetupServer(t)
+	other, _ := newTestSetupServer(t)
+
+	body := strings.NewReader(`{"account_name":"prod","token":"abc123"}`)
+	req := httptest.NewRequest(http.MethodPost, "/submit", body)
+	req.Header.Set("X-CSRF-Token", other.csrfToken)
+	rec := httptest.NewRecorder()
+	s.handleSubmit(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want 403", rec.Code)
+	}
+	if len(store.creds) != 0 {
+		t.Errorf("credentials saved despite invalid CSRF token")
+	}
+	if s.pendingResult != nil {
+		t.Errorf("pending result set despite invalid CSRF token")
+	}
+}
+
+func TestRenderSuccessPage_RecommendedScopes(t *testing.T) {
+	var none, scoped strings.Builder
+	if err := renderSuccessPage(&none, "prod", "csrf-token", nil); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if err := renderSuccessPage(&scoped, "prod", "csrf-token", []string{"contracts:read", "people:read"}); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(none.String(), "Least privilege") {
+		t.Errorf("scope tip shown without recommended scopes")
+	}
+	for _, s := range []string{"Least privilege", "contracts:read", "people:read"} {
+		if !strings.Contains(scoped.String(), s) {
+			t.Errorf("page missing %q", s)
+		}
+	}
+}
+
+const xssAccountName = `</script><img src=x onerror=alert(1)>"'`
+
+func TestRenderSuccessPage_EscapesAccountName(t *testing.T) {
+	var clean, dirty strings.Builder
+	if err := renderSuccessPage(&clean, "prod", "csrf-token", nil); err != nil {
+		t.Fatalf("render clean: %v", err)
+	}
+	if err := renderSuccessPage(&dirty, xssAccountName, "csrf-token", nil); err != nil {
+		t.Fatalf("render dirty: %v", err)
+	}
+	page := dirty.String()
+
+	for _, raw := range []string{"<img", "</script><", `"'`, "onerror=alert(1)>"} {
+		if strings.Contains(page, raw) {
+			t.Errorf("page contains unescaped %q", raw)
+		}
+	}
+	if !strings.Contains(page, "&lt;/script&gt;&lt;img src=x onerror=alert(1)&gt;") {
+		t.Errorf("escaped account name not found in page")
+	}
+	// The name must not open or close any script block.
+	for _, tag := range []string{"<script", "</script>"} {
+		if got, want := strings.Count(page, tag), strings.Count(clean.String(), tag); got != want {
+			t.Errorf("%s count = %d, want %d", tag, got, want)
+		}
+	}
+	// The inline script keeps its literal CSRF token and never sees the name.
+	if !strings.Contains(page, "'X-CSRF-Token': 'csrf-token'") {
+		t.Errorf("CSRF header missing from inline script")
+	}
+}
+
+func TestHandleSuccess_DropsInvalidStoredAccountName(t *testing.T) {
+	s, _ := newTestSetupServer(t)
+	s.pendingResult = &SetupResult{AccountName: xssAccountName}
+
+	rec := httptest.NewRecorder()
+	s.handleSuccess(rec, httptest.NewRequest(http.MethodGet, "/success", nil))
+
+	body := rec.Body.String()
+	if strings.Contains(body, "onerror") {
+		t.Errorf("invalid account name rendered on success page")
+	}
+	if got := rec.Header().Get("Content-Security-Policy"); got == "" {
+		t.Errorf("Content-Security-Policy header missing")
+	}
+}
+
+func TestHandleSuccess_RendersValidAccountName(t *testing.T) {
+	s, _ := newTestSetupServer(t)
+	s.pendingResult = &SetupResult{AccountName: "prod-eu"}
+
+	rec := httptest.NewRecorder()
+	s.handleSuccess(rec, httptest.NewRequest(http.MethodGet, "/success", nil))
+
+	if !strings.Contains(rec.Body.String(), "--account prod-eu") {
+		t.Errorf("account name missing from success page")
+	}
+}
+
+func TestHandleSubmit_RejectsMarkupInAccountName(t *testing.T) {
+	s, store := newTestSetupServer(t)
+
+	body := strings.NewReader(`{"account_name":"<img src=x onerror=alert(1)>","token":"abc123"}`)
+	req := httptest.NewRequest(http.MethodPost, "/submit", body)
+	req.Header.Set("X-CSRF-Token", s.csrfToken)
+	rec := httptest.NewRecorder()
+	s.handleSubmit(rec, req)
+
+	if !strings.Contains(rec.Body.String(), "invalid characters") {
+		t.Errorf("body = %s, want invalid characters error", rec.Body.String())
+	}
+	if len(store.creds) != 0 {
+		t.Errorf("credentials saved for invalid account name")
+	}
+	if s.pendingResult != nil {
+		t.Errorf("pending result set for invalid account name")
+	}
+}
+
+func TestHandleSubmit_RejectsInvalidProfileName(t *testing.T) {
+	s, store := newTestSetupServer(t)
+
+	body := strings.NewReader(`{"account_name":"prod","profile":"read only!","token":"abc123"}`)
+	req := httptest.NewRequest(http.MethodPost, "/submit", body)
+	req.Header.Set("X-CSRF-Token", s.csrfToken)
+	rec := httptest.NewRecorder()
+	s.handleSubmit(rec, req)
+
+	if !strings.Contains(rec.Body.String(), "profile name contains invalid characters") {
+		t.Errorf("body = %s, want invalid profile name error", rec.Body.String())
+	}
+	if len(store.creds) != 0 {
+		t.Errorf("credentials saved for invalid profile name")
+	}
+}
@@ -0,0 +1,252 @@
+package batch
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kind describes the typed value a CSV column should be coerced into.
+type Kind int
+
+const (
+	// KindString keeps the cell as-is (trimmed).
+	KindString Kind = iota
+	// KindBool accepts true/false, yes/no, y/n, and 1/0 (case-insensitive).
+	KindBool
+	// KindInt accepts base-10 integers.
+	KindInt
+	// KindFloat accepts decimal numbers; thousands separators are rejected.
+	KindFloat
+	// KindDate accepts YYYY-MM-DD dates.
+	KindDate
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindBool:
+		return "boolean"
+	case KindInt:
+		return "integer"
+	case KindFloat:
+		return "number"
+	case KindDate:
+		return "date (YYYY-MM-DD)"
+	default:
+		return "string"
+	}
+}
+
+// CellError reports a coercion failure for a single CSV cell.
+// Row numbers match spreadsheet line numbers: the header is row 1.
+type CellError struct {
+	Row    int
+	Column string
+	Value  string
+	Kind   Kind
+}
+
+func (e *CellError) Error() string {
+	return fmt.Sprintf("row %d, column %q: invalid %s %q", e.Row, e.Column, e.Kind, e.Value)
+}
+
+// Row is a single CSV record keyed by header name.
+type Row struct {
+	Line    int
+	columns []string
+	values  map[string]string
+}
+
+// String returns the trimmed cell value for column, or "" if absent.
+func (r Row) String(column string) string {
+	return strings.TrimSpace(r.values[column])
+}
+
+// Bool coerces the cell to a boolean. Empty cells return false.
+func (r Row) Bool(column string) (bool, error) {
+	v := r.String(column)
+	if v == "" {
+		return false, nil
+	}
+	b, ok := parseBool(v)
+	if !ok {
+		return false, r.cellError(column, v, KindBool)
+	}
+	return b, nil
+}
+
+// Int coerces the cell to an int. Empty cells return 0.
+func (r Row) Int(column string) (int, error) {
+	v := r.String(column)
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, r.cellError(column, v, KindInt)
+	}
+	return n, nil
+}
+
+// Float coerces the cell to a float64. Empty cells return 0.
+func (r Row) Float(column string) (float64, error) {
+	v := r.String(column)
+	if v == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, r.cellError(column, v, KindFloat)
+	}
+	return f, nil
+}
+
+// Date validates the cell as a YYYY-MM-DD date. Empty cells return "".
+func (r Row) Date(column string) (string, error) {
+	v := r.String(column)
+	if v == "" {
+		return "", nil
+	}
+	if _, err := time.Parse("2006-01-02", v); err != nil {
+		return "", r.cellError(column, v, KindDate)
+	}
+	return v, nil
+}
+
+// Coerce converts the row to a JSON object, typing each column according to kinds.
+// Columns not listed in kinds are kept as strings; empty cells are omitted.
+func (r Row) Coerce(kinds map[string]Kind) (map[string]any, error) {
+	out := make(map[string]any, len(r.values))
+	for _, column := range r.columns {
+		if r.String(column) == "" {
+			continue
+		}
+		var (
+			v   any
+			err error
+		)
+		switch kinds[column] {
+		case KindBool:
+			v, err = r.Bool(column)
+		case KindInt:
+			v, err = r.Int(column)
+		case KindFloat:
+			v, err = r.Float(column)
+		case KindDate:
+			v, err = r.Date(column)
+		default:
+			v = r.String(column)
+		}
+		if err != nil {
+			return nil, err
+		}
+		out[column] = v
+	}
+	return out, nil
+}
+
+func (r Row) cellError(column, value string, kind Kind) error {
+	return &CellError{Row: r.Line, Column: column, Value: value, Kind: kind}
+}
+
+func parseBool(v string) (bool, bool) {
+	switch strings.ToLower(v) {
+	case "true", "yes", "y", "1":
+		return true, true
+	case "false", "no", "n", "0":
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// ReadCSV reads CSV rows from a file (use "-" for stdin). The first record is the header.
+func ReadCSV(filename string) ([]Row, error) {
+	var reader io.Reader
+	if filename == "-" {
+		reader = os.Stdin
+	} else {
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open file: %w", err)
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				return
+			}
+		}()
+		reader = f
+	}
+	data, err := io.ReadAll(io.LimitReader(reader, MaxInputSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input: %w", err)
+	}
+	if len(data) > MaxInputSize {
+		return nil, fmt.Errorf("file too large: exceeds %d bytes", MaxInputSize)
+	}
+	return parseCSV(bytes.NewReader(data))
+}
+
+func parseCSV(reader io.Reader) ([]Row, error) {
+	cr := csv.NewReader(reader)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	for i, h := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))
+	}
+
+	var rows []Row
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse CSV: %w", err)
+		}
+		if len(rows) >= MaxItemCount {
+			return nil, fmt.Errorf("too many items: exceeds %d", MaxItemCount)
+		}
+		line, _ := cr.FieldPos(0)
+		values := make(map[string]string, len(header))
+		for i, h := range header {
+			if i < len(record) {
+				values[h] = record[i]
+			}
+		}
+		rows = append(rows, Row{Line: line, columns: header, values: values})
+	}
+	return rows, nil
+}
+
+// CSVItems coerces CSV rows into batch items using the given column kinds,
+// so CSV and JSON inputs can share the same importer code path.
+func CSVItems(rows []Row, kinds map[string]Kind) ([]Item, error) {
+	items := make([]Item, 0, len(rows))
+	for _, row := range rows {
+		obj, err := row.Coerce(kinds)
+		if err != nil {
+			return nil, err
+		}
+		raw, err := json.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", row.Line, err)
+		}
+		items = append(items, Item{raw: raw})
+	}
+	return items, nil
+}
@@ -0,0 +1,106 @@
+package batch
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCSV_CoerceTypes(t *testing.T) {
+	input := "name,salary,is_primary,break_minutes,start_date\n" +
+		"Alice,85000.50,yes,30,2026-01-15\n" +
+		"Bob,,0,,\n"
+
+	rows, err := parseCSV(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, 2, rows[0].Line)
+
+	salary, err := rows[0].Float("salary")
+	require.NoError(t, err)
+	assert.Equal(t, 85000.50, salary)
+
+	primary, err := rows[0].Bool("is_primary")
+	require.NoError(t, err)
+	assert.True(t, primary)
+
+	breakMinutes, err := rows[0].Int("break_minutes")
+	require.NoError(t, err)
+	assert.Equal(t, 30, breakMinutes)
+
+	start, err := rows[0].Date("start_date")
+	require.NoError(t, err)
+	assert.Equal(t, "2026-01-15", start)
+
+	primary, err = rows[1].Bool("is_primary")
+	require.NoError(t, err)
+	assert.False(t, primary)
+}
+
+func TestParseBool_AcceptedValues(t *testing.T) {
+	for _, v := range []string{"true", "TRUE", "yes", "Y", "1"} {
+		b, ok := parseBool(v)
+		assert.True(t, ok, v)
+		assert.True(t, b, v)
+	}
+	for _, v := range []string{"false", "No", "n", "0"} {
+		b, ok := parseBool(v)
+		assert.True(t, ok, v)
+		assert.False(t, b, v)
+	}
+}
+
+func TestRow_BadBoolean(t *testing.T) {
+	rows, err := parseCSV(strings.NewReader("name,is_primary\nAlice,true\nBob,maybe\n"))
+	require.NoError(t, err)
+
+	_, err = rows[1].Bool("is_primary")
+	require.Error(t, err)
+
+	var cellErr *CellError
+	require.True(t, errors.As(err, &cellErr))
+	assert.Equal(t, 3, cellErr.Row)
+	assert.Equal(t, "is_primary", cellErr.Column)
+	assert.Equal(t, "maybe", cellErr.Value)
+	assert.Contains(t, err.Error(), `row 3, column "is_primary"`)
+}
+
+func TestCSVItems_NonNumericSalary(t *testing.T) {
+	rows, err := parseCSV(strings.NewReader("email,salary\na@example.com,1000\nb@example.com,lots\n"))
+	require.NoError(t, err)
+
+	_, err = CSVItems(rows, map[string]Kind{"salary": KindFloat})
+	require.Error(t, err)
+
+	var cellErr *CellError
+	require.True(t, errors.As(err, &cellErr))
+	assert.Equal(t, 3, cellErr.Row)
+	assert.Equal(t, "salary", cellErr.Column)
+	assert.Contains(t, err.Error(), "invalid number")
+}
+
+func TestCSVItems_TypedJSON(t *testing.T) {
+	rows, err := parseCSV(strings.NewReader("email,salary,is_primary,notes\na@example.com,1000,no,\n"))
+	require.NoError(t, err)
+
+	items, err := CSVItems(rows, map[string]Kind{"salary": KindFloat, "is_primary": KindBool})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.JSONEq(t, `{"email":"a@example.com","salary":1000,"is_primary":false}`, string(items[0].Raw()))
+}
+
+func TestReadCSV_TooLarge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "big.csv")
+	data := append([]byte("name\n"), bytes.Repeat([]byte("x\n"), MaxInputSize/2)...)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	_, err := ReadCSV(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "file too large")
+}
@@ -0,0 +1,58 @@
+package batch
+
+import (
+	"context"
+	"sync"
+)
+
+// Run calls fn for each index in [0, n) using at most concurrency goroutines
+// and returns the results in index order, regardless of completion order.
+// Once ctx is cancelled no further items are started; those items are
+// reported as failed with ctx.Err(). fn must be safe for concurrent use.
+func Run(ctx context.Context, n, concurrency int, fn func(ctx context.Context, i int) (any, error)) []Result {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	results := make([]Result, n)
+	for i := range results {
+		results[i].Index = i
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(concurrency, n); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					results[i].Error = err
+					continue
+				}
+				data, err := fn(ctx, i)
+				results[i] = Result{Index: i, Success: err == nil, Data: data, Error: err}
+			}
+		}()
+	}
+
+	next := 0
+dispatch:
+	for ; next < n; next++ {
+		// Check first so a cancelled context never races a ready worker.
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case indexes <- next:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	for i := next; i < n; i++ {
+		results[i].Error = ctx.Err()
+	}
+	return results
+}
@@ -0,0 +1,79 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_ResultsInInputOrder(t *testing.T) {
+	results := Run(context.Background(), 5, 3, func(ctx context.Context, i int) (any, error) {
+		// Later items finish first.
+		time.Sleep(time.Duration(5-i) * time.Millisecond)
+		if i == 2 {
+			return nil, errors.New("boom")
+		}
+		return i * 10, nil
+	})
+
+	require.Len(t, results, 5)
+	for i, r := range results {
+		assert.Equal(t, i, r.Index)
+		if i == 2 {
+			assert.False(t, r.Success)
+			assert.EqualError(t, r.Error, "boom")
+			continue
+		}
+		assert.True(t, r.Success)
+		assert.Equal(t, i*10, r.Data)
+	}
+}
+
+func TestRun_BoundsConcurrency(t *testing.T) {
+	var running, peak atomic.Int32
+	Run(context.Background(), 20, 4, func(ctx context.Context, i int) (any, error) {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+		running.Add(-1)
+		return nil, nil
+	})
+	assert.LessOrEqual(t, peak.Load(), int32(4))
+	assert.Greater(t, peak.Load(), int32(1))
+}
+
+func TestRun_StopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	results := Run(ctx, 10, 1, func(ctx context.Context, i int) (any, error) {
+		calls.Add(1)
+		if i == 2 {
+			cancel()
+		}
+		return nil, nil
+	})
+
+	assert.Equal(t, int32(3), calls.Load())
+	assert.True(t, results[2].Success)
+	for _, r := range results[3:] {
+		assert.False(t, r.Success)
+		assert.ErrorIs(t, r.Error, context.Canceled)
+	}
+}
+
+func TestRun_Empty(t *testing.T) {
+	assert.Empty(t, Run(context.Background(), 0, 4, func(ctx context.Context, i int) (any, error) {
+		t.Fatal("fn called for empty input")
+		return nil, nil
+	}))
+}
@@ -32,6 +32,17 @@ const (
 	CategoryConfig
 )
 
+// Retryable reports whether errors in this category are transient, so the
+// same request may succeed if retried later.
+func (c Category) Retryable() bool {
+	switch c {
+	case CategoryRateLimit, CategoryServer, CategoryNetwork:
+		return true
+	default:
+		return false
+	}
+}
+
 // CLIError wraps any error with context and suggestions
 type CLIError struct {
 	Operation   string
@@ -113,3 +113,12 @@ func TestWrap_NilError(t *testing.T) {
 	wrapped := Wrap(nil, "any operation")
 	assert.Nil(t, wrapped)
 }
+
+func TestCategory_Retryable(t *testing.T) {
+	for _, c := range []Category{CategoryRateLimit, CategoryServer, CategoryNetwork} {
+		assert.True(t, c.Retryable(), "category %v", c)
+	}
+	for _, c := range []Category{CategoryUnknown, CategoryAuth, CategoryForbidden, CategoryNotFound, CategoryValidation, CategoryConfig} {
+		assert.False(t, c.Retryable(), "category %v", c)
+	}
+}
@@ -3,11 +3,13 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/salmonumbrella/deel-cli/internal/api"
 	"github.com/salmonumbrella/deel-cli/internal/dryrun"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
 )
 
 var atsCmd = &cobra.Command{
@@ -34,18 +36,32 @@ var (
 	atsJobLocationIDFlag     string
 	atsJobEmploymentTypeFlag string
 	atsJobDescriptionFlag    string
+	// Candidate creation flags
+	atsCandidateFirstNameFlag string
+	atsCandidateLastNameFlag  string
+	atsCandidateEmailFlag     string
+	atsCandidatePhoneFlag     string
+	atsCandidateLocationFlag  string
+	// Offer creation flags
+	atsOfferCandidateIDFlag  string
+	atsOfferJobIDFlag        string
+	atsOfferSalaryFlag       string
+	atsOfferCurrencyFlag     string
+	atsOfferSkipCurrencyFlag bool
+	atsOfferStartDateFlag    string
 )
 
 var atsOffersCmd = &cobra.Command{
 	Use:   "offers",
-	Short: "List ATS offers",
+	Short: "List, get, and create ATS offers",
+	Args:  cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		client, f, err := initClient("listing ats offers")
 		if err != nil {
 			return err
 		}
 
-		offers, page, hasMore, err := collectCursorItems(cmd.Context(), atsAllFlag, atsCursorFlag, atsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ATSOffer], error) {
+		offers, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), atsAllFlag, atsCursorFlag, atsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ATSOffer], error) {
 			resp, err := client.ListATSOffers(ctx, api.ATSOffersListParams{
 				Status: atsStatusFlag,
 				Limit:  limit,
@@ -75,6 +91,113 @@ var atsOffersCmd = &cobra.Command{
 	},
 }
 
+func printATSOffer(f *outfmt.Formatter, o *api.ATSOffer) {
+	f.PrintText("ID:          " + o.ID)
+	f.PrintText("Candidate:   " + o.Candidate)
+	f.PrintText("Position:    " + o.Position)
+	f.PrintText(fmt.Sprintf("Salary:      %.2f %s", o.Salary, o.Currency))
+	f.PrintText("Start Date:  " + o.StartDate)
+	f.PrintText("Status:      " + o.Status)
+}
+
+var atsOffersGetCmd = &cobra.Command{
+	Use:   "get <offer-id>",
+	Short: "Get an offer",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, f, err := initClient("getting ats offer")
+		if err != nil {
+			return err
+		}
+
+		offer, err := client.GetATSOffer(api.WithServerFields(cmd.Context()), args[0])
+		if err != nil {
+			return HandleError(f, err, "getting ats offer")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			printATSOffer(f, offer)
+		}, offer)
+	},
+}
+
+var atsOffersCreateCmd = &cobra.Command{
+	Use:     "create",
+	Short:   "Create an offer",
+	Long:    "Create a new ATS offer. Requires --candidate-id, --job-id, --salary, and --currency flags.",
+	Example: "  deel ats offers create --candidate-id cand-1 --job-id job-1 --salary 120000 --currency EUR --start-date 2026-11-01",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+
+		if atsOfferCandidateIDFlag == "" {
+			return failValidation(cmd, f, "--candidate-id flag is required")
+		}
+		if atsOfferJobIDFlag == "" {
+			return failValidation(cmd, f, "--job-id flag is required")
+		}
+		if atsOfferSalaryFlag == "" {
+			return failValidation(cmd, f, "--salary flag is required")
+		}
+		if atsOfferCurrencyFlag == "" {
+			return failValidation(cmd, f, "--currency flag is required")
+		}
+		salary, err := parsePositiveAmount(atsOfferSalaryFlag, "--salary")
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+		if err := validateCurrency(atsOfferCurrencyFlag); err != nil {
+			return failValidation(cmd, f, "--currency: "+err.Error())
+		}
+		currency := strings.ToUpper(atsOfferCurrencyFlag)
+		if atsOfferStartDateFlag != "" {
+			if err := validateDate(atsOfferStartDateFlag); err != nil {
+				return failValidation(cmd, f, "--start-date: "+err.Error())
+			}
+		}
+
+		if err := checkCurrency(cmd.Context(), f, getClient, currency, atsOfferSkipCurrencyFlag); err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
+		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
+			Operation:   "CREATE",
+			Resource:    "ATSOffer",
+			Description: "Create ATS offer",
+			Details: map[string]string{
+				"CandidateID": atsOfferCandidateIDFlag,
+				"JobID":       atsOfferJobIDFlag,
+				"Salary":      fmt.Sprintf("%.2f %s", salary, currency),
+				"StartDate":   atsOfferStartDateFlag,
+			},
+		}); ok {
+			return err
+		}
+
+		client, err := getClient()
+		if err != nil {
+			return HandleError(f, err, "initializing client")
+		}
+
+		offer, err := client.CreateATSOffer(cmd.Context(), api.CreateATSOfferParams{
+			CandidateID: atsOfferCandidateIDFlag,
+			JobID:       atsOfferJobIDFlag,
+			Salary:      salary,
+			Currency:    currency,
+			StartDate:   atsOfferStartDateFlag,
+		})
+		if err != nil {
+			return HandleError(f, err, "create offer")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			f.PrintSuccess("Offer created successfully")
+			f.PrintText("ID:          " + offer.ID)
+			f.PrintText("Status:      " + offer.Status)
+		}, offer)
+	},
+}
+
 // Jobs commands
 var atsJobsCmd = &cobra.Command{
 	Use:   "jobs",
@@ -90,7 +213,7 @@ var atsJobsListCmd = &cobra.Command{
 			return err
 		}
 
-		jobs, page, hasMore, err := collectCursorItems(cmd.Context(), atsAllFlag, atsCursorFlag, atsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ATSJob], error) {
+		jobs, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), atsAllFlag, atsCursorFlag, atsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ATSJob], error) {
 			resp, err := client.ListATSJobs(ctx, api.ATSJobsListParams{
 				Status:       atsStatusFlag,
 				DepartmentID: atsDepartmentIDFlag,
@@ -199,7 +322,7 @@ var atsPostingsListCmd = &cobra.Command{
 			return err
 		}
 
-		postings, page, hasMore, err := collectCursorItems(cmd.Context(), atsAllFlag, atsCursorFlag, atsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ATSJobPosting], error) {
+		postings, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), atsAllFlag, atsCursorFlag, atsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ATSJobPosting], error) {
 			resp, err := client.ListATSJobPostings(ctx, api.ATSJobPostingsListParams{
 				Status: atsStatusFlag,
 				JobID:  atsJobIDFlag,
@@ -240,7 +363,7 @@ var atsPostingsGetCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		posting, err := client.GetATSJobPosting(cmd.Context(), args[0])
+		posting, err := client.GetATSJobPosting(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "get job posting")
 		}
@@ -282,7 +405,7 @@ var atsApplicationsListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		apps, page, hasMore, err := collectCursorItems(cmd.Context(), atsAllFlag, atsCursorFlag, atsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ATSApplication], error) {
+		apps, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), atsAllFlag, atsCursorFlag, atsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ATSApplication], error) {
 			resp, err := client.ListATSApplications(ctx, api.ATSApplicationsListParams{
 				Status:      atsStatusFlag,
 				JobID:       atsJobIDFlag,
@@ -330,7 +453,7 @@ var atsCandidatesListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		candidates, page, hasMore, err := collectCursorItems(cmd.Context(), atsAllFlag, atsCursorFlag, atsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ATSCandidate], error) {
+		candidates, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), atsAllFlag, atsCursorFlag, atsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ATSCandidate], error) {
 			resp, err := client.ListATSCandidates(ctx, api.ATSCandidatesListParams{
 				Search: atsSearchFlag,
 				Limit:  limit,
@@ -353,10 +476,103 @@ var atsCandidatesListCmd = &cobra.Command{
 
 		response := makeListResponse(candidates, page)
 
-		return outputList(cmd, f, candidates, hasMore, "No candidates found.", []string{"ID", "NAME", "EMAIL", "PHONE", "LOCATION"}, func(c api.ATSCandidate) []string {
-			name := fmt.Sprintf("%s %s", c.FirstName, c.LastName)
-			return []string{c.ID, name, c.Email, c.Phone, c.Location}
-		}, response)
+		return outputList(cmd, f, candidates, hasMore, "No candidates found.", atsCandidateColumns, atsCandidateRow, response)
+	},
+}
+
+// atsCandidateColumns and atsCandidateRow are shared by candidate list, get
+// and create so all three render the same table.
+var atsCandidateColumns = []string{"ID", "NAME", "EMAIL", "PHONE", "LOCATION"}
+
+func atsCandidateRow(c api.ATSCandidate) []string {
+	name := fmt.Sprintf("%s %s", c.FirstName, c.LastName)
+	return []string{c.ID, name, c.Email, c.Phone, c.Location}
+}
+
+func printATSCandidate(f *outfmt.Formatter, c *api.ATSCandidate) {
+	table := f.NewTable(atsCandidateColumns...)
+	table.AddRow(atsCandidateRow(*c)...)
+	table.Render()
+}
+
+var atsCandidatesGetCmd = &cobra.Command{
+	Use:   "get <candidate-id>",
+	Short: "Get a candidate",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, f, err := initClient("getting ats candidate")
+		if err != nil {
+			return err
+		}
+
+		candidate, err := client.GetATSCandidate(api.WithServerFields(cmd.Context()), args[0])
+		if err != nil {
+			return HandleError(f, err, "getting ats candidate")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			printATSCandidate(f, candidate)
+		}, candidate)
+	},
+}
+
+var atsCandidatesCreateCmd = &cobra.Command{
+	Use:     "create",
+	Short:   "Create a candidate",
+	Long:    "Create a new ATS candidate. Requires --first-name, --last-name, and --email flags.",
+	Example: "  deel ats candidates create --first-name Ada --last-name Lovelace --email ada@example.com --location London",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+
+		if atsCandidateFirstNameFlag == "" {
+			return failValidation(cmd, f, "--first-name flag is required")
+		}
+		if atsCandidateLastNameFlag == "" {
+			return failValidation(cmd, f, "--last-name flag is required")
+		}
+		if atsCandidateEmailFlag == "" {
+			return failValidation(cmd, f, "--email flag is required")
+		}
+		if err := validateEmail(atsCandidateEmailFlag); err != nil {
+			return failValidation(cmd, f, "--email: "+err.Error())
+		}
+
+		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
+			Operation:   "CREATE",
+			Resource:    "ATSCandidate",
+			Description: "Create ATS candidate",
+			Details: map[string]string{
+				"FirstName": atsCandidateFirstNameFlag,
+				"LastName":  atsCandidateLastNameFlag,
+				"Email":     atsCandidateEmailFlag,
+				"Phone":     atsCandidatePhoneFlag,
+				"Location":  atsCandidateLocationFlag,
+			},
+		}); ok {
+			return err
+		}
+
+		client, err := getClient()
+		if err != nil {
+			return HandleError(f, err, "initializing client")
+		}
+
+		candidate, err := client.CreateATSCandidate(cmd.Context(), api.CreateATSCandidateParams{
+			FirstName: atsCandidateFirstNameFlag,
+			LastName:  atsCandidateLastNameFlag,
+			Email:     atsCandidateEmailFlag,
+			Phone:     atsCandidatePhoneFlag,
+			Location:  atsCandidateLocationFlag,
+		})
+		if err != nil {
+			return HandleError(f, err, "create candidate")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			f.PrintSuccess("Candidate created successfully")
+			printATSCandidate(f, candidate)
+		}, candidate)
 	},
 }
 
@@ -376,7 +592,7 @@ var atsDepartmentsListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		departments, page, hasMore, err := collectCursorItems(cmd.Context(), atsAllFlag, atsCursorFlag, atsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ATSDepartment], error) {
+		departments, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), atsAllFlag, atsCursorFlag, atsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ATSDepartment], error) {
 			resp, err := client.ListATSDepartments(ctx, api.ATSDepartmentsListParams{
 				Limit:  limit,
 				Cursor: cursor,
@@ -425,7 +641,7 @@ var atsLocationsListCmd = &cobra.Command{
 			remotePtr = &atsRemoteFlag
 		}
 
-		locations, page, hasMore, err := collectCursorItems(cmd.Context(), atsAllFlag, atsCursorFlag, atsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ATSLocation], error) {
+		locations, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), atsAllFlag, atsCursorFlag, atsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ATSLocation], error) {
 			resp, err := client.ListATSLocations(ctx, api.ATSLocationsListParams{
 				Remote: remotePtr,
 				Limit:  limit,
@@ -474,7 +690,7 @@ var atsRejectionReasonsListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		reasons, err := client.ListRejectionReasons(cmd.Context())
+		reasons, err := client.ListRejectionReasons(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "list rejection reasons")
 		}
@@ -504,6 +720,14 @@ func init() {
 	atsOffersCmd.Flags().StringVar(&atsCursorFlag, "cursor", "", "Pagination cursor")
 	atsOffersCmd.Flags().BoolVar(&atsAllFlag, "all", false, "Fetch all pages")
 
+	// Offers create command flags
+	atsOffersCreateCmd.Flags().StringVar(&atsOfferCandidateIDFlag, "candidate-id", "", "Candidate ID (required)")
+	atsOffersCreateCmd.Flags().StringVar(&atsOfferJobIDFlag, "job-id", "", "Job ID (required)")
+	atsOffersCreateCmd.Flags().StringVar(&atsOfferSalaryFlag, "salary", "", "Salary amount (required)")
+	atsOffersCreateCmd.Flags().StringVar(&atsOfferCurrencyFlag, "currency", "", "Salary currency, e.g. USD (required)")
+	atsOffersCreateCmd.Flags().StringVar(&atsOfferStartDateFlag, "start-date", "", "Start date YYYY-MM-DD (optional)")
+	atsOffersCreateCmd.Flags().BoolVar(&atsOfferSkipCurrencyFlag, "skip-currency-check", false, "Skip checking --currency against the supported currency list (e.g. offline)")
+
 	// Jobs list command flags
 	atsJobsListCmd.Flags().StringVar(&atsStatusFlag, "status", "", "Filter by status")
 	atsJobsListCmd.Flags().StringVar(&atsDepartmentIDFlag, "department-id", "", "Filter by department ID")
@@ -541,6 +765,13 @@ func init() {
 	atsCandidatesListCmd.Flags().StringVar(&atsCursorFlag, "cursor", "", "Pagination cursor")
 	atsCandidatesListCmd.Flags().BoolVar(&atsAllFlag, "all", false, "Fetch all pages")
 
+	// Candidates create command flags
+	atsCandidatesCreateCmd.Flags().StringVar(&atsCandidateFirstNameFlag, "first-name", "", "First name (required)")
+	atsCandidatesCreateCmd.Flags().StringVar(&atsCandidateLastNameFlag, "last-name", "", "Last name (required)")
+	atsCandidatesCreateCmd.Flags().StringVar(&atsCandidateEmailFlag, "email", "", "Email address (required)")
+	atsCandidatesCreateCmd.Flags().StringVar(&atsCandidatePhoneFlag, "phone", "", "Phone number (optional)")
+	atsCandidatesCreateCmd.Flags().StringVar(&atsCandidateLocationFlag, "location", "", "Location (optional)")
+
 	// Departments list command flags
 	atsDepartmentsListCmd.Flags().IntVar(&atsLimitFlag, "limit", 100, "Maximum results")
 	atsDepartmentsListCmd.Flags().StringVar(&atsCursorFlag, "cursor", "", "Pagination cursor")
@@ -562,6 +793,8 @@ func init() {
 	atsApplicationsCmd.AddCommand(atsApplicationsListCmd)
 
 	atsCandidatesCmd.AddCommand(atsCandidatesListCmd)
+	atsCandidatesCmd.AddCommand(atsCandidatesGetCmd)
+	atsCandidatesCmd.AddCommand(atsCandidatesCreateCmd)
 
 	atsDepartmentsCmd.AddCommand(atsDepartmentsListCmd)
 
@@ -570,6 +803,8 @@ func init() {
 	atsRejectionReasonsCmd.AddCommand(atsRejectionReasonsListCmd)
 
 	// Add all commands to ats root command
+	atsOffersCmd.AddCommand(atsOffersGetCmd)
+	atsOffersCmd.AddCommand(atsOffersCreateCmd)
 	atsCmd.AddCommand(atsOffersCmd)
 	atsCmd.AddCommand(atsJobsCmd)
 	atsCmd.AddCommand(atsPostingsCmd)
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+)
+
+func TestATSCandidateRow_MatchesListColumns(t *testing.T) {
+	row := atsCandidateRow(api.ATSCandidate{ID: "c1", FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com", Location: "London"})
+	assert.Len(t, row, len(atsCandidateColumns))
+	assert.Equal(t, []string{"c1", "Ada Lovelace", "ada@example.com", "", "London"}, row)
+}
+
+func TestATSCandidatesCreateCmd_RejectsInvalidEmail(t *testing.T) {
+	defer func() {
+		atsCandidateFirstNameFlag, atsCandidateLastNameFlag, atsCandidateEmailFlag = "", "", ""
+	}()
+
+	rootCmd.SetArgs([]string{"ats", "candidates", "create", "--first-name", "Ada", "--last-name", "Lovelace", "--email", "ada-at-example.com"})
+	err := rootCmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `--email: invalid email "ada-at-example.com"`)
+}
+
+func TestATSOffersCreateCmd_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "zero salary", args: []string{"--salary", "0", "--currency", "USD"}, want: "--salary must be greater than zero"},
+		{name: "bad currency", args: []string{"--salary", "100", "--currency", "DOLLARS"}, want: `--currency: invalid currency code "DOLLARS"`},
+		{name: "bad start date", args: []string{"--salary", "100", "--currency", "USD", "--start-date", "11/01/2026"}, want: "--start-date: invalid date format"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				atsOfferCandidateIDFlag, atsOfferJobIDFlag, atsOfferSalaryFlag, atsOfferCurrencyFlag, atsOfferStartDateFlag = "", "", "", "", ""
+			}()
+
+			rootCmd.SetArgs(append([]string{"ats", "offers", "create", "--candidate-id", "cand-1", "--job-id", "job-1"}, tt.args...))
+			err := rootCmd.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/salmonumbrella/deel-cli/internal/api"
 	"github.com/salmonumbrella/deel-cli/internal/auth"
+	"github.com/salmonumbrella/deel-cli/internal/config"
 	"github.com/salmonumbrella/deel-cli/internal/secrets"
 )
 
@@ -21,13 +24,27 @@ var authCmd = &cobra.Command{
 	Long:  "Authenticate with Deel and manage stored credentials.",
 }
 
+var authLoginIntendedUseFlag []string
+
 var authLoginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "Authenticate via browser",
-	Long:  "Opens a browser window to securely enter your Deel Personal Access Token.",
+	Long: `Opens a browser window to securely enter your Deel Personal Access Token.
+
+--intended-use lists the command groups you plan to run (e.g. contracts,time-off)
+and prints the token scopes they need, so you can create a least-privilege token
+in Deel. Add ":read" to a group to only count its read scopes.`,
+	Example: `  deel auth login
+  deel auth login --intended-use contracts,time-off
+  deel auth login --intended-use people:read,timesheets`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 
+		scopes, err := auth.RecommendedScopes(resolveIntendedUses(authLoginIntendedUseFlag))
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
 		store, err := secrets.OpenDefault()
 		if err != nil {
 			return HandleError(f, err, "open credential store")
@@ -37,7 +54,13 @@ var authLoginCmd = &cobra.Command{
 		if err != nil {
 			return HandleError(f, err, "start auth server")
 		}
+		server.SetRecommendedScopes(scopes)
 
+		if len(scopes) > 0 {
+			f.PrintText("Create your token with only these scopes:")
+			f.PrintText("  " + strings.Join(scopes, ", "))
+			f.PrintText("")
+		}
 		f.PrintText("Opening browser for authentication...")
 		f.PrintText("If the browser doesn't open, navigate to the URL shown.")
 		f.PrintText("")
@@ -47,30 +70,77 @@ var authLoginCmd = &cobra.Command{
 			return HandleError(f, err, "auth login")
 		}
 
+		ref := result.AccountName
+		if result.Profile != "" {
+			ref += "/" + result.Profile
+		}
 		return f.OutputFiltered(cmd.Context(), func() {
-			f.PrintSuccess("Successfully authenticated as %q", result.AccountName)
+			f.PrintSuccess("Successfully authenticated as %q", ref)
 			f.PrintText("")
 			f.PrintText("Test your connection with:")
-			f.PrintText("  deel auth test --account " + result.AccountName)
+			f.PrintText("  deel auth test --account " + ref)
+			if len(scopes) > 0 {
+				f.PrintText("")
+				f.PrintText("Recommended token scopes: " + strings.Join(scopes, ", "))
+			}
 		}, map[string]any{
-			"authenticated": true,
-			"account":       result.AccountName,
+			"authenticated":      true,
+			"account":            result.AccountName,
+			"profile":            result.Profile,
+			"recommended_scopes": scopes,
 		})
 	},
 }
 
+// resolveIntendedUses maps command group aliases (pto, visa, ...) in uses to
+// their group names, keeping any ":read" suffix. Unknown names pass through
+// so auth.RecommendedScopes can reject them.
+func resolveIntendedUses(uses []string) []string {
+	out := make([]string, 0, len(uses))
+	for _, use := range uses {
+		use = strings.ToLower(strings.TrimSpace(use))
+		name, suffix := use, ""
+		if base, ok := strings.CutSuffix(use, ":read"); ok {
+			name, suffix = base, ":read"
+		}
+		for _, c := range rootCmd.Commands() {
+			if c.HasAlias(name) {
+				name = c.Name()
+				break
+			}
+		}
+		out = append(out, name+suffix)
+	}
+	return out
+}
+
 var authAddCmd = &cobra.Command{
 	Use:   "add <name>",
 	Short: "Add credentials manually",
-	Long:  "Add a Deel account by entering your Personal Access Token at the prompt.",
-	Args:  cobra.ExactArgs(1),
+	Long: `Add a Deel account by entering your Personal Access Token at the prompt.
+
+To keep several tokens for the same account (e.g. read-only and admin), add
+each as a named profile with --profile or a "name/profile" argument. The
+account's default token and other profiles are kept.`,
+	Example: `  deel auth add prod
+  deel auth add prod --profile readonly
+  deel auth add prod/admin`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
-		accountName := strings.ToLower(strings.TrimSpace(args[0]))
+		accountName, profile, err := resolveProfile(args[0])
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
 
 		if err := auth.ValidateAccountName(accountName); err != nil {
 			return failValidation(cmd, f, fmt.Sprintf("Invalid account name: %v", err))
 		}
+		if profile != "" {
+			if err := auth.ValidateProfileName(profile); err != nil {
+				return failValidation(cmd, f, fmt.Sprintf("Invalid profile name: %v", err))
+			}
+		}
 
 		// Prompt for token
 		f.PrintText("Enter your Deel Personal Access Token:")
@@ -114,18 +184,25 @@ var authAddCmd = &cobra.Command{
 		}
 		f.PrintSuccess("Token validated successfully")
 
-		err = store.Set(accountName, secrets.Credentials{
-			Token: token,
-		})
+		if profile != "" {
+			err = secrets.SetProfile(store, accountName, profile, token)
+		} else {
+			err = secrets.SetToken(store, accountName, token)
+		}
 		if err != nil {
 			return HandleError(f, err, "save credentials")
 		}
 
 		return f.OutputFiltered(cmd.Context(), func() {
+			if profile != "" {
+				f.PrintSuccess("Credentials saved for account %q, profile %q", accountName, profile)
+				return
+			}
 			f.PrintSuccess("Credentials saved for account %q", accountName)
 		}, map[string]any{
 			"saved":   true,
 			"account": accountName,
+			"profile": profile,
 		})
 	},
 }
@@ -155,49 +232,226 @@ var authListCmd = &cobra.Command{
 		}
 
 		return f.OutputFiltered(cmd.Context(), func() {
-			table := f.NewTable("NAME", "CREATED")
+			table := f.NewTable("NAME", "PROFILES", "CREATED", "METADATA")
 			for _, c := range creds {
 				created := "unknown"
 				if !c.CreatedAt.IsZero() {
 					created = c.CreatedAt.Format(time.RFC3339)
 				}
-				table.AddRow(c.Name, created)
+				table.AddRow(c.Name, formatProfiles(c), created, formatMetadata(c.Metadata))
 			}
 			table.Render()
 		}, creds)
 	},
 }
 
+// formatProfiles lists an account's tokens for auth list: "-" for a single
+// default token, otherwise the profile names, with "(default)" first when the
+// account also has a default token.
+func formatProfiles(c secrets.Credentials) string {
+	if len(c.Profiles) == 0 {
+		return "-"
+	}
+	names := c.ProfileNames()
+	if c.Token != "" {
+		names = append([]string{"(default)"}, names...)
+	}
+	return strings.Join(names, ", ")
+}
+
+var authRemoveYesFlag bool
+
 var authRemoveCmd = &cobra.Command{
 	Use:   "remove <name>",
 	Short: "Remove an account",
-	Args:  cobra.ExactArgs(1),
+	Long: `Delete a stored account and all of its tokens, or with --profile (or a
+"name/profile" argument) only that profile's token. You are asked to confirm
+first; pass --yes to skip the prompt (required when stdin is not a terminal).`,
+	Example: `  deel auth remove staging
+  deel auth remove staging --yes
+  deel auth remove prod --profile readonly`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
-		accountName := strings.ToLower(strings.TrimSpace(args[0]))
+		accountName, profile, err := resolveProfile(args[0])
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
 
 		if err := auth.ValidateAccountName(accountName); err != nil {
 			return failValidation(cmd, f, fmt.Sprintf("Invalid account name: %v", err))
 		}
 
+		target := fmt.Sprintf("account %q", accountName)
+		question := fmt.Sprintf("Remove account %q and its stored token?", accountName)
+		rerun := "deel auth remove " + accountName + " --yes"
+		if profile != "" {
+			target = fmt.Sprintf("profile %q of account %q", profile, accountName)
+			question = fmt.Sprintf("Remove the %s token?", target)
+			rerun = "deel auth remove " + accountName + "/" + profile + " --yes"
+		}
+
+		if !authRemoveYesFlag {
+			if !term.IsTerminal(int(os.Stdin.Fd())) {
+				return failValidation(cmd, f, fmt.Sprintf("removing %s requires confirmation; rerun with --yes", target), rerun)
+			}
+			if !confirmPrompt(os.Stdin, os.Stderr, question) {
+				f.PrintText("Cancelled.")
+				return nil
+			}
+		}
+
 		store, err := secrets.OpenDefault()
 		if err != nil {
 			return HandleError(f, err, "open credential store")
 		}
 
-		if err := store.Delete(accountName); err != nil {
+		if profile != "" {
+			err = secrets.DeleteProfile(store, accountName, profile)
+			if errors.Is(err, secrets.ErrProfileNotFound) {
+				return failValidation(cmd, f, err.Error())
+			}
+		} else {
+			err = store.Delete(accountName)
+		}
+		if err != nil {
 			return HandleError(f, err, "remove account")
 		}
 
 		return f.OutputFiltered(cmd.Context(), func() {
+			if profile != "" {
+				f.PrintSuccess("Removed %s", target)
+				return
+			}
 			f.PrintSuccess("Removed account %q", accountName)
 		}, map[string]any{
 			"removed": true,
 			"account": accountName,
+			"profile": profile,
 		})
 	},
 }
 
+var authRenameCmd = &cobra.Command{
+	Use:   "rename <old> <new>",
+	Short: "Rename an account",
+	Long: `Move a stored account's token and metadata to a new name without
+re-entering the token. The new entry is written before the old one is removed,
+so a failure partway through never loses the token.`,
+	Example: `  deel auth rename stagin staging`,
+	Args:    cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+		oldName := strings.ToLower(strings.TrimSpace(args[0]))
+		newName := strings.ToLower(strings.TrimSpace(args[1]))
+
+		if err := auth.ValidateAccountName(newName); err != nil {
+			return failValidation(cmd, f, fmt.Sprintf("Invalid account name: %v", err))
+		}
+		if oldName == newName {
+			return failValidation(cmd, f, fmt.Sprintf("account %q already has that name", oldName))
+		}
+
+		store, err := secrets.OpenDefault()
+		if err != nil {
+			return HandleError(f, err, "open credential store")
+		}
+
+		if err := secrets.Rename(store, oldName, newName); err != nil {
+			if errors.Is(err, secrets.ErrAccountExists) {
+				return failValidation(cmd, f, fmt.Sprintf("account %q already exists; remove it first or pick another name", newName),
+					"deel auth remove "+newName)
+			}
+			return HandleError(f, err, "rename account")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			f.PrintSuccess("Renamed account %q to %q", oldName, newName)
+			if fileSettings[config.KeyAccount] == oldName {
+				f.PrintWarning("The config file default account is still %q; run: deel config set account %s", oldName, newName)
+			}
+		}, map[string]any{
+			"renamed": true,
+			"from":    oldName,
+			"to":      newName,
+		})
+	},
+}
+
+var authDefaultCmd = &cobra.Command{
+	Use:   "default [name]",
+	Short: "Show or set the default account",
+	Long: `Without a name, print the default account. With a name, make it the default
+used when neither --account nor DEEL_ACCOUNT is set. The account (and profile,
+for "name/profile" or --profile) must exist. The default is stored as the
+'account' key of the config file; clear it with: deel config set account ""`,
+	Example: `  deel auth default
+  deel auth default prod
+  deel auth default prod/readonly`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+
+		path, settings, err := loadConfigFile(f)
+		if err != nil {
+			return HandleError(f, err, "read config file")
+		}
+
+		if len(args) == 0 {
+			current := settings[config.KeyAccount]
+			if env := os.Getenv(config.EnvAccount); env != "" && env != current {
+				f.PrintWarning("%s=%s overrides the default in this shell", config.EnvAccount, env)
+			}
+			return f.OutputFiltered(cmd.Context(), func() {
+				if current == "" {
+					f.PrintText("(not set)")
+					return
+				}
+				f.PrintText(current)
+			}, map[string]any{"default": current, "path": path})
+		}
+
+		accountName, profile, err := resolveProfile(args[0])
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+		if err := auth.ValidateAccountName(accountName); err != nil {
+			return failValidation(cmd, f, fmt.Sprintf("Invalid account name: %v", err))
+		}
+
+		store, err := secrets.OpenDefault()
+		if err != nil {
+			return HandleError(f, err, "open credential store")
+		}
+		creds, err := store.Get(accountName)
+		if errors.Is(err, secrets.ErrNotFound) {
+			return failValidation(cmd, f, fmt.Sprintf("account %q not found", accountName), "deel auth list")
+		}
+		if err != nil {
+			return HandleError(f, err, "load account")
+		}
+		ref := accountName
+		if profile != "" {
+			if _, err := creds.ForProfile(profile); err != nil {
+				return failValidation(cmd, f, err.Error())
+			}
+			ref += "/" + profile
+		}
+
+		settings[config.KeyAccount] = ref
+		if err := config.SaveSettings(path, settings); err != nil {
+			return HandleError(f, err, "write config file")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			f.PrintSuccess("Default account set to %q", ref)
+			if env := os.Getenv(config.EnvAccount); env != "" && env != ref {
+				f.PrintWarning("%s=%s still overrides it in this shell", config.EnvAccount, env)
+			}
+		}, map[string]any{"default": ref, "path": path})
+	},
+}
+
 var authTestCmd = &cobra.Command{
 	Use:   "test",
 	Short: "Test authentication",
@@ -205,6 +459,14 @@ var authTestCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 
+		creds, err := activeCredentials()
+		if err != nil {
+			return HandleError(f, err, "initializing client")
+		}
+		info, err := newTokenInfo(creds, time.Now())
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
 		client, err := getClient()
 		if err != nil {
 			return HandleError(f, err, "initializing client")
@@ -218,10 +480,20 @@ var authTestCmd = &cobra.Command{
 			return HandleError(f, err, "test connection")
 		}
 
+		warnTokenExpiry(f, info.ExpiresAt, info.DaysRemaining)
 		return f.OutputFiltered(cmd.Context(), func() {
 			f.PrintSuccess("Connection successful!")
+			if info.ExpiresAt != nil {
+				f.PrintText(fmt.Sprintf("Token expires %s (%d days)", info.ExpiresAt.Format("2006-01-02"), *info.DaysRemaining))
+			}
+			if len(info.Scopes) > 0 {
+				f.PrintText("Scopes: " + strings.Join(info.Scopes, ", "))
+			}
 		}, map[string]any{
-			"ok": true,
+			"ok":             true,
+			"expires_at":     info.ExpiresAt,
+			"days_remaining": info.DaysRemaining,
+			"scopes":         info.Scopes,
 		})
 	},
 }
@@ -260,11 +532,28 @@ var authManageCmd = &cobra.Command{
 	},
 }
 
+// confirmPrompt writes question with a [y/N] suffix to out and reports
+// whether the answer read from in is yes.
+func confirmPrompt(in io.Reader, out io.Writer, question string) bool {
+	_, _ = fmt.Fprintf(out, "%s [y/N]: ", question)
+	line, _ := bufio.NewReader(in).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
+
 func init() {
+	authLoginCmd.Flags().StringSliceVar(&authLoginIntendedUseFlag, "intended-use", nil, "Command groups you plan to use (e.g. contracts,time-off); prints the token scopes they need")
+	authRemoveCmd.Flags().BoolVarP(&authRemoveYesFlag, "yes", "y", false, "Remove without asking for confirmation")
+
 	authCmd.AddCommand(authLoginCmd)
 	authCmd.AddCommand(authAddCmd)
 	authCmd.AddCommand(authListCmd)
 	authCmd.AddCommand(authRemoveCmd)
+	authCmd.AddCommand(authRenameCmd)
+	authCmd.AddCommand(authDefaultCmd)
 	authCmd.AddCommand(authTestCmd)
 	authCmd.AddCommand(authManageCmd)
 }
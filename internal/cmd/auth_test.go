@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/auth"
+	"github.com/salmonumbrella/deel-cli/internal/config"
+	"github.com/salmonumbrella/deel-cli/internal/secrets"
+)
+
+func TestConfirmPrompt(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"y\n", true},
+		{"YES\n", true},
+		{" yes ", true},
+		{"n\n", false},
+		{"\n", false},
+		{"", false},
+		{"maybe\n", false},
+	}
+	for _, tt := range tests {
+		var out bytes.Buffer
+		got := confirmPrompt(strings.NewReader(tt.input), &out, `Remove account "staging"?`)
+		assert.Equal(t, tt.want, got, "input %q", tt.input)
+		assert.Equal(t, `Remove account "staging"? [y/N]: `, out.String())
+	}
+}
+
+func TestUseCaseScopesCoverCommandGroups(t *testing.T) {
+	skip := map[string]bool{"auth": true, "completion": true, "config": true, "help": true, "meta": true, "resources": true, "upgrade": true, "version": true}
+	for _, c := range rootCmd.Commands() {
+		if skip[c.Name()] || c.Hidden {
+			continue
+		}
+		_, ok := auth.UseCaseScopes[c.Name()]
+		assert.True(t, ok, "command group %q has no recommended scopes", c.Name())
+	}
+}
+
+func TestResolveIntendedUses(t *testing.T) {
+	got := resolveIntendedUses([]string{"contracts", " PTO:read", "bogus"})
+	assert.Equal(t, []string{"contracts", "time-off:read", "bogus"}, got)
+}
+
+func TestResolveProfile(t *testing.T) {
+	t.Cleanup(func() { profileFlag = "" })
+
+	profileFlag = ""
+	account, profile, err := resolveProfile("Prod/ReadOnly")
+	assert.NoError(t, err)
+	assert.Equal(t, "prod", account)
+	assert.Equal(t, "readonly", profile)
+
+	profileFlag = "admin"
+	account, profile, err = resolveProfile("prod")
+	assert.NoError(t, err)
+	assert.Equal(t, "prod", account)
+	assert.Equal(t, "admin", profile)
+
+	_, _, err = resolveProfile("prod/readonly")
+	assert.ErrorContains(t, err, `--profile is "admin"`)
+}
+
+func TestFormatProfiles(t *testing.T) {
+	assert.Equal(t, "-", formatProfiles(secrets.Credentials{Token: "t"}))
+	withProfiles := secrets.Credentials{Token: "t", Profiles: map[string]secrets.Profile{"readonly": {}, "admin": {}}}
+	assert.Equal(t, "(default), admin, readonly", formatProfiles(withProfiles))
+	withProfiles.Token = ""
+	assert.Equal(t, "admin, readonly", formatProfiles(withProfiles))
+}
+
+func TestClientCacheAccount_ScopesProfiles(t *testing.T) {
+	assert.Equal(t, "prod", clientCacheAccount(secrets.Credentials{Name: "prod", Token: "a"}))
+	assert.Equal(t, "prod/readonly", clientCacheAccount(secrets.Credentials{Name: "prod", Profile: "readonly", Token: "b"}))
+	assert.Equal(t, tokenCacheAccount("c"), clientCacheAccount(secrets.Credentials{Token: "c"}))
+}
+
+func TestAuthDefault_SetRequiresExistingAccount(t *testing.T) {
+	dir := t.TempDir()
+	cfgPath := filepath.Join(dir, "config.yaml")
+	t.Setenv(config.EnvConfigFile, cfgPath)
+	t.Setenv(config.EnvCredentialsDir, filepath.Join(dir, "creds"))
+	t.Setenv(config.EnvStorePassphrase, "test-pass")
+	t.Setenv(config.EnvAccount, "")
+	secrets.SetBackend(secrets.BackendFile)
+	t.Cleanup(func() { secrets.SetBackend(secrets.BackendAuto) })
+
+	store, err := secrets.OpenDefault()
+	require.NoError(t, err)
+	require.NoError(t, store.Set("prod", secrets.Credentials{Token: "tok"}))
+	require.NoError(t, secrets.SetProfile(store, "prod", "readonly", "tok-ro"))
+
+	authDefaultCmd.SetContext(context.Background())
+	run := func(args ...string) error {
+		return authDefaultCmd.RunE(authDefaultCmd, args)
+	}
+
+	require.NoError(t, run("prod/readonly"))
+	settings, err := config.LoadSettings(cfgPath)
+	require.NoError(t, err)
+	assert.Equal(t, "prod/readonly", settings[config.KeyAccount])
+
+	assert.Error(t, run("staging"))
+	assert.Error(t, run("prod/admin"))
+	settings, err = config.LoadSettings(cfgPath)
+	require.NoError(t, err)
+	assert.Equal(t, "prod/readonly", settings[config.KeyAccount], "failed calls must not change the default")
+
+	assert.NoError(t, run())
+}
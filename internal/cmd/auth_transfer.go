@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/salmonumbrella/deel-cli/internal/config"
+	"github.com/salmonumbrella/deel-cli/internal/secrets"
+)
+
+var (
+	authExportAllFlag   bool
+	authImportForceFlag bool
+)
+
+var authExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export accounts as an encrypted blob",
+	Long: `Write the selected account (--account, DEEL_ACCOUNT, or the config file
+default) or every account (--all) to stdout as a passphrase-encrypted blob for
+'deel auth import' on another machine. Tokens are never written unencrypted.
+
+The passphrase is read from DEEL_EXPORT_PASSPHRASE, or prompted for (twice)
+when stdin is a terminal.`,
+	Example: `  deel auth export --account prod > prod.deel
+  deel auth export --all > accounts.deel`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+
+		account := accountFlag
+		if account == "" {
+			account = envOrSetting(config.EnvAccount, config.KeyAccount)
+		}
+		// An account is exported with all of its profiles.
+		account, _ = secrets.SplitProfile(account)
+		if authExportAllFlag && accountFlag != "" {
+			return failValidation(cmd, f, "--account cannot be used with --all")
+		}
+		if !authExportAllFlag && account == "" {
+			return failValidation(cmd, f, "specify --account <name> or --all")
+		}
+
+		store, err := secrets.OpenDefault()
+		if err != nil {
+			return HandleError(f, err, "open credential store")
+		}
+
+		names := []string{account}
+		if authExportAllFlag {
+			creds, err := store.List()
+			if err != nil {
+				return HandleError(f, err, "list accounts")
+			}
+			names = names[:0]
+			for _, c := range creds {
+				names = append(names, c.Name)
+			}
+			sort.Strings(names)
+			if len(names) == 0 {
+				return failValidation(cmd, f, "no accounts to export")
+			}
+		}
+
+		passphrase, err := readTransferPassphrase(true)
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
+		blob, err := secrets.ExportAccounts(store, names, passphrase)
+		if err != nil {
+			return HandleError(f, err, "export accounts")
+		}
+		if _, err := fmt.Fprintln(os.Stdout, string(blob)); err != nil {
+			return err
+		}
+		f.PrintWarning("Exported %d account(s): %s. Anyone with the passphrase can use them; keep the blob private.", len(names), strings.Join(names, ", "))
+		return nil
+	},
+}
+
+var authImportCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import accounts from an encrypted blob",
+	Long: `Read a blob written by 'deel auth export' from file (or stdin when file
+is omitted or "-") and store its accounts. Existing accounts are never
+overwritten unless --force is set; nothing is imported if any would be.
+
+The passphrase is read from DEEL_EXPORT_PASSPHRASE, or prompted for when the
+blob comes from a file and stdin is a terminal.`,
+	Example: `  deel auth import prod.deel
+  DEEL_EXPORT_PASSPHRASE=... deel auth import < accounts.deel`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+
+		var blob []byte
+		var err error
+		if len(args) == 0 || args[0] == "-" {
+			blob, err = io.ReadAll(os.Stdin)
+		} else {
+			blob, err = os.ReadFile(args[0])
+		}
+		if err != nil {
+			return HandleError(f, err, "read export")
+		}
+
+		passphrase, err := readTransferPassphrase(false)
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
+		store, err := secrets.OpenDefault()
+		if err != nil {
+			return HandleError(f, err, "open credential store")
+		}
+
+		names, err := secrets.ImportAccounts(store, blob, passphrase, authImportForceFlag)
+		if err != nil {
+			if errors.Is(err, secrets.ErrAccountExists) {
+				return failValidation(cmd, f, err.Error()+"; rerun with --force to overwrite")
+			}
+			if errors.Is(err, secrets.ErrBadPassphrase) {
+				return failValidation(cmd, f, err.Error())
+			}
+			return HandleError(f, err, "import accounts")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			f.PrintSuccess("Imported %d account(s): %s", len(names), strings.Join(names, ", "))
+		}, map[string]any{
+			"imported": names,
+		})
+	},
+}
+
+// readTransferPassphrase returns the export passphrase from
+// DEEL_EXPORT_PASSPHRASE or, on a terminal, a hidden prompt. With confirm the
+// prompt asks twice so a typo cannot lock the export.
+func readTransferPassphrase(confirm bool) (string, error) {
+	if p := os.Getenv(config.EnvExportPassphrase); p != "" {
+		return p, nil
+	}
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", fmt.Errorf("no passphrase: set %s or run from a terminal", config.EnvExportPassphrase)
+	}
+
+	read := func(prompt string) (string, error) {
+		_, _ = fmt.Fprint(os.Stderr, prompt)
+		b, err := term.ReadPassword(fd)
+		_, _ = fmt.Fprintln(os.Stderr)
+		return string(b), err
+	}
+	p, err := read("Export passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if p == "" {
+		return "", fmt.Errorf("passphrase cannot be empty")
+	}
+	if confirm {
+		again, err := read("Repeat passphrase: ")
+		if err != nil {
+			return "", err
+		}
+		if again != p {
+			return "", fmt.Errorf("passphrases do not match")
+		}
+	}
+	return p, nil
+}
+
+func init() {
+	authExportCmd.Flags().BoolVar(&authExportAllFlag, "all", false, "Export every stored account")
+	authImportCmd.Flags().BoolVar(&authImportForceFlag, "force", false, "Overwrite accounts that already exist")
+
+	authCmd.AddCommand(authExportCmd)
+	authCmd.AddCommand(authImportCmd)
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
 )
 
 var bgCheckCmd = &cobra.Command{
@@ -34,7 +36,7 @@ var bgCheckOptionsCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		options, err := client.ListBackgroundCheckOptions(cmd.Context(), bgCheckCountryFlag)
+		options, err := client.ListBackgroundCheckOptions(api.WithServerFields(cmd.Context()), bgCheckCountryFlag)
 		if err != nil {
 			return HandleError(f, err, "list options")
 		}
@@ -69,7 +71,7 @@ var bgCheckListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		checks, err := client.ListBackgroundChecksByContract(cmd.Context(), bgCheckContractFlag)
+		checks, err := client.ListBackgroundChecksByContract(api.WithServerFields(cmd.Context()), bgCheckContractFlag)
 		if err != nil {
 			return HandleError(f, err, "list checks")
 		}
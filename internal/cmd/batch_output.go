@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/salmonumbrella/deel-cli/internal/batch"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+// outputBatchResults writes the per-item results of a batch command and
+// returns failure, which is nil when every item succeeded. The results are the
+// command's complete output even when some items failed: the agent envelope
+// reports "ok": false, and since the failure counts as already emitted no
+// separate error object follows on stdout. The returned error still makes the
+// process exit non-zero.
+func outputBatchResults(ctx context.Context, f *outfmt.Formatter, textFn func(), data any, failure error) error {
+	if err := f.OutputFiltered(outfmt.WithPartialFailure(ctx, failure != nil), textFn, data); err != nil {
+		return err
+	}
+	if failure != nil {
+		markAgentErrorEmitted()
+		return failure
+	}
+	return nil
+}
+
+// batchSummaryJSON renders a batch summary with the JSON keys batch commands share.
+func batchSummaryJSON(s batch.Summary) map[string]int {
+	return map[string]int{
+		"total":     s.Total,
+		"succeeded": s.Succeeded,
+		"failed":    s.Failed,
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
 )
 
 var benefitsCmd = &cobra.Command{
@@ -33,7 +35,7 @@ var benefitsListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		benefits, err := client.ListBenefitsByCountry(cmd.Context(), benefitsCountryFlag)
+		benefits, err := client.ListBenefitsByCountry(api.WithServerFields(cmd.Context()), benefitsCountryFlag)
 		if err != nil {
 			return HandleError(f, err, "list benefits")
 		}
@@ -73,7 +75,7 @@ var benefitsEmployeeCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		benefits, err := client.GetEmployeeBenefits(cmd.Context(), benefitsEmployeeFlag)
+		benefits, err := client.GetEmployeeBenefits(api.WithServerFields(cmd.Context()), benefitsEmployeeFlag)
 		if err != nil {
 			return HandleError(f, err, "get benefits")
 		}
@@ -104,7 +104,7 @@ var calcSalaryHistogramCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		result, err := client.GetSalaryHistogram(cmd.Context(), calcRoleFlag, calcCountryFlag)
+		result, err := client.GetSalaryHistogram(api.WithServerFields(cmd.Context()), calcRoleFlag, calcCountryFlag)
 		if err != nil {
 			return HandleError(f, err, "get histogram")
 		}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+// thenStep is a follow-up action that --then runs against a newly created
+// resource.
+type thenStep struct {
+	// validate runs before the create so a chain that cannot finish fails
+	// without side effects.
+	validate func() error
+	run      func(ctx context.Context, client *api.Client, id string) (any, error)
+}
+
+// thenSteps is the --then allowlist, keyed by resource and step name. Only
+// reads and forward actions on the created resource belong here; nothing
+// destructive (terminate, delete) may be chained.
+var thenSteps = map[string]map[string]thenStep{
+	"contracts": {
+		"get": {
+			run: func(ctx context.Context, client *api.Client, id string) (any, error) {
+				return client.GetContract(ctx, id)
+			},
+		},
+		"sign": {
+			validate: func() error {
+				if signSignerFlag == "" {
+					return fmt.Errorf("--then sign requires --signer")
+				}
+				return nil
+			},
+			run: func(ctx context.Context, client *api.Client, id string) (any, error) {
+				return client.SignContract(ctx, id, signSignerFlag)
+			},
+		},
+		"invite": {
+			run: func(ctx context.Context, client *api.Client, id string) (any, error) {
+				params := api.InviteWorkerParams{Email: contractWorkerEmailFlag, Locale: inviteLocaleFlag}
+				if err := client.InviteWorker(ctx, id, params); err != nil {
+					return nil, err
+				}
+				return map[string]any{"sent": true, "contract_id": id, "email": params.Email, "locale": params.Locale}, nil
+			},
+		},
+		"invite-link": {
+			run: func(ctx context.Context, client *api.Client, id string) (any, error) {
+				url, err := client.GetInviteLink(ctx, id)
+				if err != nil {
+					return nil, err
+				}
+				return map[string]string{"url": url}, nil
+			},
+		},
+	},
+}
+
+// chainStepResult is one entry in the combined --then output.
+type chainStepResult struct {
+	Step    string `json:"step"`
+	ID      string `json:"id"`
+	OK      bool   `json:"ok"`
+	Skipped bool   `json:"skipped,omitempty"`
+	Result  any    `json:"result,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// parseThenSteps checks names against the allowlist for resource and runs
+// each step's pre-flight validation.
+func parseThenSteps(resource string, names []string) ([]string, error) {
+	allowed := thenSteps[resource]
+	var steps []string
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		step, ok := allowed[name]
+		if !ok {
+			valid := make([]string, 0, len(allowed))
+			for k := range allowed {
+				valid = append(valid, k)
+			}
+			sort.Strings(valid)
+			return nil, fmt.Errorf("--then %q is not allowed for %s (allowed: %s)", name, resource, strings.Join(valid, ", "))
+		}
+		if step.validate != nil {
+			if err := step.validate(); err != nil {
+				return nil, err
+			}
+		}
+		steps = append(steps, name)
+	}
+	return steps, nil
+}
+
+// runThenChain records the create as the first step and then runs steps in
+// order against id. Steps depend on each other, so the first failure skips
+// the rest.
+func runThenChain(ctx context.Context, client *api.Client, resource, id string, created any, steps []string) ([]chainStepResult, bool) {
+	results := []chainStepResult{{Step: "create", ID: id, OK: true, Result: created}}
+	failed := false
+	for _, name := range steps {
+		res := chainStepResult{Step: name, ID: id}
+		if failed {
+			res.Skipped = true
+			results = append(results, res)
+			continue
+		}
+		stepCtx := api.WithIdempotencyPart(ctx, "then-"+name)
+		out, err := thenSteps[resource][name].run(stepCtx, client, id)
+		if err != nil {
+			res.Error = err.Error()
+			failed = true
+		} else {
+			res.OK = true
+			res.Result = out
+		}
+		results = append(results, res)
+	}
+	return results, !failed
+}
+
+// outputThenChain prints the combined chain result and returns an error if
+// any step failed. The created resource is kept either way.
+func outputThenChain(ctx context.Context, f *outfmt.Formatter, results []chainStepResult, ok bool) error {
+	var failure error
+	if !ok {
+		for _, r := range results {
+			if r.Error != "" {
+				failure = fmt.Errorf("--then %s failed: %s", r.Step, r.Error)
+				break
+			}
+		}
+	}
+	return outputBatchResults(ctx, f, func() {
+		table := f.NewTable("STEP", "ID", "STATUS", "ERROR")
+		for _, r := range results {
+			status := "ok"
+			switch {
+			case r.Skipped:
+				status = "skipped"
+			case !r.OK:
+				status = "failed"
+			}
+			table.AddRow(r.Step, r.ID, status, r.Error)
+		}
+		table.Render()
+	}, map[string]any{"steps": results}, failure)
+}
@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+func TestParseThenSteps_RejectsDisallowedTarget(t *testing.T) {
+	_, err := parseThenSteps("contracts", []string{"terminate"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `--then "terminate" is not allowed for contracts`)
+	assert.Contains(t, err.Error(), "allowed: get, invite, invite-link, sign")
+
+	_, err = parseThenSteps("people", []string{"get"})
+	assert.ErrorContains(t, err, "not allowed for people")
+}
+
+func TestParseThenSteps_SignRequiresSigner(t *testing.T) {
+	orig := signSignerFlag
+	t.Cleanup(func() { signSignerFlag = orig })
+
+	signSignerFlag = ""
+	_, err := parseThenSteps("contracts", []string{"sign"})
+	assert.EqualError(t, err, "--then sign requires --signer")
+
+	signSignerFlag = "Ada Lovelace"
+	steps, err := parseThenSteps("contracts", []string{" Sign ", "get"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sign", "get"}, steps)
+}
+
+func TestRunThenChain_CreateThenSign(t *testing.T) {
+	orig := signSignerFlag
+	t.Cleanup(func() { signSignerFlag = orig })
+	signSignerFlag = "Ada Lovelace"
+
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.Handle("POST", "/rest/v2/contracts/c-1/signatures", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data struct {
+				ClientSignature string `json:"client_signature"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "Ada Lovelace", body.Data.ClientSignature)
+		_, _ = w.Write([]byte(`{"data":{"id":"c-1","status":"waiting_for_worker_sign"}}`))
+	})
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+
+	created := &api.Contract{ID: "c-1", Status: "new"}
+	steps, ok := runThenChain(context.Background(), client, "contracts", "c-1", created, []string{"sign"})
+	require.True(t, ok)
+	require.Len(t, steps, 2)
+	assert.Equal(t, "create", steps[0].Step)
+	assert.Same(t, created, steps[0].Result)
+	assert.Equal(t, "sign", steps[1].Step)
+	assert.True(t, steps[1].OK)
+	assert.Equal(t, "waiting_for_worker_sign", steps[1].Result.(*api.Contract).Status)
+}
+
+func TestRunThenChain_InviteUsesCreateLocale(t *testing.T) {
+	require.NotNil(t, contractsCreateCmd.Flags().Lookup("locale"), "--then invite reads --locale from contracts create")
+
+	origEmail, origLocale := contractWorkerEmailFlag, inviteLocaleFlag
+	t.Cleanup(func() {
+		contractWorkerEmailFlag, inviteLocaleFlag = origEmail, origLocale
+		contractsCreateCmd.Flags().Lookup("locale").Changed = false
+	})
+	require.NoError(t, contractsCreateCmd.Flags().Set("locale", "de"))
+	contractWorkerEmailFlag = "ada@example.com"
+
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.Handle("POST", "/rest/v2/contracts/c-1/invitations", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data api.InviteWorkerParams `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "ada@example.com", body.Data.Email)
+		assert.Equal(t, "de", body.Data.Locale)
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	})
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+
+	steps, ok := runThenChain(context.Background(), client, "contracts", "c-1", &api.Contract{ID: "c-1"}, []string{"invite"})
+	require.True(t, ok)
+	assert.Equal(t, "de", steps[1].Result.(map[string]any)["locale"])
+}
+
+func TestRunThenChain_StepsGetTheirOwnIdempotencyKeys(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	var keys []string
+	server.Handle("POST", "/rest/v2/contracts/c-1/invitations", func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	client.SetIdempotencyKey("create-key")
+
+	_, ok := runThenChain(context.Background(), client, "contracts", "c-1", &api.Contract{ID: "c-1"}, []string{"invite"})
+	require.True(t, ok)
+	assert.Equal(t, []string{api.DerivedIdempotencyKey("create-key", "then-invite")}, keys)
+}
+
+func TestRunThenChain_FailureSkipsRemainingSteps(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.HandleError("GET", "/rest/v2/contracts/c-1", http.StatusNotFound, "not found")
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	client.SetRetryConfig(0, 0, 0)
+
+	steps, ok := runThenChain(context.Background(), client, "contracts", "c-1", &api.Contract{ID: "c-1"}, []string{"get", "invite-link"})
+	assert.False(t, ok)
+	require.Len(t, steps, 3)
+	assert.False(t, steps[1].OK)
+	assert.NotEmpty(t, steps[1].Error)
+	assert.True(t, steps[2].Skipped)
+}
+
+func TestOutputThenChain_FailedStepReportsNotOK(t *testing.T) {
+	resetAgentErrorEmitted()
+	defer resetAgentErrorEmitted()
+
+	var out, errOut bytes.Buffer
+	f := outfmt.New(&out, &errOut, outfmt.FormatJSON, "never")
+	f.SetAgentMode(true)
+	ctx := outfmt.WithAgent(context.Background(), true)
+
+	results := []chainStepResult{
+		{Step: "create", ID: "c-1", OK: true},
+		{Step: "sign", ID: "c-1", Error: "contract not found"},
+	}
+	err := outputThenChain(ctx, f, results, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--then sign failed")
+	assert.NotEqual(t, 0, ExitCode(err))
+	assert.True(t, AgentErrorEmitted(), "main must not print a second error object")
+
+	dec := json.NewDecoder(&out)
+	var payload struct {
+		OK     bool `json:"ok"`
+		Result struct {
+			Data struct {
+				Steps []chainStepResult `json:"steps"`
+			} `json:"data"`
+		} `json:"result"`
+	}
+	require.NoError(t, dec.Decode(&payload))
+	assert.False(t, dec.More())
+	assert.False(t, payload.OK)
+	assert.Len(t, payload.Result.Data.Steps, 2)
+}
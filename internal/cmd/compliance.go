@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
 )
 
 var complianceCmd = &cobra.Command{
@@ -32,7 +34,7 @@ var complianceDocsCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		docs, err := client.ListComplianceDocs(cmd.Context(), complianceContractFlag)
+		docs, err := client.ListComplianceDocs(api.WithServerFields(cmd.Context()), complianceContractFlag)
 		if err != nil {
 			return HandleError(f, err, "list documents")
 		}
@@ -70,7 +72,7 @@ var complianceTemplatesCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		templates, err := client.ListComplianceTemplates(cmd.Context(), complianceCountryFlag)
+		templates, err := client.ListComplianceTemplates(api.WithServerFields(cmd.Context()), complianceCountryFlag)
 		if err != nil {
 			return HandleError(f, err, "list templates")
 		}
@@ -104,7 +106,7 @@ var complianceValidationsCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		validation, err := client.GetComplianceValidations(cmd.Context(), complianceContractFlag)
+		validation, err := client.GetComplianceValidations(api.WithServerFields(cmd.Context()), complianceContractFlag)
 		if err != nil {
 			return HandleError(f, err, "get validations")
 		}
@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/salmonumbrella/deel-cli/internal/auth"
+	"github.com/salmonumbrella/deel-cli/internal/config"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+	"github.com/salmonumbrella/deel-cli/internal/secrets"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manage CLI configuration",
+}
+
+var configAccountsCmd = &cobra.Command{
+	Use:   "accounts",
+	Short: "Manage account settings",
+}
+
+var configAccountsSetMetadataCmd = &cobra.Command{
+	Use:   "set-metadata <name> key=value...",
+	Short: "Annotate an account with metadata",
+	Long: `Attach non-secret annotations (environment, org name, notes) to a stored account.
+Metadata is shown by "deel auth list" and the account manager. Pass key= with an
+empty value to remove a key.`,
+	Example: `  deel config accounts set-metadata prod env=production org="Acme Inc"
+  deel config accounts set-metadata prod notes=`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+		accountName := strings.ToLower(strings.TrimSpace(args[0]))
+
+		if err := auth.ValidateAccountName(accountName); err != nil {
+			return failValidation(cmd, f, fmt.Sprintf("Invalid account name: %v", err))
+		}
+		updates, err := parseMetadataArgs(args[1:])
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
+		store, err := secrets.OpenDefault()
+		if err != nil {
+			return HandleError(f, err, "open credential store")
+		}
+
+		creds, err := store.Get(accountName)
+		if err != nil {
+			return HandleError(f, err, fmt.Sprintf("load account %q", accountName))
+		}
+
+		metadata := mergeMetadata(creds.Metadata, updates)
+		if err := store.SetMetadata(accountName, metadata); err != nil {
+			return HandleError(f, err, "save metadata")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			f.PrintSuccess("Updated metadata for account %q", accountName)
+			if len(metadata) > 0 {
+				f.PrintText("  " + formatMetadata(metadata))
+			}
+		}, map[string]any{
+			"account":  accountName,
+			"metadata": metadata,
+		})
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a default in the config file",
+	Long: `Set a default in the config file (~/.config/deel/config.yaml, or $DEEL_CONFIG).
+Keys: account, color, output, retries, timeout. Flags and DEEL_* environment
+variables override these defaults. Pass an empty value to remove a key.
+Unknown keys and invalid values already in the file are dropped when it is
+saved, so any set repairs a file other commands reject.`,
+	Example: `  deel config set output json
+  deel config set timeout 60s
+  deel config set account ""`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+		key, value := strings.ToLower(strings.TrimSpace(args[0])), strings.TrimSpace(args[1])
+		if err := config.ValidateSetting(key, value); err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+		if key == config.KeyAccount && value != "" {
+			if err := auth.ValidateAccountName(value); err != nil {
+				return failValidation(cmd, f, fmt.Sprintf("Invalid account name: %v", err))
+			}
+		}
+
+		path, settings, err := loadConfigFile(f)
+		if err != nil {
+			return HandleError(f, err, "read config file")
+		}
+		settings[key] = value
+		if err := config.SaveSettings(path, settings); err != nil {
+			return HandleError(f, err, "write config file")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			if value == "" {
+				f.PrintSuccess("Removed %s from %s", key, path)
+				return
+			}
+			f.PrintSuccess("Set %s = %s in %s", key, value, path)
+		}, map[string]any{"key": key, "value": value, "path": path})
+	},
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Show one config file default",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+		key := strings.ToLower(strings.TrimSpace(args[0]))
+		if err := config.ValidateKey(key); err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
+		_, settings, err := loadConfigFile(f)
+		if err != nil {
+			return HandleError(f, err, "read config file")
+		}
+		value := settings[key]
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			if value == "" {
+				f.PrintText("(not set)")
+				return
+			}
+			f.PrintText(value)
+		}, map[string]any{"key": key, "value": value})
+	},
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List config file defaults",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+		path, settings, err := loadConfigFile(f)
+		if err != nil {
+			return HandleError(f, err, "read config file")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			f.PrintText("Config file: " + path)
+			table := f.NewTable("KEY", "VALUE")
+			for _, key := range config.Keys {
+				value := settings[key]
+				if value == "" {
+					value = "-"
+				}
+				table.AddRow(key, value)
+			}
+			table.Render()
+		}, map[string]any{"path": path, "settings": settings})
+	},
+}
+
+// loadConfigFile resolves the config file path and reads its settings.
+// Invalid entries are skipped with a warning rather than failing, so the
+// config commands still work on a broken file; config set then saves the
+// file without them.
+func loadConfigFile(f *outfmt.Formatter) (string, config.Settings, error) {
+	path, err := config.FilePath()
+	if err != nil {
+		return "", nil, err
+	}
+	settings, problems, err := config.ReadSettings(path)
+	if err != nil {
+		return path, nil, err
+	}
+	for _, p := range problems {
+		f.PrintWarning("ignoring config file entry: %v", p)
+	}
+	return path, settings, nil
+}
+
+// parseMetadataArgs parses key=value pairs. An empty value marks the key for
+// removal and is returned as "".
+func parseMetadataArgs(args []string) (map[string]string, error) {
+	updates := make(map[string]string, len(args))
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid metadata %q: expected key=value", arg)
+		}
+		updates[key] = strings.TrimSpace(value)
+	}
+	return updates, nil
+}
+
+// mergeMetadata applies updates to existing without modifying it. Keys with an
+// empty value are removed.
+func mergeMetadata(existing, updates map[string]string) map[string]string {
+	merged := make(map[string]string, len(existing)+len(updates))
+	for k, v := range existing {
+		merged[k] = v
+	}
+	for k, v := range updates {
+		if v == "" {
+			delete(merged, k)
+			continue
+		}
+		merged[k] = v
+	}
+	return merged
+}
+
+// formatMetadata renders metadata as "k=v, k=v" sorted by key.
+func formatMetadata(metadata map[string]string) string {
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + metadata[k]
+	}
+	return strings.Join(parts, ", ")
+}
+
+func init() {
+	configAccountsCmd.AddCommand(configAccountsSetMetadataCmd)
+	configCmd.AddCommand(configAccountsCmd)
+	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configListCmd)
+}
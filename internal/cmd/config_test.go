@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/config"
+)
+
+func TestParseMetadataArgs(t *testing.T) {
+	got, err := parseMetadataArgs([]string{"env=production", "org = Acme Inc", "notes="})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"env": "production", "org": "Acme Inc", "notes": ""}, got)
+
+	_, err = parseMetadataArgs([]string{"env"})
+	assert.ErrorContains(t, err, "expected key=value")
+	_, err = parseMetadataArgs([]string{"=prod"})
+	assert.Error(t, err)
+}
+
+func TestMergeMetadata(t *testing.T) {
+	existing := map[string]string{"env": "staging", "notes": "old"}
+	merged := mergeMetadata(existing, map[string]string{"env": "prod", "notes": "", "org": "Acme"})
+
+	assert.Equal(t, map[string]string{"env": "prod", "org": "Acme"}, merged)
+	assert.Equal(t, "staging", existing["env"], "existing map must not be modified")
+}
+
+func TestFormatMetadata(t *testing.T) {
+	assert.Equal(t, "env=prod, org=Acme", formatMetadata(map[string]string{"org": "Acme", "env": "prod"}))
+	assert.Equal(t, "", formatMetadata(nil))
+}
+
+func TestLoadFileSettings_Precedence(t *testing.T) {
+	origTimeout, origRetries, origSettings := timeoutFlag, retriesFlag, fileSettings
+	defer func() { timeoutFlag, retriesFlag, fileSettings = origTimeout, origRetries, origSettings }()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("output: yaml\naccount: prod\ntimeout: 45s\nretries: 7\n"), 0o600))
+	t.Setenv(config.EnvConfigFile, path)
+	t.Setenv(config.EnvOutput, "json")
+	t.Setenv(config.EnvAccount, "")
+
+	cmd := &cobra.Command{}
+	cmd.Flags().DurationVar(&timeoutFlag, "timeout", 30*time.Second, "")
+	cmd.Flags().IntVar(&retriesFlag, "retries", 3, "")
+	require.NoError(t, cmd.Flags().Set("retries", "1"))
+
+	require.NoError(t, loadFileSettings(cmd))
+
+	// Env beats the config file; the config file fills in what env leaves unset.
+	assert.Equal(t, "json", envOrSetting(config.EnvOutput, config.KeyOutput))
+	assert.Equal(t, "prod", envOrSetting(config.EnvAccount, config.KeyAccount))
+	// Config file beats flag defaults, but not flags the user passed.
+	assert.Equal(t, 45*time.Second, timeoutFlag)
+	assert.Equal(t, 1, retriesFlag)
+}
+
+func TestLoadFileSettings_InvalidFile(t *testing.T) {
+	origSettings := fileSettings
+	defer func() { fileSettings = origSettings }()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("token: abc\n"), 0o600))
+	t.Setenv(config.EnvConfigFile, path)
+
+	err := loadFileSettings(&cobra.Command{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown config key "token"`)
+}
+
+func TestLoadFileSettings_InvalidFileIsAValidationError(t *testing.T) {
+	origSettings := fileSettings
+	defer func() { fileSettings = origSettings }()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("outptu: json\n"), 0o600))
+	t.Setenv(config.EnvConfigFile, path)
+
+	err := loadFileSettings(&cobra.Command{})
+	require.Error(t, err)
+	// The valid-keys list mentions "timeout"; that must not read as a network error.
+	assert.Equal(t, exitUsage, ExitCode(err))
+}
+
+func TestConfigSet_RepairsFileWithInvalidEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("outptu: json\ntimeout: soon\nretries: 2\n"), 0o600))
+	t.Setenv(config.EnvConfigFile, path)
+
+	require.NoError(t, configListCmd.RunE(configListCmd, nil))
+	require.NoError(t, configSetCmd.RunE(configSetCmd, []string{"output", "json"}))
+
+	settings, err := config.LoadSettings(path)
+	require.NoError(t, err)
+	assert.Equal(t, config.Settings{config.KeyOutput: "json", config.KeyRetries: "2"}, settings)
+}
@@ -21,8 +21,9 @@ func requireForce(cmd *cobra.Command, f *outfmt.Formatter, force bool, action, r
 		_ = f.PrintJSON(map[string]any{
 			"ok": false,
 			"error": map[string]any{
-				"category": "confirmation_required",
-				"message":  fmt.Sprintf("%s requires confirmation; rerun with --force", action),
+				"category":  "confirmation_required",
+				"message":   fmt.Sprintf("%s requires confirmation; rerun with --force", action),
+				"retryable": false,
 				"details": map[string]any{
 					"action":   action,
 					"resource": resource,
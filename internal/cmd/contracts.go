@@ -3,12 +3,20 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 
 	"github.com/salmonumbrella/deel-cli/internal/api"
 	"github.com/salmonumbrella/deel-cli/internal/dryrun"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
 )
 
 var contractsCmd = &cobra.Command{
@@ -18,31 +26,39 @@ var contractsCmd = &cobra.Command{
 }
 
 var (
-	contractsLimitFlag    int
-	contractsCursorFlag   string
-	contractsStatusFlag   string
-	contractsTypeFlag     string
-	contractsAllFlag      bool
-	contractsEntityIDFlag string
-	contractsCountryFlag  string
-	contractsLightFlag    bool
+	contractsLimitFlag       int
+	contractsCursorFlag      string
+	contractsStatusFlag      string
+	contractsTypeFlag        string
+	contractsAllFlag         bool
+	contractsStatusSumFlag   bool
+	contractsSumByTypeFlag   bool
+	contractsEntityIDFlag    string
+	contractsCountryFlag     string
+	contractsWorkerEmailFlag string
+	contractsLightFlag       bool
+	contractsNeedsActionFlag bool
+	contractsCountFlag       bool
+	contractsCompareTmplFlag string
 
 	// Create command flags
-	contractTitleFlag         string
-	contractTypeFlag          string
-	contractWorkerEmailFlag   string
-	contractWorkerFirstFlag   string
-	contractWorkerLastFlag    string
-	contractCurrencyFlag      string
-	contractRateFlag          float64
-	contractCountryFlag       string
-	contractJobTitleFlag      string
-	contractScopeFlag         string
-	contractStartDateFlag     string
-	contractEndDateFlag       string
-	contractPaymentCycleFlag  string
-	contractSeniorityFlag     string
-	contractSpecialClauseFlag string
+	contractTitleFlag               string
+	contractTypeFlag                string
+	contractWorkerEmailFlag         string
+	contractWorkerFirstFlag         string
+	contractWorkerLastFlag          string
+	contractCurrencyFlag            string
+	contractSkipCurrencyFlag        bool
+	contractRateFlag                float64
+	contractCountryFlag             string
+	contractJobTitleFlag            string
+	contractScopeFlag               string
+	contractStartDateFlag           string
+	contractEndDateFlag             string
+	contractPaymentCycleFlag        string
+	contractSeniorityFlag           string
+	contractSpecialClauseFlag       string
+	contractCurrencyFromCountryFlag bool
 
 	// Extended create command flags
 	contractTemplateFlag     string
@@ -52,17 +68,23 @@ var (
 	contractCycleEndTypeFlag string
 	contractFrequencyFlag    string
 	contractManagerFlag      string
+	contractFromFileFlag     string
+	contractConcurrencyFlag  int
+	contractThenFlag         []string
+	contractIdemScopeFlag    string
 
 	// Terminate command flags
-	terminateReasonFlag    string
-	terminateDateFlag      string
-	terminateNotesFlag     string
-	terminateImmediateFlag bool
-	terminateTypeFlag      string
-	terminateRehireFlag    string
+	terminateReasonFlag     string
+	terminateReasonFileFlag string
+	terminateDateFlag       string
+	terminateNotesFlag      string
+	terminateImmediateFlag  bool
+	terminateTypeFlag       string
+	terminateRehireFlag     string
 
 	// Sign command flags
-	signSignerFlag string
+	signSignerFlag      string
+	signConcurrencyFlag int
 
 	// Invite command flags
 	inviteEmailFlag   string
@@ -70,26 +92,52 @@ var (
 	inviteMessageFlag string
 
 	// Amend command flags
-	amendScopeFlag string
+	amendScopeFlag         string
+	amendEffectiveDateFlag string
+	amendReasonFlag        string
+	amendRateFlag          float64
+	amendTitleFlag         string
+	amendJobTitleFlag      string
 )
 
 var contractsListCmd = &cobra.Command{
 	Use:     "list",
 	Short:   "List contracts (default: active)",
-	Long:    "List contracts in your organization. Defaults to active contracts; use --status to query other statuses and --entity-id or --country to filter.",
-	Example: "  deel contracts list --json --items --jq '.[] | {id, worker_name, worker: .worker.name, status}'\n  deel contracts list --entity-id le-123 --all\n  deel contracts list --country TW --all",
+	Long:    "List contracts in your organization. Defaults to active contracts; use --status to query other statuses and --entity-id, --country, or --worker-email to filter. Country and worker-email filters are also applied client-side, so combine them with --all to search every page.",
+	Example: "  deel contracts list --json --items --jq '.[] | {id, worker_name, worker: .worker.name, status}'\n  deel contracts list --entity-id le-123 --all\n  deel contracts list --country TW --all\n  deel contracts list --worker-email jane@example.com --all\n  deel contracts list --all --sort-by worker\n  deel contracts list --needs-action --all\n  deel contracts list --country TW --count",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		client, f, err := initClient("initializing client")
 		if err != nil {
 			return err
 		}
 
-		allContracts, page, hasMore, err := collectCursorItems(cmd.Context(), contractsAllFlag, contractsCursorFlag, contractsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.Contract], error) {
+		if contractsSumByTypeFlag && !contractsStatusSumFlag {
+			return failValidation(cmd, f, "--by-type requires --status-summary")
+		}
+		if contractsNeedsActionFlag && (contractsStatusSumFlag || contractsLightFlag) {
+			return failValidation(cmd, f, "--needs-action cannot be used with --status-summary or --light")
+		}
+		if contractsCountFlag && (contractsStatusSumFlag || contractsNeedsActionFlag || contractsLightFlag) {
+			return failValidation(cmd, f, "--count cannot be used with --status-summary, --needs-action, or --light")
+		}
+		status := contractsStatusFlag
+		if contractsNeedsActionFlag && !cmd.Flags().Changed("status") {
+			// Actionable contracts are never active, so search every status.
+			status = ""
+		}
+		if contractsStatusSumFlag && !cmd.Flags().Changed("status") {
+			// A summary covers every status unless --status narrows it.
+			status = ""
+		}
+
+		fetch := func(ctx context.Context, cursor string, limit int) (CursorListResult[api.Contract], error) {
 			resp, err := client.ListContracts(ctx, api.ContractsListParams{
-				Limit:  limit,
-				Cursor: cursor,
-				Status: contractsStatusFlag,
-				Type:   contractsTypeFlag,
+				Limit:       limit,
+				Cursor:      cursor,
+				Status:      status,
+				Type:        contractsTypeFlag,
+				WorkerEmail: contractsWorkerEmailFlag,
+				Country:     contractsCountryFlag,
 			})
 			if err != nil {
 				return CursorListResult[api.Contract]{}, err
@@ -101,58 +149,73 @@ var contractsListCmd = &cobra.Command{
 					Total: resp.Page.Total,
 				},
 			}, nil
-		})
+		}
+		entity := &contractEntityFilter{client: client, id: contractsEntityIDFlag}
+
+		if contractsCountFlag || contractsStatusSumFlag {
+			// Counts cover every page and are accumulated as pages arrive,
+			// so no contracts are kept in memory.
+			count := 0
+			summary := newContractStatusSummary(contractsSumByTypeFlag)
+			_, hasMore, err := walkCursorPages(api.WithServerFields(cmd.Context(), contractsListNeededFields()...), true, contractsCursorFlag, contractsLimitFlag, fetch, func(batch []api.Contract) error {
+				for i := range batch {
+					c := &batch[i]
+					if !contractMatchesWorker(c, contractsWorkerEmailFlag, contractsCountryFlag) {
+						continue
+					}
+					ok, err := entity.match(cmd.Context(), c)
+					if err != nil {
+						return err
+					}
+					if !ok {
+						continue
+					}
+					if contractsCountFlag {
+						count++
+					} else {
+						summary.add(c)
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return HandleError(f, err, "listing contracts")
+			}
+			if hasMore {
+				f.PrintWarning("Stopped at --max-pages; counts cover only the fetched contracts")
+			}
+			if contractsCountFlag {
+				return outputCount(cmd, f, count)
+			}
+			return outputContractStatusSummary(cmd, f, summary)
+		}
+
+		allContracts, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context(), contractsListNeededFields()...), contractsAllFlag, contractsCursorFlag, contractsLimitFlag, fetch)
 		if err != nil {
 			return HandleError(f, err, "listing contracts")
 		}
 
 		if contractsEntityIDFlag != "" {
 			filtered := make([]api.Contract, 0, len(allContracts))
-			hasEntityIDs := false
-			for _, c := range allContracts {
-				if c.EntityID == "" {
-					continue
-				}
-				hasEntityIDs = true
-				if c.EntityID == contractsEntityIDFlag {
-					filtered = append(filtered, c)
-				}
-			}
-			if hasEntityIDs {
-				allContracts = filtered
-			} else {
-				entities, err := client.ListLegalEntities(cmd.Context())
+			for i := range allContracts {
+				ok, err := entity.match(cmd.Context(), &allContracts[i])
 				if err != nil {
-					return HandleError(f, err, "resolving legal entity")
-				}
-				var entityNameFilter string
-				for _, e := range entities {
-					if e.ID == contractsEntityIDFlag {
-						entityNameFilter = e.Name
-						break
-					}
+					return HandleError(f, err, "listing contracts")
 				}
-				if entityNameFilter == "" {
-					return fmt.Errorf("legal entity %s not found", contractsEntityIDFlag)
+				if ok {
+					filtered = append(filtered, allContracts[i])
 				}
-				filtered = filtered[:0]
-				for _, c := range allContracts {
-					if c.Entity == entityNameFilter {
-						filtered = append(filtered, c)
-					}
-				}
-				allContracts = filtered
 			}
+			allContracts = filtered
 		}
 
-		if contractsCountryFlag != "" {
-			filtered := make([]api.Contract, 0, len(allContracts))
-			for _, c := range allContracts {
-				if strings.EqualFold(c.Country, contractsCountryFlag) {
-					filtered = append(filtered, c)
-				}
-			}
-			allContracts = filtered
+		allContracts = filterContractsByWorker(allContracts, contractsWorkerEmailFlag, contractsCountryFlag)
+
+		if contractsNeedsActionFlag {
+			actionable := contractsNeedingAction(allContracts)
+			return outputList(cmd, f, actionable, hasMore, "No contracts need action.", []string{"ID", "TITLE", "WORKER", "STATUS", "ACTION", "NEXT STEP"}, func(c contractNeedingAction) []string {
+				return []string{c.ID, c.Title, c.WorkerName, c.Status, c.NextAction, c.NextCommand}
+			}, makeListResponse(actionable, page))
 		}
 
 		response := makeListResponse(allContracts, page)
@@ -178,10 +241,173 @@ var contractsListCmd = &cobra.Command{
 	},
 }
 
+// contractsListNeededFields names the raw contract fields contracts list
+// reads itself for the active filters and summaries, so --server-fields
+// cannot drop them from the request.
+func contractsListNeededFields() []string {
+	var fields []string
+	if contractsWorkerEmailFlag != "" || contractsCountryFlag != "" {
+		fields = append(fields, "worker")
+	}
+	if contractsEntityIDFlag != "" {
+		fields = append(fields, "client", "legal_entity_id")
+	}
+	if contractsStatusSumFlag || contractsNeedsActionFlag {
+		fields = append(fields, "status")
+	}
+	if contractsSumByTypeFlag {
+		fields = append(fields, "type")
+	}
+	if contractsNeedsActionFlag {
+		fields = append(fields, "id")
+	}
+	return fields
+}
+
+// filterContractsByWorker keeps contracts whose worker matches email and
+// country (case-insensitive; empty means any). The API may ignore these query
+// parameters, so results are always filtered here as well.
+func filterContractsByWorker(contracts []api.Contract, email, country string) []api.Contract {
+	if email == "" && country == "" {
+		return contracts
+	}
+	filtered := make([]api.Contract, 0, len(contracts))
+	for i := range contracts {
+		if contractMatchesWorker(&contracts[i], email, country) {
+			filtered = append(filtered, contracts[i])
+		}
+	}
+	return filtered
+}
+
+func contractMatchesWorker(c *api.Contract, email, country string) bool {
+	if email != "" && !strings.EqualFold(c.WorkerEmail, email) && !strings.EqualFold(c.Worker.Email, email) {
+		return false
+	}
+	return country == "" || strings.EqualFold(c.Country, country)
+}
+
+// contractEntityFilter matches contracts against the --entity-id legal
+// entity. Contracts without an entity ID are matched by entity name, which is
+// looked up once, the first time it is needed. An empty id matches everything.
+type contractEntityFilter struct {
+	client *api.Client
+	id     string
+	name   string
+}
+
+func (e *contractEntityFilter) match(ctx context.Context, c *api.Contract) (bool, error) {
+	if e.id == "" {
+		return true, nil
+	}
+	if c.EntityID != "" {
+		return c.EntityID == e.id, nil
+	}
+	if e.name == "" {
+		entities, _, _, err := collectCursorItems(ctx, true, "", 100, legalEntitiesPageFetcher(e.client))
+		if err != nil {
+			return false, fmt.Errorf("resolving legal entity: %w", err)
+		}
+		for _, entity := range entities {
+			if entity.ID == e.id {
+				e.name = entity.Name
+				break
+			}
+		}
+		if e.name == "" {
+			return false, fmt.Errorf("legal entity %s not found", e.id)
+		}
+	}
+	return c.Entity == e.name, nil
+}
+
+// contractStatusSummary is the --status-summary output of contracts list.
+type contractStatusSummary struct {
+	Total           int                       `json:"total"`
+	ByStatus        map[string]int            `json:"byStatus"`
+	ByStatusAndType map[string]map[string]int `json:"byStatusAndType,omitempty"`
+}
+
+// summarizeContractStatuses counts contracts per status and, with byType,
+// per status and type. Missing values count as "unknown".
+func summarizeContractStatuses(contracts []api.Contract, byType bool) contractStatusSummary {
+	summary := newContractStatusSummary(byType)
+	for i := range contracts {
+		summary.add(&contracts[i])
+	}
+	return summary
+}
+
+func newContractStatusSummary(byType bool) contractStatusSummary {
+	summary := contractStatusSummary{ByStatus: map[string]int{}}
+	if byType {
+		summary.ByStatusAndType = map[string]map[string]int{}
+	}
+	return summary
+}
+
+func (s *contractStatusSummary) add(c *api.Contract) {
+	status := valueOrUnknown(c.Status)
+	s.Total++
+	s.ByStatus[status]++
+	if s.ByStatusAndType != nil {
+		if s.ByStatusAndType[status] == nil {
+			s.ByStatusAndType[status] = map[string]int{}
+		}
+		s.ByStatusAndType[status][valueOrUnknown(c.Type)]++
+	}
+}
+
+func valueOrUnknown(v string) string {
+	if v == "" {
+		return "unknown"
+	}
+	return v
+}
+
+func outputContractStatusSummary(cmd *cobra.Command, f *outfmt.Formatter, summary contractStatusSummary) error {
+	return f.OutputFiltered(cmd.Context(), func() {
+		if summary.Total == 0 {
+			f.PrintText("No contracts found.")
+			return
+		}
+		statuses := make([]string, 0, len(summary.ByStatus))
+		for status := range summary.ByStatus {
+			statuses = append(statuses, status)
+		}
+		sort.Strings(statuses)
+
+		if summary.ByStatusAndType != nil {
+			table := f.NewTable("STATUS", "TYPE", "COUNT")
+			for _, status := range statuses {
+				types := make([]string, 0, len(summary.ByStatusAndType[status]))
+				for typ := range summary.ByStatusAndType[status] {
+					types = append(types, typ)
+				}
+				sort.Strings(types)
+				for _, typ := range types {
+					table.AddRow(status, typ, fmt.Sprintf("%d", summary.ByStatusAndType[status][typ]))
+				}
+			}
+			table.AddRow("TOTAL", "", fmt.Sprintf("%d", summary.Total))
+			table.Render()
+			return
+		}
+
+		table := f.NewTable("STATUS", "COUNT")
+		for _, status := range statuses {
+			table.AddRow(status, fmt.Sprintf("%d", summary.ByStatus[status]))
+		}
+		table.AddRow("TOTAL", fmt.Sprintf("%d", summary.Total))
+		table.Render()
+	}, summary)
+}
+
 var contractsGetCmd = &cobra.Command{
-	Use:   "get <contract-id>",
-	Short: "Get contract details",
-	Args:  cobra.ExactArgs(1),
+	Use:     "get <contract-id>",
+	Short:   "Get contract details",
+	Example: "  deel contracts get c-123\n  deel contracts get c-123 --compare-template tpl-1 --json",
+	Args:    cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 		client, err := getClient()
@@ -189,11 +415,27 @@ var contractsGetCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		contract, err := client.GetContract(cmd.Context(), args[0])
+		if contractsCompareTmplFlag != "" && contractsLightFlag {
+			return failValidation(cmd, f, "--compare-template cannot be used with --light")
+		}
+
+		contract, err := client.GetContract(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "getting contract")
 		}
 
+		if contractsCompareTmplFlag != "" {
+			template, err := client.GetContractTemplate(cmd.Context(), contractsCompareTmplFlag)
+			if err != nil {
+				return HandleError(f, err, "getting contract template")
+			}
+			comparison, err := compareContractToTemplate(contract, contractsCompareTmplFlag, template)
+			if err != nil {
+				return HandleError(f, err, "comparing contract to template")
+			}
+			return outputTemplateComparison(cmd, f, comparison)
+		}
+
 		var jsonPayload any = contract
 		if contractsLightFlag {
 			jsonPayload = toLightContract(*contract)
@@ -221,6 +463,63 @@ var contractsGetCmd = &cobra.Command{
 	},
 }
 
+// contractTemplateMetaFields describe a template rather than the contracts
+// made from it, so --compare-template skips them.
+var contractTemplateMetaFields = []string{"id", "name", "description", "created_at", "updated_at"}
+
+// contractTemplateComparison is the --compare-template output of contracts get.
+type contractTemplateComparison struct {
+	ContractID  string      `json:"contract_id"`
+	TemplateID  string      `json:"template_id"`
+	Matches     bool        `json:"matches"`
+	Differences []fieldDiff `json:"differences"`
+	Unchecked   []string    `json:"unchecked,omitempty"`
+}
+
+// compareContractToTemplate diffs contract against the fields template
+// presets. Template fields are matched by their 'contracts get --json' names;
+// ones the contract does not have are listed as unchecked.
+func compareContractToTemplate(contract *api.Contract, templateID string, template map[string]any) (contractTemplateComparison, error) {
+	expected := make(map[string]any, len(template))
+	for k, v := range template {
+		expected[k] = v
+	}
+	for _, k := range contractTemplateMetaFields {
+		delete(expected, k)
+	}
+	diffs, unchecked, err := diffFields(expected, contract)
+	if err != nil {
+		return contractTemplateComparison{}, err
+	}
+	if diffs == nil {
+		diffs = []fieldDiff{}
+	}
+	return contractTemplateComparison{
+		ContractID:  contract.ID,
+		TemplateID:  templateID,
+		Matches:     len(diffs) == 0,
+		Differences: diffs,
+		Unchecked:   unchecked,
+	}, nil
+}
+
+func outputTemplateComparison(cmd *cobra.Command, f *outfmt.Formatter, c contractTemplateComparison) error {
+	return f.OutputFiltered(cmd.Context(), func() {
+		if c.Matches {
+			f.PrintSuccess("Contract %s matches template %s", c.ContractID, c.TemplateID)
+		} else {
+			f.PrintText(fmt.Sprintf("Contract %s differs from template %s in %d field(s):", c.ContractID, c.TemplateID, len(c.Differences)))
+			for _, d := range c.Differences {
+				f.PrintText(fmt.Sprintf("- %s: %s (template)", d.Path, formatDiffValue(d.Expected)))
+				f.PrintText(fmt.Sprintf("+ %s: %s (contract)", d.Path, formatDiffValue(d.Value)))
+			}
+		}
+		if len(c.Unchecked) > 0 {
+			f.PrintText("Not on the contract, not compared: " + strings.Join(c.Unchecked, ", "))
+		}
+	}, c)
+}
+
 var contractsAmendmentsCmd = &cobra.Command{
 	Use:   "amendments <contract-id>",
 	Short: "List contract amendments",
@@ -232,7 +531,7 @@ var contractsAmendmentsCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		amendments, err := client.ListContractAmendments(cmd.Context(), args[0])
+		amendments, err := client.ListContractAmendments(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "listing contract amendments")
 		}
@@ -256,33 +555,49 @@ var contractsAmendCmd = &cobra.Command{
 	Short: "Create a contract amendment",
 	Long: `Create an amendment to modify a contractor contract.
 
-Currently supports amending the scope of work. The amendment will require
-signatures from both the employer and contractor before taking effect.
+Change the rate, contract title, job title, or scope of work; give at least
+one. The amendment will require signatures from both the employer and
+contractor before taking effect. For EOR contracts use 'deel eor amend'.`,
+	Example: `  # Amend scope of work
+  deel contracts amend abc123 --scope "New scope of work description"
 
-Examples:
-  # Amend scope of work
-  deel contracts amend abc123 --scope "New scope of work description"`,
+  # Raise the rate from a future date
+  deel contracts amend abc123 --rate 95 --effective-date 2026-07-01 --reason "Annual review"
+
+  # Preview a promotion without creating it
+  deel contracts amend abc123 --job-title "Senior Engineer" --rate 120 --dry-run`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 
-		if amendScopeFlag == "" {
-			return failValidation(cmd, f, "--scope is required")
-		}
-
 		params := api.CreateContractAmendmentParams{
 			ScopeOfWork:    amendScopeFlag,
+			Title:          amendTitleFlag,
+			JobTitleName:   amendJobTitleFlag,
 			PaymentDueType: "REGULAR",
+			EffectiveDate:  amendEffectiveDateFlag,
+			Reason:         amendReasonFlag,
+		}
+		if cmd.Flags().Changed("rate") {
+			if amendRateFlag <= 0 {
+				return failValidation(cmd, f, "--rate must be greater than 0")
+			}
+			params.Amount = amendRateFlag
+		}
+		if params.ScopeOfWork == "" && params.Amount == 0 && params.Title == "" && params.JobTitleName == "" {
+			return failValidation(cmd, f, "nothing to amend: give at least one of --rate, --title, --job-title, or --scope")
+		}
+		if amendEffectiveDateFlag != "" {
+			if err := validateEffectiveDate(amendEffectiveDateFlag, time.Now()); err != nil {
+				return failValidation(cmd, f, "--effective-date: "+err.Error())
+			}
 		}
 
 		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
 			Operation:   "CREATE",
 			Resource:    "Amendment",
 			Description: "Create contract amendment",
-			Details: map[string]string{
-				"ContractID":  args[0],
-				"ScopeOfWork": amendScopeFlag,
-			},
+			Details:     contractAmendmentDetails(args[0], params),
 		}); ok {
 			return err
 		}
@@ -309,6 +624,26 @@ Examples:
 	},
 }
 
+// contractAmendmentDetails lists the fields an amendment sets, for --dry-run.
+func contractAmendmentDetails(contractID string, params api.CreateContractAmendmentParams) map[string]string {
+	details := map[string]string{"ContractID": contractID}
+	for key, value := range map[string]string{
+		"ScopeOfWork":   params.ScopeOfWork,
+		"Title":         params.Title,
+		"JobTitle":      params.JobTitleName,
+		"EffectiveDate": params.EffectiveDate,
+		"Reason":        params.Reason,
+	} {
+		if value != "" {
+			details[key] = value
+		}
+	}
+	if params.Amount > 0 {
+		details["Rate"] = strconv.FormatFloat(params.Amount, 'f', -1, 64)
+	}
+	return details
+}
+
 var contractsPaymentDatesCmd = &cobra.Command{
 	Use:   "payment-dates <contract-id>",
 	Short: "Get contract payment dates",
@@ -320,7 +655,7 @@ var contractsPaymentDatesCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		dates, err := client.GetContractPaymentDates(cmd.Context(), args[0])
+		dates, err := client.GetContractPaymentDates(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "getting payment dates")
 		}
@@ -342,9 +677,46 @@ var contractsPaymentDatesCmd = &cobra.Command{
 var contractsCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new contract",
+	Long: `Create a new contract from flags, or many contracts from a file.
+
+With --from-file, each row of a CSV (header row naming the fields) or JSON
+array is created in order. Field names match the API parameters: title, type,
+worker_email, worker_first_name, worker_last_name, currency, rate, country,
+job_title, scope_of_work, start_date, end_date, payment_cycle, seniority_level,
+template_id, legal_entity_id, group_id, cycle_end, cycle_end_type, frequency,
+special_clause, manager_id. Failed rows do not stop the run; the command exits
+non-zero if any row failed. --concurrency N creates up to N rows at a time;
+results are still reported in file order.
+
+--idempotency-scope S sends an idempotency key derived from S and the
+contract fields. Re-running the same command with the same scope sends the
+same key, so the API de-duplicates it; identical fields under a different
+scope (another CI build, another day) get a different key and create a new
+contract. --then steps send keys derived from the scoped key, one per step,
+so they never reuse the create's key. An explicit --idempotency-key (or
+DEEL_IDEMPOTENCY_KEY) takes precedence and the scope is ignored.`,
+	Example: `  deel contracts create --title "Dev" --type payg_tasks --worker-email a@b.co --country US --currency USD
+  deel contracts create --title "Dev" --type payg_tasks --worker-email a@b.co --country US --currency USD --then sign,invite --signer "Ada Lovelace"
+  deel contracts create --from-file workers.csv --dry-run
+  deel contracts create --from-file workers.json --concurrency 4
+  deel contracts create --title "Dev" --type payg_tasks --worker-email a@b.co --country US --currency USD --idempotency-scope "$CI_BUILD_ID"`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 
+		if contractFromFileFlag != "" {
+			if contractIdemScopeFlag != "" {
+				return failValidation(cmd, f, "--idempotency-scope is not supported with --from-file")
+			}
+			return runBulkContractCreate(cmd, f, contractFromFileFlag, contractConcurrencyFlag)
+		}
+		if cmd.Flags().Changed("concurrency") {
+			return failValidation(cmd, f, "--concurrency requires --from-file")
+		}
+		thenChain, err := parseThenSteps("contracts", contractThenFlag)
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
 		// Validate required fields
 		if contractTitleFlag == "" {
 			return failValidation(cmd, f, "--title is required")
@@ -352,15 +724,31 @@ var contractsCreateCmd = &cobra.Command{
 		if contractTypeFlag == "" {
 			return failValidation(cmd, f, "--type is required (payg_tasks, pay_as_you_go_time_based, payg_milestones, ongoing_time_based)")
 		}
+		if err := validateContractEnum("--type", contractTypeFlag, contractTypes, contractTypeAliases); err != nil {
+			return failValidation(cmd, f, err.Error(), "deel contracts payment-cycles")
+		}
+		if contractPaymentCycleFlag != "" {
+			if err := validateContractEnum("--payment-cycle", contractPaymentCycleFlag, contractPaymentCycles, nil); err != nil {
+				return failValidation(cmd, f, err.Error(), "deel contracts payment-cycles")
+			}
+		}
 		if contractWorkerEmailFlag == "" {
 			return failValidation(cmd, f, "--worker-email is required")
 		}
-		if contractCurrencyFlag == "" {
-			return failValidation(cmd, f, "--currency is required")
-		}
 		if contractCountryFlag == "" {
 			return failValidation(cmd, f, "--country is required")
 		}
+		currency, currencyDerived, err := resolveContractCurrency(contractCurrencyFlag, contractCountryFlag, contractCurrencyFromCountryFlag)
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+		if currency == "" {
+			return failValidation(cmd, f, "--currency is required (or use --currency-from-country)")
+		}
+		currencyPreview := currency
+		if currencyDerived {
+			currencyPreview = fmt.Sprintf("%s (derived from country %s)", currency, strings.ToUpper(contractCountryFlag))
+		}
 
 		params := api.CreateContractParams{
 			Title:          contractTitleFlag,
@@ -368,7 +756,7 @@ var contractsCreateCmd = &cobra.Command{
 			WorkerEmail:    contractWorkerEmailFlag,
 			WorkerFirst:    contractWorkerFirstFlag,
 			WorkerLast:     contractWorkerLastFlag,
-			Currency:       contractCurrencyFlag,
+			Currency:       currency,
 			Rate:           contractRateFlag,
 			Country:        contractCountryFlag,
 			JobTitle:       contractJobTitleFlag,
@@ -387,27 +775,38 @@ var contractsCreateCmd = &cobra.Command{
 			ManagerID:      contractManagerFlag,
 		}
 
+		if err := checkCurrency(cmd.Context(), f, getClient, currency, contractSkipCurrencyFlag); err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
+		scopedKey, err := contractIdempotencyKey(f, contractIdemScopeFlag, params)
+		if err != nil {
+			return HandleError(f, err, "deriving idempotency key")
+		}
+
 		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
 			Operation:   "CREATE",
 			Resource:    "Contract",
 			Description: "Create contract",
 			Details: map[string]string{
-				"Title":        contractTitleFlag,
-				"Type":         contractTypeFlag,
-				"WorkerEmail":  contractWorkerEmailFlag,
-				"Currency":     contractCurrencyFlag,
-				"Rate":         fmt.Sprintf("%.2f", contractRateFlag),
-				"Country":      contractCountryFlag,
-				"JobTitle":     contractJobTitleFlag,
-				"StartDate":    contractStartDateFlag,
-				"EndDate":      contractEndDateFlag,
-				"Template":     contractTemplateFlag,
-				"LegalEntity":  contractLegalEntityFlag,
-				"Group":        contractGroupFlag,
-				"Manager":      contractManagerFlag,
-				"CycleEnd":     fmt.Sprintf("%d", contractCycleEndFlag),
-				"CycleEndType": contractCycleEndTypeFlag,
-				"Frequency":    contractFrequencyFlag,
+				"Title":          contractTitleFlag,
+				"Type":           contractTypeFlag,
+				"WorkerEmail":    contractWorkerEmailFlag,
+				"Currency":       currencyPreview,
+				"Rate":           fmt.Sprintf("%.2f", contractRateFlag),
+				"Country":        contractCountryFlag,
+				"JobTitle":       contractJobTitleFlag,
+				"StartDate":      contractStartDateFlag,
+				"EndDate":        contractEndDateFlag,
+				"Template":       contractTemplateFlag,
+				"LegalEntity":    contractLegalEntityFlag,
+				"Group":          contractGroupFlag,
+				"Manager":        contractManagerFlag,
+				"CycleEnd":       fmt.Sprintf("%d", contractCycleEndFlag),
+				"CycleEndType":   contractCycleEndTypeFlag,
+				"Frequency":      contractFrequencyFlag,
+				"Then":           strings.Join(thenChain, ", "),
+				"IdempotencyKey": scopedKey,
 			},
 		}); ok {
 			return err
@@ -418,11 +817,21 @@ var contractsCreateCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		contract, err := client.CreateContract(cmd.Context(), params)
+		ctx := cmd.Context()
+		if scopedKey != "" {
+			ctx = useScopedIdempotencyKey(ctx, client, scopedKey)
+		}
+
+		contract, err := client.CreateContract(ctx, params)
 		if err != nil {
 			return HandleError(f, err, "creating contract")
 		}
 
+		if len(thenChain) > 0 {
+			steps, ok := runThenChain(ctx, client, "contracts", contract.ID, contract, thenChain)
+			return outputThenChain(ctx, f, steps, ok)
+		}
+
 		result := map[string]any{
 			"contract": contract,
 			"urls": map[string]string{
@@ -444,7 +853,7 @@ var contractsCreateCmd = &cobra.Command{
 			)
 		}
 
-		return f.OutputFiltered(cmd.Context(), func() {
+		return f.OutputFiltered(ctx, func() {
 			f.PrintSuccess("Contract created successfully")
 			f.PrintText("Contract ID: " + contract.ID)
 			f.PrintText("Status: " + contract.Status)
@@ -466,23 +875,151 @@ var contractsCreateCmd = &cobra.Command{
 	},
 }
 
+// contractIdempotencyKey returns the key --idempotency-scope derives for
+// params, or "" when no scope is set or an explicit key takes precedence.
+func contractIdempotencyKey(f *outfmt.Formatter, scope string, params api.CreateContractParams) (string, error) {
+	if scope == "" {
+		return "", nil
+	}
+	if explicitIdempotencyKey() {
+		f.PrintWarning("--idempotency-key is set; ignoring --idempotency-scope")
+		return "", nil
+	}
+	return api.ScopedIdempotencyKey(scope, params)
+}
+
+// useScopedIdempotencyKey makes key the client's idempotency key and reports
+// it like an --auto-idempotency key once a write carries it. The create sends
+// key itself; --then steps send keys derived from it, so a chained sign or
+// invite never reuses the create's key.
+func useScopedIdempotencyKey(ctx context.Context, client *api.Client, key string) context.Context {
+	autoIdempotencyKey = key
+	client.SetIdempotencyKey(key)
+	ctx = outfmt.WithIdempotencyKey(ctx, sentAutoIdempotencyKey)
+	return outfmt.WithIdempotencyParts(ctx, sentIdempotencyParts)
+}
+
+var contractsUpdateCmd = &cobra.Command{
+	Use:   "update <contract-id>",
+	Short: "Update a contract",
+	Long:  "Update an existing contract. Only the flags you pass are sent: --title, --rate, --job-title, --scope, --end-date, --payment-cycle. Pass an empty value, such as --end-date \"\", to clear a field.",
+	Example: `  deel contracts update c-123 --rate 95
+  deel contracts update c-123 --title "Senior Engineer" --end-date 2026-12-31
+  deel contracts update c-123 --end-date ""`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+		flags := cmd.Flags()
+
+		if !flags.Changed("title") && !flags.Changed("rate") && !flags.Changed("job-title") &&
+			!flags.Changed("scope") && !flags.Changed("end-date") && !flags.Changed("payment-cycle") {
+			return failValidation(cmd, f, "At least one flag (--title, --rate, --job-title, --scope, --end-date, --payment-cycle) must be provided")
+		}
+
+		details := map[string]string{
+			"ID": args[0],
+		}
+		params := api.UpdateContractParams{}
+		if flags.Changed("title") {
+			if strings.TrimSpace(contractTitleFlag) == "" {
+				return failValidation(cmd, f, "--title cannot be empty")
+			}
+			params.Title = &contractTitleFlag
+			details["Title"] = contractTitleFlag
+		}
+		if flags.Changed("rate") {
+			if contractRateFlag <= 0 {
+				return failValidation(cmd, f, "--rate must be greater than 0")
+			}
+			params.Rate = &contractRateFlag
+			details["Rate"] = fmt.Sprintf("%.2f", contractRateFlag)
+		}
+		if flags.Changed("job-title") {
+			params.JobTitle = &contractJobTitleFlag
+			details["JobTitle"] = contractJobTitleFlag
+		}
+		if flags.Changed("scope") {
+			params.ScopeOfWork = &contractScopeFlag
+			details["Scope"] = contractScopeFlag
+		}
+		if flags.Changed("end-date") {
+			if contractEndDateFlag != "" {
+				if err := validateDate(contractEndDateFlag); err != nil {
+					return failValidation(cmd, f, "--end-date: "+err.Error())
+				}
+			}
+			params.EndDate = &contractEndDateFlag
+			details["EndDate"] = contractEndDateFlag
+		}
+		if flags.Changed("payment-cycle") {
+			if err := validateContractEnum("--payment-cycle", contractPaymentCycleFlag, contractPaymentCycles, nil); err != nil {
+				return failValidation(cmd, f, err.Error(), "deel contracts payment-cycles")
+			}
+			params.PaymentCycle = &contractPaymentCycleFlag
+			details["PaymentCycle"] = contractPaymentCycleFlag
+		}
+
+		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
+			Operation:   "UPDATE",
+			Resource:    "Contract",
+			Description: "Update contract",
+			Details:     details,
+		}); ok {
+			return err
+		}
+
+		client, err := getClient()
+		if err != nil {
+			return HandleError(f, err, "initializing client")
+		}
+
+		contract, err := client.UpdateContract(cmd.Context(), args[0], params)
+		if err != nil {
+			return HandleError(f, err, "updating contract")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			f.PrintSuccess("Contract updated successfully")
+			f.PrintText("ID:           " + contract.ID)
+			f.PrintText("Title:        " + contract.Title)
+			f.PrintText("Status:       " + contract.Status)
+			if contract.CompensationAmount > 0 {
+				f.PrintText(fmt.Sprintf("Compensation: %.2f %s", contract.CompensationAmount, contract.Currency))
+			}
+			if contract.EndDate != "" {
+				f.PrintText("End Date:     " + contract.EndDate)
+			}
+		}, contract)
+	},
+}
+
 var contractsSignCmd = &cobra.Command{
-	Use:   "sign <contract-id>",
-	Short: "Sign a contract",
-	Args:  cobra.ExactArgs(1),
+	Use:   "sign <contract-id>...",
+	Short: "Sign one or more contracts",
+	Long: `Sign contracts on behalf of the client.
+
+With several IDs every contract is attempted and the output lists each one
+with ok and any error; the command exits non-zero if any could not be signed.
+--concurrency N signs up to N contracts at a time.`,
+	Example: `  deel contracts sign c-123 --signer "Ada Lovelace"
+  deel contracts sign c-123 c-456 c-789 --signer "Ada Lovelace" --json`,
+	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 
 		if signSignerFlag == "" {
 			return failValidation(cmd, f, "--signer is required")
 		}
+		if signConcurrencyFlag < 1 || signConcurrencyFlag > maxBulkConcurrency {
+			return failValidation(cmd, f, fmt.Sprintf("--concurrency must be between 1 and %d", maxBulkConcurrency))
+		}
 
 		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
 			Operation:   "SIGN",
 			Resource:    "Contract",
 			Description: "Sign contract",
 			Details: map[string]string{
-				"ID":     args[0],
+				"ID":     strings.Join(args, ", "),
 				"Signer": signSignerFlag,
 			},
 		}); ok {
@@ -494,6 +1031,10 @@ var contractsSignCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
+		if len(args) > 1 {
+			return runBulkContractSign(cmd.Context(), f, client, args, signSignerFlag, signConcurrencyFlag)
+		}
+
 		contract, err := client.SignContract(cmd.Context(), args[0], signSignerFlag)
 		if err != nil {
 			return HandleError(f, err, "signing contract")
@@ -507,6 +1048,27 @@ var contractsSignCmd = &cobra.Command{
 	},
 }
 
+// Termination notes (termination_reason_description) read from --reason-file
+// must be 100-5000 characters, as for EOR reason details. --notes is sent as
+// given, as it always has been.
+const (
+	contractTerminationNotesMinLen = 100
+	contractTerminationNotesMaxLen = 5000
+)
+
+// contractTerminationNotes resolves --notes or --reason-file. Only file
+// content is checked against the length limits. Empty notes are not sent.
+func contractTerminationNotes(inline, path string) (string, error) {
+	notes, err := resolveTextFlag(inline, path, "--notes", "--reason-file")
+	if err != nil || notes == "" || path == "" {
+		return notes, err
+	}
+	if err := validateTextLength("--reason-file", notes, contractTerminationNotesMinLen, contractTerminationNotesMaxLen); err != nil {
+		return "", err
+	}
+	return notes, nil
+}
+
 var contractsTerminateCmd = &cobra.Command{
 	Use:   "terminate <contract-id>",
 	Short: "Terminate or cancel a contract",
@@ -520,14 +1082,22 @@ Examples:
   deel contracts terminate abc123 --reason "Project has come to an end" --immediate
 
   # Schedule termination for an active contract
-  deel contracts terminate abc123 --reason "Project has come to an end" --date 2026-02-01`,
+  deel contracts terminate abc123 --reason "Project has come to an end" --date 2026-02-01
+
+  # Read the notes from a file (100-5000 characters)
+  deel contracts terminate abc123 --reason "Project has come to an end" --reason-file ./notes.txt --immediate`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 
-		if terminateReasonFlag == "" {
+		reason := strings.TrimSpace(terminateReasonFlag)
+		if reason == "" {
 			return failValidation(cmd, f, "--reason is required", "To see available reasons, run: deel contracts termination-reasons")
 		}
+		notes, err := contractTerminationNotes(terminateNotesFlag, terminateReasonFileFlag)
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
 
 		client, err := getClient()
 		if err != nil {
@@ -541,25 +1111,25 @@ Examples:
 		}
 
 		var reasonID string
-		reasonLower := strings.ToLower(terminateReasonFlag)
+		reasonLower := strings.ToLower(reason)
 		for _, r := range reasons {
-			if strings.ToLower(r.Name) == reasonLower || r.ID == terminateReasonFlag {
+			if strings.ToLower(r.Name) == reasonLower || r.ID == reason {
 				reasonID = r.ID
 				break
 			}
 		}
 		if reasonID == "" {
-			f.PrintError("Unknown termination reason: %s", terminateReasonFlag)
+			f.PrintError("Unknown termination reason: %s", reason)
 			f.PrintText("\nAvailable reasons:")
 			for _, r := range reasons {
 				f.PrintText("  • " + r.Name)
 			}
-			return failValidation(cmd, f, fmt.Sprintf("Unknown termination reason: %s", terminateReasonFlag))
+			return failValidation(cmd, f, fmt.Sprintf("Unknown termination reason: %s", reason))
 		}
 
 		params := api.TerminateContractParams{
 			TerminationReasonID:          reasonID,
-			TerminationReasonDescription: terminateNotesFlag,
+			TerminationReasonDescription: notes,
 			CompletionDate:               terminateDateFlag,
 			TerminateNow:                 terminateImmediateFlag,
 			TerminationType:              terminateTypeFlag,
@@ -572,9 +1142,9 @@ Examples:
 			Description: "Terminate contract",
 			Details: map[string]string{
 				"ID":            args[0],
-				"Reason":        terminateReasonFlag,
+				"Reason":        reason,
 				"EffectiveDate": terminateDateFlag,
-				"Notes":         terminateNotesFlag,
+				"Notes":         notes,
 			},
 		}); ok {
 			return err
@@ -588,14 +1158,14 @@ Examples:
 		return f.OutputFiltered(cmd.Context(), func() {
 			f.PrintSuccess("Contract termination initiated successfully")
 			f.PrintText("Contract ID: " + args[0])
-			f.PrintText("Reason: " + terminateReasonFlag)
+			f.PrintText("Reason: " + reason)
 			if terminateDateFlag != "" {
 				f.PrintText("Effective Date: " + terminateDateFlag)
 			}
 		}, map[string]any{
 			"terminated":     true,
 			"contract_id":    args[0],
-			"reason":         terminateReasonFlag,
+			"reason":         reason,
 			"effective_date": terminateDateFlag,
 			"immediate":      terminateImmediateFlag,
 		})
@@ -612,7 +1182,7 @@ var contractsTerminationReasonsCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		reasons, err := client.ListTerminationReasons(cmd.Context())
+		reasons, err := client.ListTerminationReasons(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "listing termination reasons")
 		}
@@ -635,6 +1205,7 @@ var contractsTerminationReasonsCmd = &cobra.Command{
 var contractsPDFCmd = &cobra.Command{
 	Use:   "pdf <contract-id>",
 	Short: "Get contract PDF download URL",
+	Long:  "Print the pre-signed download URL for the contract PDF. To save the file instead, use 'deel contracts download-pdf'.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
@@ -655,6 +1226,115 @@ var contractsPDFCmd = &cobra.Command{
 	},
 }
 
+var contractsDownloadPDFCmd = &cobra.Command{
+	Use:   "download-pdf <contract-id> [path]",
+	Short: "Download the contract PDF to a file",
+	Long: `Download the contract PDF and save it to path (default: <contract-id>.pdf
+in the current directory). Use '-' as the path to write the PDF to stdout.
+
+The whole download must finish within --timeout; raise it for large files.
+Progress is shown on stderr when it is a terminal.`,
+	Example: "  deel contracts download-pdf c-123\n  deel contracts download-pdf c-123 ~/contracts/jane.pdf --timeout 5m",
+	Args:    cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, f, err := initClient("downloading contract PDF")
+		if err != nil {
+			return err
+		}
+
+		outputPath := args[0] + ".pdf"
+		if len(args) == 2 {
+			outputPath = args[1]
+		}
+		if outputPath == "-" && (f.IsJSON() || f.IsYAML()) {
+			return failValidation(cmd, f, "cannot write PDF bytes to stdout in --json/--yaml mode; give a file path")
+		}
+
+		url, err := client.GetContractPDF(cmd.Context(), args[0])
+		if err != nil {
+			return HandleError(f, err, "getting contract PDF")
+		}
+		if url == "" {
+			return HandleError(f, fmt.Errorf("API returned no download URL"), "getting contract PDF")
+		}
+
+		var progress func(written, total int64)
+		clearProgress := func() {}
+		if term.IsTerminal(int(os.Stderr.Fd())) {
+			progress, clearProgress = newDownloadProgress(os.Stderr, filepath.Base(outputPath))
+		}
+
+		if outputPath == "-" {
+			_, err := client.DownloadContractPDF(cmd.Context(), url, os.Stdout, progress)
+			clearProgress()
+			if err != nil {
+				return HandleError(f, err, "downloading contract PDF")
+			}
+			return nil
+		}
+
+		size, err := downloadToFile(outputPath, func(w io.Writer) (int64, error) {
+			return client.DownloadContractPDF(cmd.Context(), url, w, progress)
+		})
+		clearProgress()
+		if err != nil {
+			return HandleError(f, err, "downloading contract PDF")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			f.PrintSuccess("Saved contract to %s (%s)", outputPath, formatBytes(size))
+		}, map[string]any{
+			"saved":       true,
+			"contract_id": args[0],
+			"path":        outputPath,
+			"bytes":       size,
+		})
+	},
+}
+
+// downloadToFile writes a download to a temporary file next to path and
+// renames it into place once complete, so a failed or rejected download never
+// leaves a partial file behind.
+func downloadToFile(path string, download func(w io.Writer) (int64, error)) (int64, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.part")
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	n, err := download(tmp)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return 0, err
+	}
+	return n, os.Rename(tmp.Name(), path)
+}
+
+// newDownloadProgress returns a progress callback that redraws a single
+// status line on w at most every 100ms, and a function that clears the line
+// once the download is over.
+func newDownloadProgress(w io.Writer, name string) (update func(written, total int64), clear func()) {
+	var last time.Time
+	update = func(written, total int64) {
+		if time.Since(last) < 100*time.Millisecond && written != total {
+			return
+		}
+		last = time.Now()
+		line := fmt.Sprintf("Downloading %s: %s", name, formatBytes(written))
+		if total > 0 {
+			line += fmt.Sprintf(" / %s (%d%%)", formatBytes(total), written*100/total)
+		}
+		_, _ = fmt.Fprintf(w, "\r\033[K%s", line)
+	}
+	clear = func() { _, _ = fmt.Fprint(w, "\r\033[K") }
+	return update, clear
+}
+
 var contractsInviteCmd = &cobra.Command{
 	Use:   "invite <contract-id>",
 	Short: "Send invitation email to worker",
@@ -706,7 +1386,7 @@ var contractsInviteLinkCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		url, err := client.GetInviteLink(cmd.Context(), args[0])
+		url, err := client.GetInviteLink(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "getting invite link")
 		}
@@ -728,7 +1408,7 @@ var contractsTemplatesCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		templates, err := client.ListContractTemplates(cmd.Context())
+		templates, err := client.ListContractTemplates(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "listing contract templates")
 		}
@@ -749,18 +1429,25 @@ var contractsTemplatesCmd = &cobra.Command{
 
 func init() {
 	// List command flags
+	enableWatch(contractsListCmd)
 	contractsListCmd.Flags().IntVar(&contractsLimitFlag, "limit", 100, "Maximum results")
 	contractsListCmd.Flags().StringVar(&contractsCursorFlag, "cursor", "", "Pagination cursor")
 	contractsListCmd.Flags().StringVar(&contractsStatusFlag, "status", "active", "Filter by status (default: active)")
 	contractsListCmd.Flags().StringVar(&contractsTypeFlag, "type", "", "Filter by type")
 	contractsListCmd.Flags().BoolVar(&contractsAllFlag, "all", false, "Fetch all pages")
+	contractsListCmd.Flags().BoolVar(&contractsStatusSumFlag, "status-summary", false, "Print contract counts per status instead of rows (fetches all pages and all statuses unless --status is set)")
+	contractsListCmd.Flags().BoolVar(&contractsSumByTypeFlag, "by-type", false, "Break --status-summary counts down by contract type")
+	contractsListCmd.Flags().BoolVar(&contractsCountFlag, "count", false, "Print only the number of matching contracts (fetches all pages without keeping them)")
 	contractsListCmd.Flags().StringVar(&contractsEntityIDFlag, "entity-id", "", "Filter by legal entity ID (client-side)")
-	contractsListCmd.Flags().StringVar(&contractsCountryFlag, "country", "", "Filter by worker country code (client-side)")
+	contractsListCmd.Flags().StringVar(&contractsCountryFlag, "country", "", "Filter by worker country code (sent to the API and re-applied client-side to fetched pages)")
+	contractsListCmd.Flags().StringVar(&contractsWorkerEmailFlag, "worker-email", "", "Filter by worker email, case-insensitive (sent to the API and re-applied client-side to fetched pages)")
 	contractsListCmd.Flags().BoolVar(&contractsLightFlag, "light", false, "Minimal payload (saves tokens)")
+	contractsListCmd.Flags().BoolVar(&contractsNeedsActionFlag, "needs-action", false, "Only contracts waiting on you (to sign, invite, or approve), each with the suggested next step; searches all statuses unless --status is set")
 	flagAlias(contractsListCmd.Flags(), "light", "li")
 
 	// Get command light flag
 	contractsGetCmd.Flags().BoolVar(&contractsLightFlag, "light", false, "Minimal payload (saves tokens)")
+	contractsGetCmd.Flags().StringVar(&contractsCompareTmplFlag, "compare-template", "", "Report where the contract differs from this contract template's preset fields")
 	flagAlias(contractsGetCmd.Flags(), "light", "li")
 
 	// Create command flags
@@ -769,7 +1456,9 @@ func init() {
 	contractsCreateCmd.Flags().StringVar(&contractWorkerEmailFlag, "worker-email", "", "Worker email address (required)")
 	contractsCreateCmd.Flags().StringVar(&contractWorkerFirstFlag, "worker-first", "", "Worker first name")
 	contractsCreateCmd.Flags().StringVar(&contractWorkerLastFlag, "worker-last", "", "Worker last name")
-	contractsCreateCmd.Flags().StringVar(&contractCurrencyFlag, "currency", "", "Currency code (e.g., USD, EUR) (required)")
+	contractsCreateCmd.Flags().StringVar(&contractCurrencyFlag, "currency", "", "Currency code (e.g., USD, EUR) (required unless --currency-from-country)")
+	contractsCreateCmd.Flags().BoolVar(&contractSkipCurrencyFlag, "skip-currency-check", false, "Skip checking --currency against the supported currency list (e.g. offline)")
+	contractsCreateCmd.Flags().BoolVar(&contractCurrencyFromCountryFlag, "currency-from-country", false, "Default --currency to the country's currency (explicit --currency wins)")
 	contractsCreateCmd.Flags().Float64Var(&contractRateFlag, "rate", 0, "Compensation rate")
 	contractsCreateCmd.Flags().StringVar(&contractCountryFlag, "country", "", "Country code (required)")
 	contractsCreateCmd.Flags().StringVar(&contractJobTitleFlag, "job-title", "", "Job title")
@@ -788,9 +1477,24 @@ func init() {
 	contractsCreateCmd.Flags().StringVar(&contractSeniorityFlag, "seniority", "", "Seniority level ID (e.g., junior, mid, senior)")
 	contractsCreateCmd.Flags().StringVar(&contractSpecialClauseFlag, "special-clause", "", "Special clause text for contract")
 	contractsCreateCmd.Flags().StringVar(&contractManagerFlag, "manager", "", "Manager ID (printed in next steps for deferred assignment)")
+	contractsCreateCmd.Flags().StringVar(&contractFromFileFlag, "from-file", "", "Create one contract per row of a CSV or JSON file (- for stdin)")
+	contractsCreateCmd.Flags().StringSliceVar(&contractThenFlag, "then", nil, "Run follow-up steps on the new contract and print {steps: [...]}: get, sign, invite, invite-link")
+	contractsCreateCmd.Flags().StringVar(&signSignerFlag, "signer", "", "Signer name for --then sign")
+	contractsCreateCmd.Flags().StringVar(&inviteLocaleFlag, "locale", "en", "Invitation locale for --then invite")
+	contractsCreateCmd.Flags().IntVar(&contractConcurrencyFlag, "concurrency", 1, "Rows to create in parallel with --from-file (max 10)")
+	contractsCreateCmd.Flags().StringVar(&contractIdemScopeFlag, "idempotency-scope", "", "Send an idempotency key derived from this scope and the contract fields, so a re-run in the same scope (e.g. a CI build ID) is de-duplicated (ignored when --idempotency-key is set)")
+
+	// Update command flags (shared with create)
+	contractsUpdateCmd.Flags().StringVar(&contractTitleFlag, "title", "", "New contract title")
+	contractsUpdateCmd.Flags().Float64Var(&contractRateFlag, "rate", 0, "New compensation rate")
+	contractsUpdateCmd.Flags().StringVar(&contractJobTitleFlag, "job-title", "", "New job title")
+	contractsUpdateCmd.Flags().StringVar(&contractScopeFlag, "scope", "", "New scope of work")
+	contractsUpdateCmd.Flags().StringVar(&contractEndDateFlag, "end-date", "", "New end date (YYYY-MM-DD); empty clears it")
+	contractsUpdateCmd.Flags().StringVar(&contractPaymentCycleFlag, "payment-cycle", "", "New payment cycle: weekly, bi_weekly, monthly")
 
 	// Sign command flags
 	contractsSignCmd.Flags().StringVar(&signSignerFlag, "signer", "", "Full name of person signing on behalf of client (required)")
+	contractsSignCmd.Flags().IntVar(&signConcurrencyFlag, "concurrency", 1, "Contracts to sign in parallel when several IDs are given (max 10)")
 
 	// Invite command flags
 	contractsInviteCmd.Flags().StringVar(&inviteEmailFlag, "email", "", "Worker email address (required)")
@@ -799,6 +1503,7 @@ func init() {
 
 	// Terminate command flags
 	contractsTerminateCmd.Flags().StringVar(&terminateReasonFlag, "reason", "", "Termination reason (required)")
+	contractsTerminateCmd.Flags().StringVar(&terminateReasonFileFlag, "reason-file", "", "Read the termination notes from a file, 100-5000 characters (alternative to --notes)")
 	contractsTerminateCmd.Flags().StringVar(&terminateDateFlag, "date", "", "Completion date for scheduled termination (YYYY-MM-DD)")
 	contractsTerminateCmd.Flags().StringVar(&terminateNotesFlag, "notes", "", "Additional notes/description for the termination")
 	contractsTerminateCmd.Flags().BoolVar(&terminateImmediateFlag, "immediate", false, "Terminate immediately (overrides --date)")
@@ -806,7 +1511,12 @@ func init() {
 	contractsTerminateCmd.Flags().StringVar(&terminateRehireFlag, "rehire", "", "Eligible for rehire: YES, NO, or DONT_KNOW")
 
 	// Amend command flags
-	contractsAmendCmd.Flags().StringVar(&amendScopeFlag, "scope", "", "New scope of work (required)")
+	contractsAmendCmd.Flags().StringVar(&amendScopeFlag, "scope", "", "New scope of work")
+	contractsAmendCmd.Flags().Float64Var(&amendRateFlag, "rate", 0, "New compensation rate")
+	contractsAmendCmd.Flags().StringVar(&amendTitleFlag, "title", "", "New contract title")
+	contractsAmendCmd.Flags().StringVar(&amendJobTitleFlag, "job-title", "", "New job title")
+	contractsAmendCmd.Flags().StringVar(&amendReasonFlag, "reason", "", "Reason for the amendment")
+	contractsAmendCmd.Flags().StringVar(&amendEffectiveDateFlag, "effective-date", "", "Date the amendment takes effect, YYYY-MM-DD (today or later; default: once signed)")
 
 	// Add all commands
 	contractsCmd.AddCommand(contractsListCmd)
@@ -815,10 +1525,13 @@ func init() {
 	contractsCmd.AddCommand(contractsAmendCmd)
 	contractsCmd.AddCommand(contractsPaymentDatesCmd)
 	contractsCmd.AddCommand(contractsCreateCmd)
+	contractsCmd.AddCommand(contractsUpdateCmd)
 	contractsCmd.AddCommand(contractsSignCmd)
 	contractsCmd.AddCommand(contractsTerminateCmd)
 	contractsCmd.AddCommand(contractsTerminationReasonsCmd)
+	contractsCmd.AddCommand(contractsPaymentCyclesCmd)
 	contractsCmd.AddCommand(contractsPDFCmd)
+	contractsCmd.AddCommand(contractsDownloadPDFCmd)
 	contractsCmd.AddCommand(contractsInviteCmd)
 	contractsCmd.AddCommand(contractsInviteLinkCmd)
 	contractsCmd.AddCommand(contractsTemplatesCmd)
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+)
+
+// Suggested next actions for contracts that are waiting on the client.
+const (
+	contractActionSign    = "sign"
+	contractActionInvite  = "invite"
+	contractActionApprove = "approve"
+)
+
+// contractStatusActions maps contract statuses that need someone to act to the
+// suggested next action. Statuses not listed need no action.
+var contractStatusActions = map[string]string{
+	"new":                            contractActionSign,
+	"draft":                          contractActionSign,
+	"waiting_for_client_sign":        contractActionSign,
+	"waiting_for_contractor_sign":    contractActionInvite,
+	"waiting_for_employee_sign":      contractActionInvite,
+	"waiting_for_worker_sign":        contractActionInvite,
+	"pending_invite":                 contractActionInvite,
+	"pending_amendment":              contractActionApprove,
+	"amendment_pending":              contractActionApprove,
+	"waiting_for_amendment_approval": contractActionApprove,
+}
+
+// contractNextAction returns the suggested next action for c and the command
+// that performs it, or ok=false when c needs no action. Required flags the
+// CLI cannot fill in are given as quoted <placeholders>.
+func contractNextAction(c api.Contract) (action, command string, ok bool) {
+	action, ok = contractStatusActions[strings.ToLower(strings.TrimSpace(c.Status))]
+	if !ok {
+		return "", "", false
+	}
+	switch action {
+	case contractActionSign:
+		command = "deel contracts sign " + c.ID + ` --signer "<name>"`
+	case contractActionInvite:
+		command = "deel contracts invite " + c.ID + ` --email "<worker email>"`
+	case contractActionApprove:
+		command = "deel contracts amendments " + c.ID
+	}
+	return action, command, true
+}
+
+// contractNeedingAction is a contracts list --needs-action row: the contract
+// plus its suggested next action.
+type contractNeedingAction struct {
+	api.Contract
+	NextAction  string
+	NextCommand string
+}
+
+// MarshalJSON renders the contract as usual, keeping its key order, with
+// next_action and next_command appended.
+func (c contractNeedingAction) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(c.Contract)
+	if err != nil {
+		return nil, err
+	}
+	extra, err := json.Marshal(struct {
+		NextAction  string `json:"next_action"`
+		NextCommand string `json:"next_command"`
+	}{c.NextAction, c.NextCommand})
+	if err != nil {
+		return nil, err
+	}
+	// Splice {"next_action":...} into the contract object.
+	out := append(bytes.TrimSuffix(b, []byte("}")), ',')
+	return append(out, extra[1:]...), nil
+}
+
+// contractsNeedingAction keeps the contracts that need action, in order, each
+// annotated with its suggested next action.
+func contractsNeedingAction(contracts []api.Contract) []contractNeedingAction {
+	out := make([]contractNeedingAction, 0, len(contracts))
+	for _, c := range contracts {
+		action, command, ok := contractNextAction(c)
+		if !ok {
+			continue
+		}
+		out = append(out, contractNeedingAction{Contract: c, NextAction: action, NextCommand: command})
+	}
+	return out
+}
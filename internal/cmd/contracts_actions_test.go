@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+)
+
+func TestContractsNeedingAction(t *testing.T) {
+	contracts := []api.Contract{
+		{ID: "c1", Status: "active"},
+		{ID: "c2", Status: "waiting_for_client_sign"},
+		{ID: "c3", Status: "waiting_for_contractor_sign"},
+		{ID: "c4", Status: "completed"},
+		{ID: "c5", Status: "pending_amendment"},
+		{ID: "c6", Status: "Draft"},
+	}
+
+	got := contractsNeedingAction(contracts)
+	require.Len(t, got, 4)
+
+	want := []struct{ id, action, command string }{
+		{"c2", "sign", `deel contracts sign c2 --signer "<name>"`},
+		{"c3", "invite", `deel contracts invite c3 --email "<worker email>"`},
+		{"c5", "approve", "deel contracts amendments c5"},
+		{"c6", "sign", `deel contracts sign c6 --signer "<name>"`},
+	}
+	for i, w := range want {
+		assert.Equal(t, w.id, got[i].ID)
+		assert.Equal(t, w.action, got[i].NextAction, w.id)
+		assert.Equal(t, w.command, got[i].NextCommand, w.id)
+	}
+}
+
+func TestContractsNeedingAction_NoneActionable(t *testing.T) {
+	got := contractsNeedingAction([]api.Contract{{ID: "c1", Status: "active"}, {ID: "c2", Status: ""}})
+	assert.NotNil(t, got)
+	assert.Empty(t, got)
+}
+
+func TestContractNeedingAction_MarshalJSON(t *testing.T) {
+	row := contractsNeedingAction([]api.Contract{{ID: "c2", Title: "Design", Status: "waiting_for_client_sign", CompensationAmount: 1000, Currency: "USD"}})[0]
+
+	b, err := json.Marshal(row)
+	require.NoError(t, err)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, "c2", got["id"])
+	assert.Equal(t, "Design", got["title"])
+	assert.Equal(t, "sign", got["next_action"])
+	assert.Equal(t, `deel contracts sign c2 --signer "<name>"`, got["next_command"])
+	assert.Contains(t, got, "compensation")
+}
+
+// TestContractNextAction_CommandsParse runs every suggested command through
+// the real command tree: it must resolve to a command, pass its argument
+// check, and set the flags that command refuses to run without.
+func TestContractNextAction_CommandsParse(t *testing.T) {
+	requiredFlags := map[string][]string{
+		contractActionSign:    {"signer"},
+		contractActionInvite:  {"email"},
+		contractActionApprove: nil,
+	}
+	seen := map[string]bool{}
+	for status := range contractStatusActions {
+		action, command, ok := contractNextAction(api.Contract{ID: "c-1", Status: status})
+		require.True(t, ok, status)
+		if seen[action] {
+			continue
+		}
+		seen[action] = true
+
+		args := splitSuggestedCommand(t, command)
+		require.Equal(t, "deel", args[0], command)
+		cmd, rest, err := rootCmd.Find(args[1:])
+		require.NoError(t, err, command)
+
+		flags := cmd.Flags()
+		require.NoError(t, flags.Parse(rest), command)
+		assert.NoError(t, cmd.ValidateArgs(flags.Args()), command)
+		for _, name := range requiredFlags[action] {
+			assert.True(t, flags.Changed(name), "%s: missing --%s", command, name)
+		}
+		flags.VisitAll(func(fl *pflag.Flag) {
+			if fl.Changed {
+				_ = fl.Value.Set(fl.DefValue)
+				fl.Changed = false
+			}
+		})
+	}
+	assert.Len(t, seen, len(requiredFlags))
+}
+
+// splitSuggestedCommand splits command on spaces, keeping double-quoted
+// words together, the way a shell would for these simple commands.
+func splitSuggestedCommand(t *testing.T, command string) []string {
+	t.Helper()
+	var args []string
+	for command != "" {
+		command = strings.TrimLeft(command, " ")
+		if strings.HasPrefix(command, `"`) {
+			end := strings.Index(command[1:], `"`)
+			require.GreaterOrEqual(t, end, 0, "unterminated quote in %q", command)
+			args = append(args, command[1:end+1])
+			command = command[end+2:]
+			continue
+		}
+		word, rest, _ := strings.Cut(command, " ")
+		args = append(args, word)
+		command = rest
+	}
+	return args
+}
@@ -0,0 +1,380 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/batch"
+	"github.com/salmonumbrella/deel-cli/internal/dryrun"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+// contractRow is one contract in a --from-file input. JSON keys and CSV
+// headers use the CreateContractParams field names (worker_email, job_title).
+type contractRow struct {
+	Title          string  `json:"title"`
+	Type           string  `json:"type"`
+	WorkerEmail    string  `json:"worker_email"`
+	WorkerFirst    string  `json:"worker_first_name"`
+	WorkerLast     string  `json:"worker_last_name"`
+	Currency       string  `json:"currency"`
+	Rate           float64 `json:"rate"`
+	Country        string  `json:"country"`
+	JobTitle       string  `json:"job_title"`
+	ScopeOfWork    string  `json:"scope_of_work"`
+	StartDate      string  `json:"start_date"`
+	EndDate        string  `json:"end_date"`
+	PaymentCycle   string  `json:"payment_cycle"`
+	SeniorityLevel string  `json:"seniority_level"`
+	TemplateID     string  `json:"template_id"`
+	LegalEntityID  string  `json:"legal_entity_id"`
+	GroupID        string  `json:"group_id"`
+	CycleEnd       int     `json:"cycle_end"`
+	CycleEndType   string  `json:"cycle_end_type"`
+	Frequency      string  `json:"frequency"`
+	SpecialClause  string  `json:"special_clause"`
+	ManagerID      string  `json:"manager_id"`
+}
+
+// maxBulkConcurrency caps --concurrency so a large file cannot flood the API.
+const maxBulkConcurrency = 10
+
+var contractRowKinds = map[string]batch.Kind{
+	"rate":       batch.KindFloat,
+	"cycle_end":  batch.KindInt,
+	"start_date": batch.KindDate,
+	"end_date":   batch.KindDate,
+}
+
+func (r contractRow) params() api.CreateContractParams {
+	return api.CreateContractParams{
+		Title:          r.Title,
+		Type:           r.Type,
+		WorkerEmail:    r.WorkerEmail,
+		WorkerFirst:    r.WorkerFirst,
+		WorkerLast:     r.WorkerLast,
+		Currency:       strings.ToUpper(r.Currency),
+		Rate:           r.Rate,
+		Country:        r.Country,
+		JobTitle:       r.JobTitle,
+		ScopeOfWork:    r.ScopeOfWork,
+		StartDate:      r.StartDate,
+		EndDate:        r.EndDate,
+		PaymentCycle:   r.PaymentCycle,
+		SeniorityLevel: r.SeniorityLevel,
+		TemplateID:     r.TemplateID,
+		LegalEntityID:  r.LegalEntityID,
+		GroupID:        r.GroupID,
+		CycleEnd:       r.CycleEnd,
+		CycleEndType:   r.CycleEndType,
+		Frequency:      r.Frequency,
+		SpecialClause:  r.SpecialClause,
+		ManagerID:      r.ManagerID,
+	}
+}
+
+// validate reports the first missing required field or invalid value, mirroring
+// contracts create.
+func (r contractRow) validate() error {
+	switch {
+	case r.Title == "":
+		return fmt.Errorf("title is required")
+	case r.Type == "":
+		return fmt.Errorf("type is required")
+	case r.WorkerEmail == "":
+		return fmt.Errorf("worker_email is required")
+	case r.Country == "":
+		return fmt.Errorf("country is required")
+	case r.Currency == "":
+		return fmt.Errorf("currency is required")
+	}
+	if err := validateContractEnum("type", r.Type, contractTypes, contractTypeAliases); err != nil {
+		return err
+	}
+	if r.PaymentCycle != "" {
+		if err := validateContractEnum("payment_cycle", r.PaymentCycle, contractPaymentCycles, nil); err != nil {
+			return err
+		}
+	}
+	return validateCurrency(strings.ToUpper(r.Currency))
+}
+
+// bulkContractInput is a parsed row plus any error decoding it. Line is
+// where the row is in the file: its CSV line number, counting the header, or
+// its 1-based position in a JSON file.
+type bulkContractInput struct {
+	Row  contractRow
+	Line int
+	Err  error
+}
+
+// bulkContractResult is the per-row outcome of contracts create --from-file.
+// Row is the input's Line, so it can be found in the file as reported.
+type bulkContractResult struct {
+	Row         int    `json:"row"`
+	Title       string `json:"title"`
+	WorkerEmail string `json:"worker_email"`
+	OK          bool   `json:"ok"`
+	Status      string `json:"status"` // created, failed, or would_create (dry run)
+	ContractID  string `json:"contract_id,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// readContractRows loads rows from a CSV (by .csv extension) or a JSON
+// array/NDJSON file. Structural problems fail the whole file; a row with bad
+// values is returned with Err set so the remaining rows still run.
+func readContractRows(path string) ([]bulkContractInput, error) {
+	var raws []json.RawMessage
+	var rowErrs []error
+	var lines []int
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		rows, err := batch.ReadCSV(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			lines = append(lines, row.Line)
+			obj, err := row.Coerce(contractRowKinds)
+			if err != nil {
+				raws = append(raws, nil)
+				rowErrs = append(rowErrs, err)
+				continue
+			}
+			raw, err := json.Marshal(obj)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", row.Line, err)
+			}
+			raws = append(raws, raw)
+			rowErrs = append(rowErrs, nil)
+		}
+	} else {
+		items, err := batch.ReadItems(path)
+		if err != nil {
+			return nil, err
+		}
+		for i, item := range items {
+			lines = append(lines, i+1)
+			raws = append(raws, item.Raw())
+			rowErrs = append(rowErrs, nil)
+		}
+	}
+	if len(raws) == 0 {
+		return nil, fmt.Errorf("%s contains no rows", path)
+	}
+
+	inputs := make([]bulkContractInput, len(raws))
+	for i, raw := range raws {
+		inputs[i].Line = lines[i]
+		if rowErrs[i] != nil {
+			inputs[i].Err = rowErrs[i]
+			continue
+		}
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&inputs[i].Row); err != nil {
+			inputs[i].Err = fmt.Errorf("invalid row: %w", err)
+		}
+	}
+	return inputs, nil
+}
+
+// checkRowCurrencies runs checkCurrency on every decoded row, so a row with
+// a currency Deel does not support fails, in a dry run too, before anything
+// is created. The supported list is fetched once; if that fails a single
+// warning is printed and the rows' codes are only format-checked.
+func checkRowCurrencies(ctx context.Context, f *outfmt.Formatter, newClient func() (*api.Client, error), inputs []bulkContractInput, skipLookup bool) {
+	if !skipLookup {
+		if _, ok := supportedCurrencies(ctx, f, newClient, "currencies"); !ok {
+			skipLookup = true
+		}
+	}
+	for i := range inputs {
+		in := &inputs[i]
+		if in.Err != nil || in.Row.Currency == "" {
+			continue
+		}
+		if err := checkCurrency(ctx, f, newClient, strings.ToUpper(in.Row.Currency), skipLookup); err != nil {
+			in.Err = err
+		}
+	}
+}
+
+// createContractsFromRows validates every row, then creates the valid ones
+// using up to concurrency workers that share client (and so its circuit
+// breaker). Results are in input order and failures never stop other rows.
+// In dry run no API calls are made.
+func createContractsFromRows(ctx context.Context, client *api.Client, inputs []bulkContractInput, dryRun bool, concurrency int) ([]bulkContractResult, batch.Summary) {
+	results := make([]bulkContractResult, len(inputs))
+	var pending []int
+	for i, in := range inputs {
+		results[i] = bulkContractResult{
+			Row:         in.Line,
+			Title:       in.Row.Title,
+			WorkerEmail: in.Row.WorkerEmail,
+		}
+		err := in.Err
+		if err == nil {
+			err = in.Row.validate()
+		}
+		switch {
+		case err != nil:
+			results[i].Status = "failed"
+			results[i].Error = err.Error()
+		case dryRun:
+			results[i].Status = "would_create"
+		default:
+			pending = append(pending, i)
+		}
+	}
+
+	created := batch.Run(ctx, len(pending), concurrency, func(ctx context.Context, j int) (any, error) {
+		// Each row is a distinct write, so it gets its own idempotency key.
+		// The part is the row's position, as earlier releases sent it, so
+		// a file edited between runs must not reuse the same base key.
+		ctx = api.WithIdempotencyPart(ctx, fmt.Sprintf("row-%d", pending[j]+1))
+		return client.CreateContract(ctx, inputs[pending[j]].Row.params())
+	})
+	for j, r := range created {
+		res := &results[pending[j]]
+		if r.Error != nil {
+			res.Status = "failed"
+			res.Error = r.Error.Error()
+			continue
+		}
+		res.Status = "created"
+		res.ContractID = r.Data.(*api.Contract).ID
+	}
+
+	summary := batch.Summary{Total: len(results)}
+	for i := range results {
+		results[i].OK = results[i].Status != "failed"
+		if results[i].OK {
+			summary.Succeeded++
+		} else {
+			summary.Failed++
+		}
+	}
+	return results, summary
+}
+
+// runBulkContractCreate implements contracts create --from-file.
+func runBulkContractCreate(cmd *cobra.Command, f *outfmt.Formatter, path string, concurrency int) error {
+	var mixed []string
+	cmd.LocalFlags().Visit(func(fl *pflag.Flag) {
+		if fl.Name != "from-file" && fl.Name != "concurrency" && fl.Name != "skip-currency-check" {
+			mixed = append(mixed, "--"+fl.Name)
+		}
+	})
+	if len(mixed) > 0 {
+		return failValidation(cmd, f, fmt.Sprintf("--from-file cannot be combined with %s; put those values in the file", strings.Join(mixed, ", ")))
+	}
+
+	if concurrency < 1 || concurrency > maxBulkConcurrency {
+		return failValidation(cmd, f, fmt.Sprintf("--concurrency must be between 1 and %d", maxBulkConcurrency))
+	}
+
+	inputs, err := readContractRows(path)
+	if err != nil {
+		return failValidation(cmd, f, err.Error())
+	}
+
+	checkRowCurrencies(cmd.Context(), f, getClient, inputs, contractSkipCurrencyFlag)
+
+	dryRun := dryrun.IsEnabled(cmd.Context())
+	var client *api.Client
+	if !dryRun {
+		client, err = getClient()
+		if err != nil {
+			return HandleError(f, err, "initializing client")
+		}
+	}
+
+	results, summary := createContractsFromRows(cmd.Context(), client, inputs, dryRun, concurrency)
+
+	var failure error
+	if summary.Failed > 0 {
+		failure = fmt.Errorf("%d of %d contracts failed", summary.Failed, summary.Total)
+	}
+	return outputBatchResults(cmd.Context(), f, func() {
+		if dryRun {
+			f.PrintText("[DRY-RUN] No contracts were created.")
+		}
+		table := f.NewTable("ROW", "TITLE", "WORKER", "STATUS", "CONTRACT / ERROR")
+		for _, r := range results {
+			detail := r.ContractID
+			if r.Error != "" {
+				detail = r.Error
+			}
+			table.AddRow(fmt.Sprintf("%d", r.Row), r.Title, r.WorkerEmail, r.Status, detail)
+		}
+		table.Render()
+		f.PrintText("")
+		f.PrintText(fmt.Sprintf("%d rows: %d ok, %d failed", summary.Total, summary.Succeeded, summary.Failed))
+	}, map[string]any{
+		"dry_run": dryRun,
+		"summary": batchSummaryJSON(summary),
+		"results": results,
+	}, failure)
+}
+
+// bulkSignResult is the per-contract outcome of contracts sign with several IDs.
+type bulkSignResult struct {
+	ContractID string `json:"contract_id"`
+	OK         bool   `json:"ok"`
+	Status     string `json:"status,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// signContracts signs each contract as signer using up to concurrency
+// workers. Results are in input order and a failure never stops the others.
+func signContracts(ctx context.Context, client *api.Client, ids []string, signer string, concurrency int) ([]bulkSignResult, batch.Summary) {
+	results := make([]bulkSignResult, len(ids))
+	runs := batch.Run(ctx, len(ids), concurrency, func(ctx context.Context, i int) (any, error) {
+		return client.SignContract(api.WithIdempotencyPart(ctx, "sign-"+ids[i]), ids[i], signer)
+	})
+
+	summary := batch.Summary{Total: len(ids)}
+	for _, r := range runs {
+		res := &results[r.Index]
+		res.ContractID = ids[r.Index]
+		if r.Error != nil {
+			res.Error = r.Error.Error()
+			summary.Failed++
+			continue
+		}
+		res.OK = true
+		res.Status = r.Data.(*api.Contract).Status
+		summary.Succeeded++
+	}
+	return results, summary
+}
+
+// runBulkContractSign implements contracts sign with more than one ID.
+func runBulkContractSign(ctx context.Context, f *outfmt.Formatter, client *api.Client, ids []string, signer string, concurrency int) error {
+	results, summary := signContracts(ctx, client, ids, signer, concurrency)
+
+	var failure error
+	if summary.Failed > 0 {
+		failure = fmt.Errorf("%d of %d contracts could not be signed", summary.Failed, summary.Total)
+	}
+	return outputBatchResults(ctx, f, func() {
+		table := f.NewTable("CONTRACT", "STATUS", "ERROR")
+		for _, r := range results {
+			table.AddRow(r.ContractID, r.Status, r.Error)
+		}
+		table.Render()
+		f.PrintText("")
+		f.PrintText(fmt.Sprintf("%d contracts: %d signed, %d failed", summary.Total, summary.Succeeded, summary.Failed))
+	}, map[string]any{
+		"summary": batchSummaryJSON(summary),
+		"results": results,
+	}, failure)
+}
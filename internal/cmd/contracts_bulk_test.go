@@ -0,0 +1,307 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+func writeContractsFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestReadContractRows_CSV(t *testing.T) {
+	path := writeContractsFile(t, "workers.csv", `title,type,worker_email,country,currency,rate,cycle_end,start_date
+Dev,payg_tasks,a@example.com,US,usd,95.5,5,2026-01-01
+QA,payg_tasks,b@example.com,DE,EUR,abc,,
+`)
+
+	inputs, err := readContractRows(path)
+	require.NoError(t, err)
+	require.Len(t, inputs, 2)
+	assert.Equal(t, 2, inputs[0].Line, "line 1 is the header")
+	assert.Equal(t, 3, inputs[1].Line)
+
+	require.NoError(t, inputs[0].Err)
+	row := inputs[0].Row
+	assert.Equal(t, "Dev", row.Title)
+	assert.Equal(t, "a@example.com", row.WorkerEmail)
+	assert.Equal(t, 95.5, row.Rate)
+	assert.Equal(t, 5, row.CycleEnd)
+	assert.Equal(t, "2026-01-01", row.StartDate)
+	assert.Equal(t, "USD", row.params().Currency)
+
+	assert.ErrorContains(t, inputs[1].Err, "rate")
+}
+
+func TestReadContractRows_JSON(t *testing.T) {
+	path := writeContractsFile(t, "workers.json", `[
+  {"title":"Dev","type":"payg_tasks","worker_email":"a@example.com","country":"US","currency":"USD","template_id":"tpl-1"},
+  {"title":"QA","bogus":true}
+]`)
+
+	inputs, err := readContractRows(path)
+	require.NoError(t, err)
+	require.Len(t, inputs, 2)
+	assert.Equal(t, 1, inputs[0].Line)
+	assert.Equal(t, 2, inputs[1].Line)
+	require.NoError(t, inputs[0].Err)
+	assert.Equal(t, "tpl-1", inputs[0].Row.params().TemplateID)
+	assert.ErrorContains(t, inputs[1].Err, `unknown field "bogus"`)
+}
+
+func TestReadContractRows_Empty(t *testing.T) {
+	_, err := readContractRows(writeContractsFile(t, "empty.json", `[]`))
+	assert.ErrorContains(t, err, "contains no rows")
+}
+
+func TestCreateContractsFromRows_ContinuesAfterFailure(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+
+	var titles []string
+	server.Handle("POST", "/rest/v2/contracts", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data struct {
+				Title string `json:"title"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		titles = append(titles, body.Data.Title)
+		if body.Data.Title == "Broken" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":[{"message":"invalid worker"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"c-` + body.Data.Title + `"}}`))
+	})
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	client.SetRetryConfig(0, 0, 0)
+
+	valid := func(title string) bulkContractInput {
+		return bulkContractInput{Row: contractRow{Title: title, Type: "payg_tasks", WorkerEmail: "w@example.com", Country: "US", Currency: "USD"}}
+	}
+	inputs := []bulkContractInput{
+		valid("One"),
+		valid("Broken"),
+		{Row: contractRow{Title: "Missing"}},
+		valid("Two"),
+	}
+	for i := range inputs {
+		inputs[i].Line = i + 2
+	}
+
+	results, summary := createContractsFromRows(context.Background(), client, inputs, false, 1)
+	assert.Equal(t, []string{"One", "Broken", "Two"}, titles)
+	assert.Equal(t, 4, summary.Total)
+	assert.Equal(t, 2, summary.Succeeded)
+	assert.Equal(t, 2, summary.Failed)
+
+	assert.Equal(t, "created", results[0].Status)
+	assert.Equal(t, "c-One", results[0].ContractID)
+	assert.Equal(t, "failed", results[1].Status)
+	assert.NotEmpty(t, results[1].Error)
+	assert.Equal(t, "failed", results[2].Status)
+	assert.Equal(t, "type is required", results[2].Error)
+	assert.Equal(t, "created", results[3].Status)
+	assert.Equal(t, 5, results[3].Row)
+}
+
+func TestCreateContractsFromRows_DryRunMakesNoCalls(t *testing.T) {
+	inputs := []bulkContractInput{
+		{Row: contractRow{Title: "Dev", Type: "payg_tasks", WorkerEmail: "w@example.com", Country: "US", Currency: "USD"}},
+		{Row: contractRow{Title: "Bad", Type: "payg_tasks", WorkerEmail: "w@example.com", Country: "US", Currency: "DOLLARS"}},
+	}
+
+	// A nil client would panic if any API call were attempted.
+	results, summary := createContractsFromRows(context.Background(), nil, inputs, true, 4)
+	assert.Equal(t, "would_create", results[0].Status)
+	assert.Equal(t, "failed", results[1].Status)
+	assert.Equal(t, 1, summary.Failed)
+}
+
+func TestCheckRowCurrencies_FailsUnsupportedRows(t *testing.T) {
+	calls := 0
+	newClient, f, _ := currencyCheckSetup(t, currencyListHandler(&calls))
+
+	row := func(currency string) bulkContractInput {
+		return bulkContractInput{Row: contractRow{Title: "Dev", Type: "payg_tasks", WorkerEmail: "w@example.com", Country: "US", Currency: currency}}
+	}
+	inputs := []bulkContractInput{row("usd"), row("XYZ"), row("EUR")}
+	checkRowCurrencies(context.Background(), f, newClient, inputs, false)
+	assert.Equal(t, 1, calls, "the currency list is fetched once")
+
+	results, summary := createContractsFromRows(context.Background(), nil, inputs, true, 1)
+	assert.Equal(t, "would_create", results[0].Status)
+	assert.Equal(t, "failed", results[1].Status)
+	assert.Contains(t, results[1].Error, `unknown currency code "XYZ"`)
+	assert.Equal(t, "would_create", results[2].Status)
+	assert.Equal(t, 1, summary.Failed)
+}
+
+func TestCheckRowCurrencies_LookupFailureWarnsOnce(t *testing.T) {
+	newClient, f, errOut := currencyCheckSetup(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	inputs := []bulkContractInput{
+		{Row: contractRow{Currency: "XYZ"}},
+		{Row: contractRow{Currency: "ABC"}},
+	}
+	checkRowCurrencies(context.Background(), f, newClient, inputs, false)
+	assert.NoError(t, inputs[0].Err)
+	assert.NoError(t, inputs[1].Err)
+	assert.Equal(t, 1, bytes.Count(errOut.Bytes(), []byte("Could not verify currencies")))
+}
+
+func TestCreateContractsFromRows_ReportsCSVLines(t *testing.T) {
+	path := writeContractsFile(t, "workers.csv", `title,type,worker_email,country,currency
+Dev,payg_tasks,a@example.com,US,USD
+QA,payg_tasks,,DE,EUR
+`)
+	inputs, err := readContractRows(path)
+	require.NoError(t, err)
+
+	results, _ := createContractsFromRows(context.Background(), nil, inputs, true, 1)
+	assert.Equal(t, 2, results[0].Row)
+	assert.Equal(t, 3, results[1].Row)
+	assert.Equal(t, "worker_email is required", results[1].Error)
+}
+
+func TestCreateContractsFromRows_ConcurrentKeepsInputOrder(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+
+	server.Handle("POST", "/rest/v2/contracts", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data struct {
+				Title string `json:"title"`
+			} `json:"data"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"c-` + body.Data.Title + `"}}`))
+	})
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	client.SetRetryConfig(0, 0, 0)
+
+	var inputs []bulkContractInput
+	for _, title := range []string{"a", "b", "c", "d", "e", "f"} {
+		inputs = append(inputs, bulkContractInput{Line: len(inputs) + 1, Row: contractRow{Title: title, Type: "payg_tasks", WorkerEmail: "w@example.com", Country: "US", Currency: "USD"}})
+	}
+
+	results, summary := createContractsFromRows(context.Background(), client, inputs, false, 3)
+	assert.Equal(t, 6, summary.Succeeded)
+	for i, r := range results {
+		assert.Equal(t, i+1, r.Row)
+		assert.Equal(t, "c-"+inputs[i].Row.Title, r.ContractID)
+	}
+}
+
+func TestCreateContractsFromRows_EachRowGetsItsOwnIdempotencyKey(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+
+	var mu sync.Mutex
+	keys := map[string]string{}
+	server.Handle("POST", "/rest/v2/contracts", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Data struct {
+				Title string `json:"title"`
+			} `json:"data"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		keys[body.Data.Title] = r.Header.Get("Idempotency-Key")
+		mu.Unlock()
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"c-` + body.Data.Title + `"}}`))
+	})
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	client.SetIdempotencyKey("base-key")
+
+	var inputs []bulkContractInput
+	for _, title := range []string{"a", "b", "c"} {
+		inputs = append(inputs, bulkContractInput{Row: contractRow{Title: title, Type: "payg_tasks", WorkerEmail: "w@example.com", Country: "US", Currency: "USD"}})
+	}
+
+	_, summary := createContractsFromRows(context.Background(), client, inputs, false, 3)
+	assert.Equal(t, 3, summary.Succeeded)
+	assert.Equal(t, map[string]string{
+		"a": api.DerivedIdempotencyKey("base-key", "row-1"),
+		"b": api.DerivedIdempotencyKey("base-key", "row-2"),
+		"c": api.DerivedIdempotencyKey("base-key", "row-3"),
+	}, keys)
+	assert.Len(t, client.IdempotencyKeysSent(), 3)
+}
+
+func TestRunBulkContractSign_PartialFailureEmitsAllResults(t *testing.T) {
+	resetAgentErrorEmitted()
+	defer resetAgentErrorEmitted()
+
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.HandleJSON("POST", "/rest/v2/contracts/c-1/signatures", http.StatusOK, map[string]any{"data": map[string]any{"id": "c-1", "status": "waiting_for_contractor_sign"}})
+	server.HandleError("POST", "/rest/v2/contracts/c-2/signatures", http.StatusNotFound, "contract not found")
+	server.HandleJSON("POST", "/rest/v2/contracts/c-3/signatures", http.StatusOK, map[string]any{"data": map[string]any{"id": "c-3", "status": "waiting_for_contractor_sign"}})
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	client.SetRetryConfig(0, 0, 0)
+
+	var out, errOut bytes.Buffer
+	f := outfmt.New(&out, &errOut, outfmt.FormatJSON, "never")
+	f.SetAgentMode(true)
+	ctx := outfmt.WithAgent(context.Background(), true)
+
+	err := runBulkContractSign(ctx, f, client, []string{"c-1", "c-2", "c-3"}, "Ada Lovelace", 2)
+	require.Error(t, err)
+	assert.NotEqual(t, 0, ExitCode(err))
+	assert.True(t, AgentErrorEmitted(), "main must not print a second error object")
+
+	// stdout holds exactly one JSON document: the full per-item results.
+	dec := json.NewDecoder(&out)
+	var payload struct {
+		OK     bool `json:"ok"`
+		Result struct {
+			Data struct {
+				Summary map[string]int   `json:"summary"`
+				Results []bulkSignResult `json:"results"`
+			} `json:"data"`
+		} `json:"result"`
+	}
+	require.NoError(t, dec.Decode(&payload))
+	assert.False(t, dec.More())
+
+	assert.False(t, payload.OK)
+	assert.Equal(t, map[string]int{"total": 3, "succeeded": 2, "failed": 1}, payload.Result.Data.Summary)
+	results := payload.Result.Data.Results
+	require.Len(t, results, 3)
+	assert.Equal(t, bulkSignResult{ContractID: "c-1", OK: true, Status: "waiting_for_contractor_sign"}, results[0])
+	assert.Equal(t, "c-2", results[1].ContractID)
+	assert.False(t, results[1].OK)
+	assert.NotEmpty(t, results[1].Error)
+	assert.True(t, results[2].OK)
+}
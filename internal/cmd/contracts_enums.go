@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+// contractEnumValue is one accepted value of an enumerated contract flag.
+type contractEnumValue struct {
+	Value       string `json:"value"`
+	Description string `json:"description"`
+}
+
+var contractPaymentCycles = []contractEnumValue{
+	{Value: "weekly", Description: "Paid every week"},
+	{Value: "bi_weekly", Description: "Paid every two weeks"},
+	{Value: "monthly", Description: "Paid once a month"},
+}
+
+var contractTypes = []contractEnumValue{
+	{Value: "ongoing_time_based", Description: "Fixed rate, paid each cycle"},
+	{Value: "pay_as_you_go_time_based", Description: "Pay as you go, billed by time worked"},
+	{Value: "payg_milestones", Description: "Paid per approved milestone"},
+	{Value: "payg_tasks", Description: "Paid per submitted task"},
+}
+
+// contractTypeAliases maps the names used in the Deel UI to API contract types
+// so near-miss suggestions can point at the right value.
+var contractTypeAliases = map[string]string{
+	"fixed":         "ongoing_time_based",
+	"fixed_rate":    "ongoing_time_based",
+	"ongoing":       "ongoing_time_based",
+	"pay_as_you_go": "pay_as_you_go_time_based",
+	"payg":          "pay_as_you_go_time_based",
+	"time_based":    "pay_as_you_go_time_based",
+	"milestone":     "payg_milestones",
+	"milestones":    "payg_milestones",
+	"task":          "payg_tasks",
+	"task_based":    "payg_tasks",
+	"tasks":         "payg_tasks",
+}
+
+var contractsPaymentCyclesCmd = &cobra.Command{
+	Use:   "payment-cycles",
+	Short: "List valid --payment-cycle and --type values",
+	Long:  "List the payment cycles and contract types accepted by 'contracts create' and 'contracts update'.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return outputContractEnums(cmd, getFormatter())
+	},
+}
+
+// outputContractEnums prints the accepted payment cycles and contract types.
+func outputContractEnums(cmd *cobra.Command, f *outfmt.Formatter) error {
+	data := map[string][]contractEnumValue{
+		"payment_cycles": contractPaymentCycles,
+		"contract_types": contractTypes,
+	}
+	return f.OutputFiltered(cmd.Context(), func() {
+		f.PrintText("Payment cycles (--payment-cycle):")
+		for _, v := range contractPaymentCycles {
+			f.PrintText("  • " + v.Value + " - " + v.Description)
+		}
+		f.PrintText("\nContract types (--type):")
+		for _, v := range contractTypes {
+			f.PrintText("  • " + v.Value + " - " + v.Description)
+		}
+	}, data)
+}
+
+// validateContractEnum checks value against allowed. The error names the
+// closest valid value when one is near enough to be a likely typo.
+func validateContractEnum(flag, value string, allowed []contractEnumValue, aliases map[string]string) error {
+	values := make([]string, len(allowed))
+	for i, v := range allowed {
+		if v.Value == value {
+			return nil
+		}
+		values[i] = v.Value
+	}
+	msg := fmt.Sprintf("invalid %s %q", flag, value)
+	if s := suggestEnumValue(value, values, aliases); s != "" {
+		msg += fmt.Sprintf(" (did you mean %q?)", s)
+	}
+	return fmt.Errorf("%s; expected one of %s", msg, strings.Join(values, ", "))
+}
+
+// suggestEnumValue returns the allowed value closest to value, or "" when
+// nothing is close. Case, hyphens, and spaces are ignored, known aliases win,
+// and otherwise the nearest value within a small edit distance is used.
+func suggestEnumValue(value string, allowed []string, aliases map[string]string) string {
+	norm := normalizeEnumValue(value)
+	if norm == "" {
+		return ""
+	}
+	for _, a := range allowed {
+		if normalizeEnumValue(a) == norm {
+			return a
+		}
+	}
+	for alias, target := range aliases {
+		if normalizeEnumValue(alias) == norm {
+			return target
+		}
+	}
+
+	best, bestDist := "", 3
+	for _, a := range allowed {
+		if d := editDistance(norm, normalizeEnumValue(a)); d < bestDist {
+			best, bestDist = a, d
+		}
+	}
+	return best
+}
+
+// normalizeEnumValue lowercases s and drops separators so "Bi-Weekly",
+// "biweekly", and "bi_weekly" compare equal.
+func normalizeEnumValue(s string) string {
+	return strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(s)))
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+func TestValidateContractEnum_PaymentCycle(t *testing.T) {
+	assert.NoError(t, validateContractEnum("--payment-cycle", "bi_weekly", contractPaymentCycles, nil))
+
+	err := validateContractEnum("--payment-cycle", "biweekly", contractPaymentCycles, nil)
+	require.Error(t, err)
+	assert.Equal(t, `invalid --payment-cycle "biweekly" (did you mean "bi_weekly"?); expected one of weekly, bi_weekly, monthly`, err.Error())
+
+	err = validateContractEnum("--payment-cycle", "montly", contractPaymentCycles, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `did you mean "monthly"?`)
+
+	err = validateContractEnum("--payment-cycle", "quarterly", contractPaymentCycles, nil)
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "did you mean")
+}
+
+func TestValidateContractEnum_Type(t *testing.T) {
+	assert.NoError(t, validateContractEnum("--type", "payg_tasks", contractTypes, contractTypeAliases))
+
+	cases := map[string]string{
+		"fixed_rate":    "ongoing_time_based",
+		"pay-as-you-go": "pay_as_you_go_time_based",
+		"milestone":     "payg_milestones",
+		"task_based":    "payg_tasks",
+		"payg_task":     "payg_tasks",
+	}
+	for input, want := range cases {
+		err := validateContractEnum("--type", input, contractTypes, contractTypeAliases)
+		require.Error(t, err, input)
+		assert.Contains(t, err.Error(), `did you mean "`+want+`"?`, input)
+	}
+}
+
+func TestOutputContractEnums_JSONShape(t *testing.T) {
+	var out bytes.Buffer
+	f := outfmt.New(&out, &out, outfmt.FormatJSON, "never")
+	f.SetRaw(true)
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+
+	require.NoError(t, outputContractEnums(cmd, f))
+	assert.JSONEq(t, `{
+		"payment_cycles": [
+			{"value":"weekly","description":"Paid every week"},
+			{"value":"bi_weekly","description":"Paid every two weeks"},
+			{"value":"monthly","description":"Paid once a month"}
+		],
+		"contract_types": [
+			{"value":"ongoing_time_based","description":"Fixed rate, paid each cycle"},
+			{"value":"pay_as_you_go_time_based","description":"Pay as you go, billed by time worked"},
+			{"value":"payg_milestones","description":"Paid per approved milestone"},
+			{"value":"payg_tasks","description":"Paid per submitted task"}
+		]
+	}`, out.String())
+}
+
+func TestOutputContractEnums_Text(t *testing.T) {
+	var out bytes.Buffer
+	f := outfmt.New(&out, &out, outfmt.FormatText, "never")
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+
+	require.NoError(t, outputContractEnums(cmd, f))
+	assert.Contains(t, out.String(), "Payment cycles (--payment-cycle):")
+	assert.Contains(t, out.String(), "  • bi_weekly - Paid every two weeks")
+	assert.Contains(t, out.String(), "  • payg_tasks - Paid per submitted task")
+}
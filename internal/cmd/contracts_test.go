@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+func TestFilterContractsByWorker(t *testing.T) {
+	contracts := []api.Contract{
+		{ID: "c1", WorkerEmail: "Jane@Example.com", Country: "US"},
+		{ID: "c2", Worker: api.ContractWorker{Email: "jane@example.com"}, Country: "DE"},
+		{ID: "c3", WorkerEmail: "bob@example.com", Country: "us"},
+	}
+	ids := func(cs []api.Contract) []string {
+		out := make([]string, 0, len(cs))
+		for _, c := range cs {
+			out = append(out, c.ID)
+		}
+		return out
+	}
+
+	assert.Equal(t, []string{"c1", "c2", "c3"}, ids(filterContractsByWorker(contracts, "", "")))
+	assert.Equal(t, []string{"c1", "c2"}, ids(filterContractsByWorker(contracts, "jane@example.com", "")))
+	assert.Equal(t, []string{"c1", "c3"}, ids(filterContractsByWorker(contracts, "", "US")))
+	assert.Equal(t, []string{"c1"}, ids(filterContractsByWorker(contracts, "JANE@example.com", "us")))
+	assert.Empty(t, filterContractsByWorker(contracts, "nobody@example.com", ""))
+}
+
+func statusSummaryContracts() []api.Contract {
+	return []api.Contract{
+		{ID: "c1", Status: "active", Type: "ongoing_time_based"},
+		{ID: "c2", Status: "active", Type: "ongoing_time_based"},
+		{ID: "c3", Status: "active", Type: "payg_tasks"},
+		{ID: "c4", Status: "terminated", Type: "payg_tasks"},
+		{ID: "c5", Status: "waiting_for_client_sign", Type: "ongoing_time_based"},
+		{ID: "c6", Type: "payg_milestones"},
+	}
+}
+
+func TestSummarizeContractStatuses(t *testing.T) {
+	summary := summarizeContractStatuses(statusSummaryContracts(), false)
+	assert.Equal(t, 6, summary.Total)
+	assert.Equal(t, map[string]int{
+		"active":                  3,
+		"terminated":              1,
+		"waiting_for_client_sign": 1,
+		"unknown":                 1,
+	}, summary.ByStatus)
+	assert.Nil(t, summary.ByStatusAndType)
+
+	byType := summarizeContractStatuses(statusSummaryContracts(), true)
+	assert.Equal(t, map[string]int{"ongoing_time_based": 2, "payg_tasks": 1}, byType.ByStatusAndType["active"])
+	assert.Equal(t, map[string]int{"payg_milestones": 1}, byType.ByStatusAndType["unknown"])
+}
+
+func TestOutputContractStatusSummary_JSONShape(t *testing.T) {
+	var out bytes.Buffer
+	f := outfmt.New(&out, &out, outfmt.FormatJSON, "never")
+	f.SetRaw(true)
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+
+	summary := summarizeContractStatuses(statusSummaryContracts()[:4], false)
+	require.NoError(t, outputContractStatusSummary(cmd, f, summary))
+	assert.JSONEq(t, `{"total":4,"byStatus":{"active":3,"terminated":1}}`, out.String())
+}
+
+func TestDownloadToFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "c1.pdf")
+
+	n, err := downloadToFile(path, func(w io.Writer) (int64, error) {
+		k, err := w.Write([]byte("%PDF-1.7"))
+		return int64(k), err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), n)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF-1.7", string(data))
+
+	// A failed download leaves neither the target nor a partial file.
+	failed := filepath.Join(dir, "c2.pdf")
+	_, err = downloadToFile(failed, func(w io.Writer) (int64, error) {
+		_, _ = w.Write([]byte("%PDF"))
+		return 4, errors.New("connection reset")
+	})
+	require.Error(t, err)
+	assert.NoFileExists(t, failed)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestCompareContractToTemplate_ReportsOverriddenRate(t *testing.T) {
+	contract := &api.Contract{ID: "c1", Type: "ongoing_time_based", Currency: "USD", CompensationAmount: 95, Worker: api.ContractWorker{Country: "US"}}
+	template := map[string]any{
+		"id":                  "tpl1",
+		"name":                "Standard Contractor",
+		"type":                "ongoing_time_based",
+		"currency":            "USD",
+		"compensation_amount": 100,
+		"worker":              map[string]any{"country": "US"},
+		"notice_period":       30,
+	}
+
+	comparison, err := compareContractToTemplate(contract, "tpl1", template)
+	require.NoError(t, err)
+	assert.False(t, comparison.Matches)
+	require.Len(t, comparison.Differences, 1)
+	d := comparison.Differences[0]
+	assert.Equal(t, "replace", d.Op)
+	assert.Equal(t, "/compensation_amount", d.Path)
+	assert.Equal(t, "95", formatDiffValue(d.Value))
+	assert.Equal(t, "100", formatDiffValue(d.Expected))
+	assert.Equal(t, []string{"/notice_period"}, comparison.Unchecked)
+}
+
+func TestCompareContractToTemplate_Matches(t *testing.T) {
+	contract := &api.Contract{ID: "c1", Type: "pay_as_you_go", Currency: "EUR", CompensationAmount: 100}
+	template := map[string]any{"id": "tpl1", "type": "pay_as_you_go", "currency": "EUR", "compensation_amount": "100.00"}
+
+	comparison, err := compareContractToTemplate(contract, "tpl1", template)
+	require.NoError(t, err)
+	assert.True(t, comparison.Matches)
+	assert.Empty(t, comparison.Differences)
+
+	var out bytes.Buffer
+	f := outfmt.New(&out, &out, outfmt.FormatText, "never")
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+	require.NoError(t, outputTemplateComparison(cmd, f, comparison))
+	assert.Contains(t, out.String(), "Contract c1 matches template tpl1")
+}
+
+func TestContractAmendmentDetails(t *testing.T) {
+	details := contractAmendmentDetails("c1", api.CreateContractAmendmentParams{
+		Amount:         95.5,
+		JobTitleName:   "Senior Engineer",
+		PaymentDueType: "REGULAR",
+		Reason:         "Annual review",
+	})
+	assert.Equal(t, map[string]string{
+		"ContractID": "c1",
+		"Rate":       "95.5",
+		"JobTitle":   "Senior Engineer",
+		"Reason":     "Annual review",
+	}, details)
+}
+
+func TestContractsAmend_RequiresAChange(t *testing.T) {
+	amendReasonFlag = "Annual review"
+	t.Cleanup(func() { amendReasonFlag = "" })
+
+	cmd := &cobra.Command{}
+	cmd.Flags().AddFlagSet(contractsAmendCmd.Flags())
+	cmd.SetContext(context.Background())
+	err := contractsAmendCmd.RunE(cmd, []string{"c1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nothing to amend")
+}
+
+func TestContractIdempotencyKey_Scope(t *testing.T) {
+	var out, errOut bytes.Buffer
+	f := outfmt.New(&out, &errOut, outfmt.FormatText, "never")
+	params := api.CreateContractParams{Title: "Test", Type: "payg_tasks", WorkerEmail: "a@b.co", Country: "US"}
+
+	none, err := contractIdempotencyKey(f, "", params)
+	require.NoError(t, err)
+	assert.Empty(t, none, "no scope, no derived key")
+
+	a, err := contractIdempotencyKey(f, "build-1", params)
+	require.NoError(t, err)
+	again, err := contractIdempotencyKey(f, "build-1", params)
+	require.NoError(t, err)
+	other, err := contractIdempotencyKey(f, "build-2", params)
+	require.NoError(t, err)
+	assert.Len(t, a, 36)
+	assert.Equal(t, a, again, "same scope and params")
+	assert.NotEqual(t, a, other, "different scopes")
+}
+
+func TestContractIdempotencyKey_ExplicitKeyWins(t *testing.T) {
+	idempotencyKeyFlag = "explicit"
+	t.Cleanup(func() { idempotencyKeyFlag = "" })
+
+	var out, errOut bytes.Buffer
+	f := outfmt.New(&out, &errOut, outfmt.FormatText, "never")
+	key, err := contractIdempotencyKey(f, "build-1", api.CreateContractParams{Title: "Test"})
+	require.NoError(t, err)
+	assert.Empty(t, key)
+	assert.Contains(t, errOut.String(), "ignoring --idempotency-scope")
+}
+
+func TestUseScopedIdempotencyKey_ThenStepsDoNotReuseCreateKey(t *testing.T) {
+	origClient := lastClient
+	t.Cleanup(func() { autoIdempotencyKey, lastClient = "", origClient })
+
+	server := testutil.NewMockServer()
+	defer server.Close()
+	keys := map[string]string{}
+	server.Handle(http.MethodPost, "/rest/v2/contracts", func(w http.ResponseWriter, r *http.Request) {
+		keys["create"] = r.Header.Get("Idempotency-Key")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"c-1"}}`))
+	})
+	server.Handle(http.MethodPost, "/rest/v2/contracts/c-1/invitations", func(w http.ResponseWriter, r *http.Request) {
+		keys["invite"] = r.Header.Get("Idempotency-Key")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	lastClient = client
+	params := api.CreateContractParams{Title: "Test", Type: "payg_tasks", WorkerEmail: "a@b.co", Country: "US"}
+	scoped, err := api.ScopedIdempotencyKey("build-1", params)
+	require.NoError(t, err)
+
+	ctx := useScopedIdempotencyKey(context.Background(), client, scoped)
+	contract, err := client.CreateContract(ctx, params)
+	require.NoError(t, err)
+	_, ok := runThenChain(ctx, client, "contracts", contract.ID, contract, []string{"invite"})
+	require.True(t, ok)
+
+	assert.Equal(t, scoped, keys["create"])
+	assert.Equal(t, api.DerivedIdempotencyKey(scoped, "then-invite"), keys["invite"])
+	assert.Equal(t, map[string]string{"then-invite": keys["invite"]}, outfmt.IdempotencyParts(ctx))
+}
+
+func TestContractsListNeededFields(t *testing.T) {
+	assert.Empty(t, contractsListNeededFields())
+
+	contractsCountryFlag, contractsEntityIDFlag, contractsStatusSumFlag, contractsSumByTypeFlag = "TW", "le-1", true, true
+	t.Cleanup(func() {
+		contractsCountryFlag, contractsEntityIDFlag, contractsStatusSumFlag, contractsSumByTypeFlag = "", "", false, false
+	})
+	assert.Equal(t, []string{"worker", "client", "legal_entity_id", "status", "type"}, contractsListNeededFields())
+}
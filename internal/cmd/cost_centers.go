@@ -33,7 +33,7 @@ var costCentersListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		centers, err := client.ListCostCenters(cmd.Context())
+		centers, err := client.ListCostCenters(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "list cost centers")
 		}
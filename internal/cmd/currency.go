@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/dryrun"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+// knownCurrencies caches the currency codes from ListCurrencies for the rest
+// of the process. A failed lookup is not cached.
+var knownCurrencies struct {
+	sync.Mutex
+	codes map[string]bool
+}
+
+// countryDefaultCurrencies maps ISO 3166-1 alpha-2 country codes to the
+// ISO 4217 currency most commonly used for payroll in that country.
+var countryDefaultCurrencies = map[string]string{
+	"AE": "AED", "AR": "ARS", "AT": "EUR", "AU": "AUD", "BD": "BDT",
+	"BE": "EUR", "BG": "BGN", "BO": "BOB", "BR": "BRL", "CA": "CAD",
+	"CH": "CHF", "CL": "CLP", "CN": "CNY", "CO": "COP", "CR": "CRC",
+	"CY": "EUR", "CZ": "CZK", "DE": "EUR", "DK": "DKK", "DO": "DOP",
+	"EC": "USD", "EE": "EUR", "EG": "EGP", "ES": "EUR", "FI": "EUR",
+	"FR": "EUR", "GB": "GBP", "GE": "GEL", "GH": "GHS", "GR": "EUR",
+	"GT": "GTQ", "HK": "HKD", "HR": "EUR", "HU": "HUF", "ID": "IDR",
+	"IE": "EUR", "IL": "ILS", "IN": "INR", "IS": "ISK", "IT": "EUR",
+	"JM": "JMD", "JP": "JPY", "KE": "KES", "KR": "KRW", "KZ": "KZT",
+	"LK": "LKR", "LT": "EUR", "LU": "EUR", "LV": "EUR", "MA": "MAD",
+	"MT": "EUR", "MX": "MXN", "MY": "MYR", "NG": "NGN", "NL": "EUR",
+	"NO": "NOK", "NZ": "NZD", "PA": "USD", "PE": "PEN", "PH": "PHP",
+	"PK": "PKR", "PL": "PLN", "PT": "EUR", "PY": "PYG", "RO": "RON",
+	"RS": "RSD", "SA": "SAR", "SE": "SEK", "SG": "SGD", "SI": "EUR",
+	"SK": "EUR", "SV": "USD", "TH": "THB", "TR": "TRY", "TW": "TWD",
+	"UA": "UAH", "UG": "UGX", "US": "USD", "UY": "UYU", "VN": "VND",
+	"ZA": "ZAR",
+}
+
+// defaultCurrencyForCountry returns the default currency for a country code.
+func defaultCurrencyForCountry(country string) (string, bool) {
+	code, ok := countryDefaultCurrencies[strings.ToUpper(strings.TrimSpace(country))]
+	return code, ok
+}
+
+// resolveContractCurrency returns the currency to use for a new contract.
+// An explicit currency always wins; otherwise, when fromCountry is set, the
+// country's default currency is used and derived is true.
+func resolveContractCurrency(currency, country string, fromCountry bool) (code string, derived bool, err error) {
+	if currency != "" || !fromCountry {
+		return currency, false, nil
+	}
+	if country == "" {
+		return "", false, fmt.Errorf("--currency-from-country requires --country")
+	}
+	code, ok := defaultCurrencyForCountry(country)
+	if !ok {
+		return "", false, fmt.Errorf("no known default currency for country %q; pass --currency explicitly", country)
+	}
+	return code, true, nil
+}
+
+// checkCurrency validates a --currency value before a create request, and
+// before its --dry-run preview. The code must be well-formed ISO 4217 and,
+// unless skipLookup (the command's --skip-currency-check) is set, one of the
+// currencies Deel supports. newClient is only called when the list has to be
+// fetched. If the lookup fails a warning is printed and the code is accepted
+// on its format alone, leaving the API to judge it.
+func checkCurrency(ctx context.Context, f *outfmt.Formatter, newClient func() (*api.Client, error), code string, skipLookup bool) error {
+	if err := validateCurrency(code); err != nil {
+		return err
+	}
+	if skipLookup {
+		return nil
+	}
+	codes, ok := supportedCurrencies(ctx, f, newClient, "currency "+code)
+	if !ok {
+		return nil
+	}
+	if !codes[strings.ToUpper(code)] {
+		return fmt.Errorf("unknown currency code %q (see 'deel org lookups currencies')", code)
+	}
+	return nil
+}
+
+// supportedCurrencies returns the currency codes Deel supports, or false
+// when they could not be fetched; what names the values being checked in
+// the warning printed then. Without credentials a --dry-run still previews,
+// with a warning; a real run stays quiet here and fails when the command
+// builds its own client.
+func supportedCurrencies(ctx context.Context, f *outfmt.Formatter, newClient func() (*api.Client, error), what string) (map[string]bool, bool) {
+	knownCurrencies.Lock()
+	codes := knownCurrencies.codes
+	knownCurrencies.Unlock()
+	if codes != nil {
+		return codes, true
+	}
+	client, err := newClient()
+	if err != nil {
+		if dryrun.IsEnabled(ctx) {
+			f.PrintWarning("Could not verify %s (%v); checked its format only", what, err)
+		}
+		return nil, false
+	}
+	codes, err = currencyCodes(ctx, client)
+	if err != nil {
+		f.PrintWarning("Could not verify %s (%v); use --skip-currency-check to silence this", what, err)
+		return nil, false
+	}
+	return codes, true
+}
+
+func currencyCodes(ctx context.Context, client *api.Client) (map[string]bool, error) {
+	knownCurrencies.Lock()
+	defer knownCurrencies.Unlock()
+	if knownCurrencies.codes != nil {
+		return knownCurrencies.codes, nil
+	}
+	currencies, err := client.ListCurrencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(currencies) == 0 {
+		return nil, fmt.Errorf("no currencies returned")
+	}
+	codes := make(map[string]bool, len(currencies))
+	for _, c := range currencies {
+		codes[strings.ToUpper(c.Code)] = true
+	}
+	knownCurrencies.codes = codes
+	return codes, nil
+}
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+	"github.com/salmonumbrella/deel-cli/internal/dryrun"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+func TestResolveContractCurrency_DerivedFromCountry(t *testing.T) {
+	code, derived, err := resolveContractCurrency("", "de", true)
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", code)
+	assert.True(t, derived)
+}
+
+func TestResolveContractCurrency_ExplicitWins(t *testing.T) {
+	code, derived, err := resolveContractCurrency("USD", "DE", true)
+	require.NoError(t, err)
+	assert.Equal(t, "USD", code)
+	assert.False(t, derived)
+}
+
+func TestResolveContractCurrency_UnknownCountry(t *testing.T) {
+	_, _, err := resolveContractCurrency("", "XX", true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `no known default currency for country "XX"`)
+}
+
+func TestResolveContractCurrency_NotRequested(t *testing.T) {
+	code, derived, err := resolveContractCurrency("", "DE", false)
+	require.NoError(t, err)
+	assert.Empty(t, code)
+	assert.False(t, derived)
+}
+
+// currencyCheckSetup resets the process-wide currency cache and returns a
+// client constructor whose currency lookup is served by handler.
+func currencyCheckSetup(t *testing.T, handler http.HandlerFunc) (func() (*api.Client, error), *outfmt.Formatter, *bytes.Buffer) {
+	t.Helper()
+	knownCurrencies.codes = nil
+	t.Cleanup(func() { knownCurrencies.codes = nil })
+
+	server := testutil.NewMockServer()
+	t.Cleanup(server.Close)
+	server.Handle("GET", "/rest/v2/lookups/currencies", handler)
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	client.SetRetryConfig(0, 0, 0)
+
+	var out, errOut bytes.Buffer
+	newClient := func() (*api.Client, error) { return client, nil }
+	return newClient, outfmt.New(&out, &errOut, outfmt.FormatText, "never"), &errOut
+}
+
+func currencyListHandler(calls *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"code":"USD","name":"US Dollar"},{"code":"EUR","name":"Euro"}]}`))
+	}
+}
+
+func TestCheckCurrency_KnownAndCached(t *testing.T) {
+	calls := 0
+	newClient, f, _ := currencyCheckSetup(t, currencyListHandler(&calls))
+
+	require.NoError(t, checkCurrency(context.Background(), f, newClient, "usd", false))
+	require.NoError(t, checkCurrency(context.Background(), f, newClient, "EUR", false))
+	assert.Equal(t, 1, calls)
+}
+
+func TestCheckCurrency_Unknown(t *testing.T) {
+	calls := 0
+	newClient, f, _ := currencyCheckSetup(t, currencyListHandler(&calls))
+
+	err := checkCurrency(context.Background(), f, newClient, "XYZ", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown currency code "XYZ"`)
+}
+
+func TestCheckCurrency_MalformedFailsWithoutLookup(t *testing.T) {
+	calls := 0
+	newClient, f, _ := currencyCheckSetup(t, currencyListHandler(&calls))
+
+	require.Error(t, checkCurrency(context.Background(), f, newClient, "US", false))
+	assert.Equal(t, 0, calls)
+}
+
+func TestCheckCurrency_LookupFailureWarns(t *testing.T) {
+	newClient, f, errOut := currencyCheckSetup(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	require.NoError(t, checkCurrency(context.Background(), f, newClient, "XYZ", false))
+	assert.Contains(t, errOut.String(), "Could not verify currency XYZ")
+	assert.Nil(t, knownCurrencies.codes)
+}
+
+func TestCheckCurrency_SkipFlag(t *testing.T) {
+	calls := 0
+	newClient, f, _ := currencyCheckSetup(t, currencyListHandler(&calls))
+
+	require.NoError(t, checkCurrency(context.Background(), f, newClient, "XYZ", true))
+	assert.Equal(t, 0, calls)
+}
+
+func TestSkipCurrencyCheckFlag_PerCommand(t *testing.T) {
+	cmds := []*cobra.Command{contractsCreateCmd, eorCreateCmd, gpCreateCmd, adjustmentsCreateCmd, atsOffersCreateCmd}
+	for _, cmd := range cmds {
+		require.NotNil(t, cmd.Flags().Lookup("skip-currency-check"), cmd.CommandPath())
+	}
+	require.NoError(t, contractsCreateCmd.Flags().Set("skip-currency-check", "true"))
+	t.Cleanup(func() { _ = contractsCreateCmd.Flags().Set("skip-currency-check", "false") })
+	for _, cmd := range cmds[1:] {
+		assert.Equal(t, "false", cmd.Flags().Lookup("skip-currency-check").Value.String(), cmd.CommandPath())
+	}
+}
+
+func TestCheckCurrency_NoCredentials(t *testing.T) {
+	knownCurrencies.codes = nil
+	t.Cleanup(func() { knownCurrencies.codes = nil })
+	noAccount := func() (*api.Client, error) { return nil, errors.New("no account specified") }
+
+	var out, errOut bytes.Buffer
+	f := outfmt.New(&out, &errOut, outfmt.FormatText, "never")
+
+	// A dry run still previews, checking only the format.
+	ctx := dryrun.WithDryRun(context.Background(), true)
+	require.NoError(t, checkCurrency(ctx, f, noAccount, "XYZ", false))
+	assert.Contains(t, errOut.String(), "Could not verify currency XYZ (no account specified); checked its format only")
+	require.Error(t, checkCurrency(ctx, f, noAccount, "US", false))
+
+	// A real run leaves the error to the command's own client.
+	errOut.Reset()
+	require.NoError(t, checkCurrency(context.Background(), f, noAccount, "XYZ", false))
+	assert.Empty(t, errOut.String())
+}
+
+func TestCheckCurrency_SkipBuildsNoClient(t *testing.T) {
+	var out, errOut bytes.Buffer
+	f := outfmt.New(&out, &errOut, outfmt.FormatText, "never")
+	newClient := func() (*api.Client, error) {
+		t.Fatal("no client should be built with --skip-currency-check")
+		return nil, nil
+	}
+	require.NoError(t, checkCurrency(context.Background(), f, newClient, "XYZ", true))
+}
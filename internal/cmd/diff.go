@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// fieldDiff is one field where actual deviates from expected, in JSON Patch
+// form: applying {op, path, value} to expected gives actual.
+type fieldDiff struct {
+	Op       string `json:"op"`
+	Path     string `json:"path"`
+	Value    any    `json:"value"`
+	Expected any    `json:"expected"`
+}
+
+// diffFields compares every field expected sets against the same field of
+// actual. Both are compared as JSON, leaf by leaf, with JSON Pointer paths
+// (/compensation_amount, /worker/country); see jsonValuesEqual. Fields of
+// expected that actual does not have are returned in unchecked rather than
+// reported as differences. Results are sorted by path.
+func diffFields(expected, actual any) (diffs []fieldDiff, unchecked []string, err error) {
+	want, err := flattenJSON(expected)
+	if err != nil {
+		return nil, nil, err
+	}
+	got, err := flattenJSON(actual)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paths := make([]string, 0, len(want))
+	for path := range want {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		value, ok := got[path]
+		if !ok {
+			unchecked = append(unchecked, path)
+			continue
+		}
+		if !jsonValuesEqual(want[path], value) {
+			diffs = append(diffs, fieldDiff{Op: "replace", Path: path, Value: value, Expected: want[path]})
+		}
+	}
+	return diffs, unchecked, nil
+}
+
+// flattenJSON maps the JSON Pointer path of every leaf value in v to the
+// value. Arrays are leaves: they are compared whole.
+func flattenJSON(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var generic any
+	if err := dec.Decode(&generic); err != nil {
+		return nil, err
+	}
+	leaves := map[string]any{}
+	var walk func(prefix string, v any)
+	walk = func(prefix string, v any) {
+		obj, ok := v.(map[string]any)
+		if !ok || (len(obj) == 0 && prefix != "") {
+			leaves[prefix] = v
+			return
+		}
+		for k, child := range obj {
+			walk(prefix+"/"+strings.NewReplacer("~", "~0", "/", "~1").Replace(k), child)
+		}
+	}
+	walk("", generic)
+	return leaves, nil
+}
+
+// jsonValuesEqual compares decoded JSON values. Numbers compare by value, and
+// so do numeric strings, since the API sends many amounts as strings (100,
+// 100.00 and "100.00" are equal).
+func jsonValuesEqual(a, b any) bool {
+	if af, ok := jsonNumber(a); ok {
+		if bf, ok := jsonNumber(b); ok {
+			return af == bf
+		}
+	}
+	ab, _ := json.Marshal(a)
+	bb, _ := json.Marshal(b)
+	return bytes.Equal(ab, bb)
+}
+
+func jsonNumber(v any) (float64, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// formatDiffValue renders a diffed value for text output.
+func formatDiffValue(v any) string {
+	if s, ok := v.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
@@ -1,13 +1,16 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/salmonumbrella/deel-cli/internal/api"
 	"github.com/salmonumbrella/deel-cli/internal/dryrun"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
 )
 
 var eorCmd = &cobra.Command{
@@ -25,16 +28,21 @@ var (
 	eorCreateStartDateFlag    string
 	eorCreateSalaryFlag       string
 	eorCreateCurrencyFlag     string
+	eorCreateSkipCurrencyFlag bool
 	eorCreatePayFrequencyFlag string
 	eorCreateJobTitleFlag     string
 	eorCreateSeniorityFlag    string
 	eorCreateScopeFlag        string
+	eorCreateAutoWorkerFlag   bool
+	eorCreateWorkerDOBFlag    string
+	eorCreateWorkerPhoneFlag  string
 )
 
 var eorCreateCmd = &cobra.Command{
-	Use:   "create",
-	Short: "Create EOR contract",
-	Long:  "Create a new Employer of Record contract. Requires --title, --worker-email, --worker-name, --country, --start-date, --salary, --currency, --pay-frequency, and --job-title flags.",
+	Use:     "create",
+	Short:   "Create EOR contract",
+	Long:    "Create a new Employer of Record contract. Requires --title, --worker-email, --worker-name, --country, --start-date, --salary, --currency, --pay-frequency, and --job-title flags.\n\nWith --auto-worker, the EOR worker is created first (from --worker-email, --worker-name, --country, and optional --worker-dob/--worker-phone) unless a person with that email already exists.",
+	Example: "  deel eor create --title 'Engineer' --worker-email jane@example.com --worker-name 'Jane Doe' --country GB --start-date 2026-01-05 --salary 90000 --currency GBP --pay-frequency monthly --job-title Engineer --auto-worker",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 
@@ -67,28 +75,42 @@ var eorCreateCmd = &cobra.Command{
 			return failValidation(cmd, f, "--job-title flag is required")
 		}
 
+		if !eorCreateAutoWorkerFlag && (eorCreateWorkerDOBFlag != "" || eorCreateWorkerPhoneFlag != "") {
+			return failValidation(cmd, f, "--worker-dob and --worker-phone require --auto-worker")
+		}
+
 		// Parse salary
-		salary, err := strconv.ParseFloat(eorCreateSalaryFlag, 64)
+		salary, err := parsePositiveAmount(eorCreateSalaryFlag, "--salary")
 		if err != nil {
-			return failValidation(cmd, f, fmt.Sprintf("Invalid --salary value: %v", err))
+			return failValidation(cmd, f, err.Error())
 		}
 
-		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
-			Operation:   "CREATE",
-			Resource:    "EORContract",
-			Description: "Create EOR contract",
-			Details: map[string]string{
-				"Title":        eorCreateTitleFlag,
-				"WorkerEmail":  eorCreateWorkerEmailFlag,
-				"WorkerName":   eorCreateWorkerNameFlag,
-				"Country":      eorCreateCountryFlag,
-				"StartDate":    eorCreateStartDateFlag,
-				"Salary":       fmt.Sprintf("%.2f %s", salary, eorCreateCurrencyFlag),
-				"PayFrequency": eorCreatePayFrequencyFlag,
-				"JobTitle":     eorCreateJobTitleFlag,
-				"Seniority":    eorCreateSeniorityFlag,
-			},
-		}); ok {
+		var workerParams api.CreateEORWorkerParams
+		if eorCreateAutoWorkerFlag {
+			firstName, lastName, err := splitWorkerName(eorCreateWorkerNameFlag)
+			if err != nil {
+				return failValidation(cmd, f, err.Error())
+			}
+			if eorCreateWorkerDOBFlag != "" {
+				if err := validateDate(eorCreateWorkerDOBFlag); err != nil {
+					return failValidation(cmd, f, "--worker-dob: "+err.Error())
+				}
+			}
+			workerParams = api.CreateEORWorkerParams{
+				Email:       eorCreateWorkerEmailFlag,
+				FirstName:   firstName,
+				LastName:    lastName,
+				Country:     eorCreateCountryFlag,
+				DateOfBirth: eorCreateWorkerDOBFlag,
+				Phone:       eorCreateWorkerPhoneFlag,
+			}
+		}
+
+		if err := checkCurrency(cmd.Context(), f, getClient, eorCreateCurrencyFlag, eorCreateSkipCurrencyFlag); err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
+		if ok, err := handleDryRun(cmd, f, eorCreatePreview(salary, eorCreateAutoWorkerFlag, workerParams)); ok {
 			return err
 		}
 
@@ -111,35 +133,91 @@ var eorCreateCmd = &cobra.Command{
 			Scope:          eorCreateScopeFlag,
 		}
 
+		if eorCreateAutoWorkerFlag {
+			result, err := createEORContractWithWorker(cmd.Context(), client, workerParams, params)
+			if err != nil {
+				return HandleError(f, err, "create EOR contract")
+			}
+			return f.OutputFiltered(cmd.Context(), func() {
+				if result.WorkerCreated {
+					f.PrintSuccess("EOR worker created: %s (%s)", result.Worker.ID, result.Worker.Email)
+				} else {
+					f.PrintText(fmt.Sprintf("Worker %s already exists (%s); skipped worker creation", eorCreateWorkerEmailFlag, result.ExistingWorkerID))
+				}
+				printEORContractCreated(f, result.Contract)
+			}, result)
+		}
+
 		contract, err := client.CreateEORContract(cmd.Context(), params)
 		if err != nil {
 			return HandleError(f, err, "create EOR contract")
 		}
 
 		return f.OutputFiltered(cmd.Context(), func() {
-			f.PrintSuccess("EOR contract created successfully")
-			f.PrintText("ID:            " + contract.ID)
-			f.PrintText("Title:         " + contract.Title)
-			f.PrintText("Status:        " + contract.Status)
-			f.PrintText("Worker Email:  " + contract.WorkerEmail)
-			f.PrintText("Worker Name:   " + contract.WorkerName)
-			f.PrintText("Country:       " + contract.Country)
-			f.PrintText("Start Date:    " + contract.StartDate)
-			f.PrintText(fmt.Sprintf("Salary:        %.2f %s", contract.Salary, contract.Currency))
-			f.PrintText("Pay Frequency: " + contract.PayFrequency)
-			f.PrintText("Job Title:     " + contract.JobTitle)
-			if contract.SeniorityLevel != "" {
-				f.PrintText("Seniority:     " + contract.SeniorityLevel)
-			}
-			f.PrintText("Created:       " + contract.CreatedAt)
+			printEORContractCreated(f, contract)
 		}, contract)
 	},
 }
 
+// eorCreatePreview builds the dry-run preview for eor create. With
+// autoWorker, the preview lists the worker step ahead of the contract step.
+func eorCreatePreview(salary float64, autoWorker bool, worker api.CreateEORWorkerParams) *dryrun.Preview {
+	preview := &dryrun.Preview{
+		Operation:   "CREATE",
+		Resource:    "EORContract",
+		Description: "Create EOR contract",
+		Details: map[string]string{
+			"Title":        eorCreateTitleFlag,
+			"WorkerEmail":  eorCreateWorkerEmailFlag,
+			"WorkerName":   eorCreateWorkerNameFlag,
+			"Country":      eorCreateCountryFlag,
+			"StartDate":    eorCreateStartDateFlag,
+			"Salary":       fmt.Sprintf("%.2f %s", salary, eorCreateCurrencyFlag),
+			"PayFrequency": eorCreatePayFrequencyFlag,
+			"JobTitle":     eorCreateJobTitleFlag,
+			"Seniority":    eorCreateSeniorityFlag,
+		},
+	}
+	if autoWorker {
+		preview.Description = "Create EOR worker (if missing), then EOR contract"
+		preview.Details["Steps"] = fmt.Sprintf("1) create EOR worker %s %s <%s> unless one already exists; 2) create EOR contract", worker.FirstName, worker.LastName, worker.Email)
+		if worker.DateOfBirth != "" {
+			preview.Details["WorkerDOB"] = worker.DateOfBirth
+		}
+		if worker.Phone != "" {
+			preview.Details["WorkerPhone"] = worker.Phone
+		}
+	}
+	return preview
+}
+
+func printEORContractCreated(f *outfmt.Formatter, contract *api.EORContract) {
+	f.PrintSuccess("EOR contract created successfully")
+	f.PrintText("ID:            " + contract.ID)
+	f.PrintText("Title:         " + contract.Title)
+	f.PrintText("Status:        " + contract.Status)
+	f.PrintText("Worker Email:  " + contract.WorkerEmail)
+	f.PrintText("Worker Name:   " + contract.WorkerName)
+	f.PrintText("Country:       " + contract.Country)
+	f.PrintText("Start Date:    " + contract.StartDate)
+	f.PrintText(fmt.Sprintf("Salary:        %.2f %s", contract.Salary, contract.Currency))
+	f.PrintText("Pay Frequency: " + contract.PayFrequency)
+	f.PrintText("Job Title:     " + contract.JobTitle)
+	if contract.SeniorityLevel != "" {
+		f.PrintText("Seniority:     " + contract.SeniorityLevel)
+	}
+	f.PrintText("Created:       " + contract.CreatedAt)
+}
+
+// eorGetIncludeFlag lists related records to fetch with `eor get`.
+var eorGetIncludeFlag []string
+
 var eorGetCmd = &cobra.Command{
-	Use:   "get <id>",
-	Short: "Get EOR contract details",
-	Args:  cobra.ExactArgs(1),
+	Use:     "get <id>",
+	Short:   "Get EOR contract details",
+	Long:    "Get EOR contract details.\n\nWith --include amendments,termination, the contract's amendments and termination record are fetched concurrently and nested in the output. If one of them cannot be fetched, the others are still shown and the failure is listed under include_errors.",
+	Example: "  deel eor get eor-123 --include amendments,termination",
+	Args:    cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
@@ -158,12 +236,28 @@ var eorGetCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		contract, err := client.GetEORContract(cmd.Context(), args[0])
+		include, err := parseEORIncludes(eorGetIncludeFlag)
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
+		contract, err := client.GetEORContract(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "get EOR contract")
 		}
 
-		return f.OutputFiltered(cmd.Context(), func() {
+		var data any = contract
+		var inc *eorIncludes
+		if len(include) > 0 {
+			inc = fetchEORIncludes(cmd.Context(), client, args[0], include)
+			data, err = withEORIncludes(contract, include, inc)
+			if err != nil {
+				return HandleError(f, err, "get EOR contract")
+			}
+			warnEORIncludeErrors(f, inc)
+		}
+
+		return outputBatchResults(cmd.Context(), f, func() {
 			f.PrintText("ID:            " + contract.ID)
 			f.PrintText("Title:         " + contract.Title)
 			f.PrintText("Status:        " + contract.Status)
@@ -202,7 +296,10 @@ var eorGetCmd = &cobra.Command{
 				}
 				table.Render()
 			}
-		}, contract)
+			if inc != nil {
+				printEORIncludes(f, include, inc)
+			}
+		}, data, eorIncludeFailure(inc))
 	},
 }
 
@@ -290,47 +387,60 @@ var (
 	eorAmendJobTitleFlag      string
 	eorAmendSeniorityFlag     string
 	eorAmendScopeFlag         string
+	eorAmendChangesFileFlag   string
 )
 
 var eorAmendCmd = &cobra.Command{
 	Use:   "amend <id>",
 	Short: "Create amendment for EOR contract",
-	Long:  "Create an amendment for an EOR contract. Requires --type, --effective-date, and --reason flags. Additional changes via --salary, --job-title, --seniority, --scope flags.",
-	Args:  cobra.ExactArgs(1),
+	Long: `Create an amendment for an EOR contract. Requires --type, --effective-date, and --reason flags. Additional changes via --salary, --job-title, --seniority, --scope flags.
+
+--changes-file loads the whole amendment from JSON instead, so it can be
+templated:
+
+  {"type": "salary_change", "effective_date": "2026-01-01",
+   "reason": "Annual review", "changes": {"salary": 95000}}
+
+Flags given alongside the file override its values, and change flags are
+merged into its changes.`,
+	Example: `  deel eor amend c-123 --type salary_change --effective-date 2026-01-01 --reason "Annual review" --salary 95000
+  deel eor amend c-123 --changes-file amendment.json --reason "Promotion"`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 
-		if eorAmendTypeFlag == "" {
-			return failValidation(cmd, f, "--type flag is required")
-		}
-		if eorAmendEffectiveDateFlag == "" {
-			return failValidation(cmd, f, "--effective-date flag is required")
-		}
-		if eorAmendReasonFlag == "" {
-			return failValidation(cmd, f, "--reason flag is required")
+		spec := eorAmendmentSpec{
+			Type:          eorAmendTypeFlag,
+			EffectiveDate: eorAmendEffectiveDateFlag,
+			Reason:        eorAmendReasonFlag,
+			Changes:       make(map[string]any),
 		}
-
-		// Build changes map
-		changes := make(map[string]interface{})
 		if eorAmendSalaryFlag != "" {
 			salary, err := strconv.ParseFloat(eorAmendSalaryFlag, 64)
 			if err != nil {
 				return failValidation(cmd, f, fmt.Sprintf("Invalid --salary value: %v", err))
 			}
-			changes["salary"] = salary
+			spec.Changes["salary"] = salary
 		}
 		if eorAmendJobTitleFlag != "" {
-			changes["job_title"] = eorAmendJobTitleFlag
+			spec.Changes["job_title"] = eorAmendJobTitleFlag
 		}
 		if eorAmendSeniorityFlag != "" {
-			changes["seniority_level"] = eorAmendSeniorityFlag
+			spec.Changes["seniority_level"] = eorAmendSeniorityFlag
 		}
 		if eorAmendScopeFlag != "" {
-			changes["scope"] = eorAmendScopeFlag
+			spec.Changes["scope"] = eorAmendScopeFlag
 		}
 
-		if len(changes) == 0 {
-			return failValidation(cmd, f, "At least one change flag (--salary, --job-title, --seniority, --scope) is required")
+		if eorAmendChangesFileFlag != "" {
+			file, err := loadEORAmendmentFile(eorAmendChangesFileFlag)
+			if err != nil {
+				return failValidation(cmd, f, err.Error())
+			}
+			spec = mergeEORAmendment(file, spec)
+		}
+		if err := spec.validate(); err != nil {
+			return failValidation(cmd, f, err.Error())
 		}
 
 		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
@@ -339,9 +449,9 @@ var eorAmendCmd = &cobra.Command{
 			Description: "Create EOR amendment",
 			Details: map[string]string{
 				"ID":            args[0],
-				"Type":          eorAmendTypeFlag,
-				"EffectiveDate": eorAmendEffectiveDateFlag,
-				"Reason":        eorAmendReasonFlag,
+				"Type":          spec.Type,
+				"EffectiveDate": spec.EffectiveDate,
+				"Reason":        spec.Reason,
 			},
 		}); ok {
 			return err
@@ -353,10 +463,10 @@ var eorAmendCmd = &cobra.Command{
 		}
 
 		params := api.CreateEORAmendmentParams{
-			Type:          eorAmendTypeFlag,
-			Changes:       changes,
-			EffectiveDate: eorAmendEffectiveDateFlag,
-			Reason:        eorAmendReasonFlag,
+			Type:          spec.Type,
+			Changes:       spec.Changes,
+			EffectiveDate: spec.EffectiveDate,
+			Reason:        spec.Reason,
 		}
 
 		amendment, err := client.CreateEORAmendment(cmd.Context(), args[0], params)
@@ -404,7 +514,7 @@ var eorAmendmentsListCmd = &cobra.Command{
 			return HandleError(f, err, "listing amendments")
 		}
 
-		amendments, err := client.ListEORAmendments(cmd.Context(), args[0])
+		amendments, err := client.ListEORAmendments(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "listing amendments")
 		}
@@ -514,10 +624,17 @@ var eorAmendmentsAcceptCmd = &cobra.Command{
 	},
 }
 
+// EOR termination reason details must be 100-5000 characters.
+const (
+	eorTerminationDetailMinLen = 100
+	eorTerminationDetailMaxLen = 5000
+)
+
 // Flags for terminate command
 var (
 	eorTerminateReasonFlag       string
 	eorTerminateReasonDetailFlag string
+	eorTerminateReasonFileFlag   string
 	eorTerminateNotifiedFlag     bool
 	eorTerminateSensitiveFlag    bool
 	eorTerminateSeveranceFlag    string
@@ -531,7 +648,7 @@ var eorTerminateCmd = &cobra.Command{
 	Short: "Request termination for EOR contract",
 	Long: `Request termination for an EOR contract (employer-initiated).
 
-Requires --reason and --reason-detail flags.
+Requires --reason and --reason-detail (or --reason-file) flags.
 
 Available reasons:
   TERMINATION, FOR_CAUSE, PERFORMANCE, PERFORMANCE_ISSUES, ATTENDANCE_ISSUES,
@@ -540,7 +657,8 @@ Available reasons:
   ROLE_BECAME_REDUNDANT_OR_ROLE_CHANGED, NON_RENEWAL, PROBATION, and more.
 
 Example:
-  deel eor terminate abc123 --reason TERMINATION --reason-detail "Position eliminated due to restructuring" --notified`,
+  deel eor terminate abc123 --reason TERMINATION --reason-detail "Position eliminated due to restructuring" --notified
+  deel eor terminate abc123 --reason TERMINATION --reason-file ./termination-detail.txt`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
@@ -548,11 +666,15 @@ Example:
 		if eorTerminateReasonFlag == "" {
 			return failValidation(cmd, f, "--reason flag is required")
 		}
-		if eorTerminateReasonDetailFlag == "" {
-			return failValidation(cmd, f, "--reason-detail flag is required (100-5000 chars)")
+		reasonDetail, err := resolveTextFlag(eorTerminateReasonDetailFlag, eorTerminateReasonFileFlag, "--reason-detail", "--reason-file")
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
 		}
-		if len(eorTerminateReasonDetailFlag) < 100 {
-			return failValidation(cmd, f, "--reason-detail must be at least 100 characters")
+		if reasonDetail == "" {
+			return failValidation(cmd, f, "--reason-detail or --reason-file is required (100-5000 chars)")
+		}
+		if err := validateTextLength("--reason-detail", reasonDetail, eorTerminationDetailMinLen, eorTerminationDetailMaxLen); err != nil {
+			return failValidation(cmd, f, err.Error())
 		}
 
 		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
@@ -562,7 +684,7 @@ Example:
 			Details: map[string]string{
 				"OID":              args[0],
 				"Reason":           eorTerminateReasonFlag,
-				"ReasonDetail":     eorTerminateReasonDetailFlag[:min(50, len(eorTerminateReasonDetailFlag))] + "...",
+				"ReasonDetail":     truncateRunes(reasonDetail, 50),
 				"EmployeeNotified": fmt.Sprintf("%t", eorTerminateNotifiedFlag),
 				"Sensitive":        fmt.Sprintf("%t", eorTerminateSensitiveFlag),
 			},
@@ -577,7 +699,7 @@ Example:
 
 		params := api.EORTerminationParams{
 			Reason:             eorTerminateReasonFlag,
-			ReasonDetail:       eorTerminateReasonDetailFlag,
+			ReasonDetail:       reasonDetail,
 			IsEmployeeNotified: eorTerminateNotifiedFlag,
 			IsSensitive:        eorTerminateSensitiveFlag,
 			SeveranceType:      eorTerminateSeveranceFlag,
@@ -615,7 +737,93 @@ Example:
 var workersCmd = &cobra.Command{
 	Use:   "workers",
 	Short: "Manage EOR workers",
-	Long:  "Create and manage EOR workers.",
+	Long:  "Create, list, and inspect EOR workers.",
+}
+
+// Flags for workers list command
+var (
+	workersListLimitFlag  int
+	workersListCursorFlag string
+	workersListAllFlag    bool
+)
+
+var workersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List EOR workers",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, f, err := initClient("listing EOR workers")
+		if err != nil {
+			return err
+		}
+
+		workers, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), workersListAllFlag, workersListCursorFlag, workersListLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.EORWorker], error) {
+			resp, err := client.ListEORWorkers(ctx, api.EORWorkersListParams{
+				Limit:  limit,
+				Cursor: cursor,
+			})
+			if err != nil {
+				return CursorListResult[api.EORWorker]{}, err
+			}
+			return CursorListResult[api.EORWorker]{
+				Items: resp.Data,
+				Page: CursorPage{
+					Next:  resp.Page.Next,
+					Total: resp.Page.Total,
+				},
+			}, nil
+		})
+		if err != nil {
+			return HandleError(f, err, "listing EOR workers")
+		}
+
+		response := makeListResponse(workers, page)
+
+		return outputList(cmd, f, workers, hasMore, "No EOR workers found.", []string{"ID", "EMAIL", "NAME", "COUNTRY", "STATUS"}, func(w api.EORWorker) []string {
+			return []string{w.ID, w.Email, eorWorkerName(w), w.Country, w.Status}
+		}, response)
+	},
+}
+
+var workersGetCmd = &cobra.Command{
+	Use:   "get <worker-id>",
+	Short: "Get EOR worker details",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, f, err := initClient("initializing client")
+		if err != nil {
+			return err
+		}
+
+		worker, err := client.GetEORWorker(api.WithServerFields(cmd.Context()), args[0])
+		if err != nil {
+			return HandleError(f, err, "get EOR worker")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			f.PrintText("ID:          " + worker.ID)
+			f.PrintText("Email:       " + worker.Email)
+			f.PrintText("Name:        " + eorWorkerName(*worker))
+			f.PrintText("Country:     " + worker.Country)
+			if worker.DateOfBirth != "" {
+				f.PrintText("DOB:         " + worker.DateOfBirth)
+			}
+			if worker.Phone != "" {
+				f.PrintText("Phone:       " + worker.Phone)
+			}
+			if worker.Address != "" {
+				f.PrintText("Address:     " + worker.Address)
+			}
+			if worker.ContractID != "" {
+				f.PrintText("Contract ID: " + worker.ContractID)
+			}
+			f.PrintText("Status:      " + worker.Status)
+			f.PrintText("Created:     " + worker.CreatedAt)
+		}, worker)
+	},
+}
+
+func eorWorkerName(w api.EORWorker) string {
+	return strings.TrimSpace(w.FirstName + " " + w.LastName)
 }
 
 // Flags for workers create command
@@ -706,7 +914,93 @@ var workersCreateCmd = &cobra.Command{
 var bankAccountsCmd = &cobra.Command{
 	Use:   "bank-accounts",
 	Short: "Manage EOR worker bank accounts",
-	Long:  "Add and manage bank accounts for EOR workers.",
+	Long:  "List, add, and delete bank accounts for EOR workers.",
+}
+
+var bankAccountsListCmd = &cobra.Command{
+	Use:   "list <worker-id>",
+	Short: "List bank accounts for EOR worker",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, f, err := initClient("initializing client")
+		if err != nil {
+			return err
+		}
+
+		accounts, err := client.ListEORWorkerBankAccounts(api.WithServerFields(cmd.Context()), args[0])
+		if err != nil {
+			return HandleError(f, err, "list bank accounts")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			if len(accounts) == 0 {
+				f.PrintText("No bank accounts found")
+				return
+			}
+			table := f.NewTable("ID", "ACCOUNT HOLDER", "BANK NAME", "ACCOUNT NUMBER", "CURRENCY", "PRIMARY", "STATUS")
+			for _, account := range accounts {
+				primaryStr := "No"
+				if account.IsPrimary {
+					primaryStr = "Yes"
+				}
+				table.AddRow(
+					account.ID,
+					account.AccountHolder,
+					account.BankName,
+					account.AccountNumber,
+					account.Currency,
+					primaryStr,
+					account.Status,
+				)
+			}
+			table.Render()
+		}, accounts)
+	},
+}
+
+var bankAccountDeleteForceFlag bool
+
+var bankAccountsDeleteCmd = &cobra.Command{
+	Use:   "delete <worker-id> <account-id>",
+	Short: "Delete bank account from EOR worker",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+		workerID, accountID := args[0], args[1]
+
+		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
+			Operation:   "DELETE",
+			Resource:    "EORBankAccount",
+			Description: "Delete EOR bank account",
+			Details: map[string]string{
+				"WorkerID":  workerID,
+				"AccountID": accountID,
+			},
+		}); ok {
+			return err
+		}
+
+		if ok, err := requireForce(cmd, f, bankAccountDeleteForceFlag, "delete", "bank account", accountID, "deel eor bank-accounts delete "+workerID+" "+accountID+" --force"); !ok {
+			return err
+		}
+
+		client, err := getClient()
+		if err != nil {
+			return HandleError(f, err, "initializing client")
+		}
+
+		if err := client.DeleteEORWorkerBankAccount(cmd.Context(), workerID, accountID); err != nil {
+			return HandleError(f, err, "delete bank account")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			f.PrintSuccess("Bank account deleted successfully.")
+		}, map[string]any{
+			"deleted":    true,
+			"worker_id":  workerID,
+			"account_id": accountID,
+		})
+	},
 }
 
 // Flags for bank-accounts add command
@@ -804,6 +1098,8 @@ var bankAccountsAddCmd = &cobra.Command{
 }
 
 func init() {
+	eorGetCmd.Flags().StringSliceVar(&eorGetIncludeFlag, "include", nil, "Related records to include: amendments, termination (comma-separated)")
+
 	// Create command flags
 	eorCreateCmd.Flags().StringVar(&eorCreateTitleFlag, "title", "", "Contract title (required)")
 	eorCreateCmd.Flags().StringVar(&eorCreateWorkerEmailFlag, "worker-email", "", "Worker email (required)")
@@ -812,10 +1108,14 @@ func init() {
 	eorCreateCmd.Flags().StringVar(&eorCreateStartDateFlag, "start-date", "", "Start date YYYY-MM-DD (required)")
 	eorCreateCmd.Flags().StringVar(&eorCreateSalaryFlag, "salary", "", "Annual salary (required)")
 	eorCreateCmd.Flags().StringVar(&eorCreateCurrencyFlag, "currency", "", "Currency code (required)")
+	eorCreateCmd.Flags().BoolVar(&eorCreateSkipCurrencyFlag, "skip-currency-check", false, "Skip checking --currency against the supported currency list (e.g. offline)")
 	eorCreateCmd.Flags().StringVar(&eorCreatePayFrequencyFlag, "pay-frequency", "", "Pay frequency (required)")
 	eorCreateCmd.Flags().StringVar(&eorCreateJobTitleFlag, "job-title", "", "Job title (required)")
 	eorCreateCmd.Flags().StringVar(&eorCreateSeniorityFlag, "seniority", "", "Seniority level (optional)")
 	eorCreateCmd.Flags().StringVar(&eorCreateScopeFlag, "scope", "", "Scope of work (optional)")
+	eorCreateCmd.Flags().BoolVar(&eorCreateAutoWorkerFlag, "auto-worker", false, "Create the EOR worker first if no person with --worker-email exists")
+	eorCreateCmd.Flags().StringVar(&eorCreateWorkerDOBFlag, "worker-dob", "", "Worker date of birth YYYY-MM-DD (with --auto-worker)")
+	eorCreateCmd.Flags().StringVar(&eorCreateWorkerPhoneFlag, "worker-phone", "", "Worker phone number (with --auto-worker)")
 
 	// Cancel command flags
 	eorCancelCmd.Flags().StringVar(&eorCancelReasonFlag, "reason", "", "Cancellation reason (required)")
@@ -828,10 +1128,12 @@ func init() {
 	eorAmendCmd.Flags().StringVar(&eorAmendJobTitleFlag, "job-title", "", "New job title (optional)")
 	eorAmendCmd.Flags().StringVar(&eorAmendSeniorityFlag, "seniority", "", "New seniority level (optional)")
 	eorAmendCmd.Flags().StringVar(&eorAmendScopeFlag, "scope", "", "New scope (optional)")
+	eorAmendCmd.Flags().StringVar(&eorAmendChangesFileFlag, "changes-file", "", "JSON file with type, effective_date, reason, and changes (flags override)")
 
 	// Terminate command flags
 	eorTerminateCmd.Flags().StringVar(&eorTerminateReasonFlag, "reason", "", "Termination reason enum (required): TERMINATION, FOR_CAUSE, PERFORMANCE, etc.")
 	eorTerminateCmd.Flags().StringVar(&eorTerminateReasonDetailFlag, "reason-detail", "", "Detailed reason description, 100-5000 chars (required)")
+	eorTerminateCmd.Flags().StringVar(&eorTerminateReasonFileFlag, "reason-file", "", "Read the detailed reason description from a file (alternative to --reason-detail)")
 	eorTerminateCmd.Flags().BoolVar(&eorTerminateNotifiedFlag, "notified", false, "Has the employee been notified")
 	eorTerminateCmd.Flags().BoolVar(&eorTerminateSensitiveFlag, "sensitive", false, "Mark as sensitive termination")
 	eorTerminateCmd.Flags().StringVar(&eorTerminateSeveranceFlag, "severance", "", "Severance type: DAYS, WEEKS, MONTHS, or CASH")
@@ -839,6 +1141,11 @@ func init() {
 	eorTerminateCmd.Flags().IntVar(&eorTerminateUnpaidFlag, "unpaid-days", 0, "Unpaid time off days used")
 	eorTerminateCmd.Flags().IntVar(&eorTerminateSickFlag, "sick-days", 0, "Sick leave days used")
 
+	// Workers list command flags
+	workersListCmd.Flags().IntVar(&workersListLimitFlag, "limit", 100, "Maximum results")
+	workersListCmd.Flags().StringVar(&workersListCursorFlag, "cursor", "", "Pagination cursor")
+	workersListCmd.Flags().BoolVar(&workersListAllFlag, "all", false, "Fetch all pages")
+
 	// Workers create command flags
 	workersCreateCmd.Flags().StringVar(&workersCreateEmailFlag, "email", "", "Worker email (required)")
 	workersCreateCmd.Flags().StringVar(&workersCreateFirstNameFlag, "first-name", "", "First name (required)")
@@ -857,11 +1164,18 @@ func init() {
 	bankAccountsAddCmd.Flags().StringVar(&bankAccountAddCurrencyFlag, "currency", "", "Currency code (required)")
 	bankAccountsAddCmd.Flags().BoolVar(&bankAccountAddIsPrimaryFlag, "is-primary", false, "Set as primary account (optional)")
 
+	// Bank accounts delete command flags
+	bankAccountsDeleteCmd.Flags().BoolVar(&bankAccountDeleteForceFlag, "force", false, "Confirm deletion")
+
 	// Add subcommands to workers
+	workersCmd.AddCommand(workersListCmd)
+	workersCmd.AddCommand(workersGetCmd)
 	workersCmd.AddCommand(workersCreateCmd)
 
 	// Add subcommands to bank-accounts
+	bankAccountsCmd.AddCommand(bankAccountsListCmd)
 	bankAccountsCmd.AddCommand(bankAccountsAddCmd)
+	bankAccountsCmd.AddCommand(bankAccountsDeleteCmd)
 
 	// Amendments list command flags
 	eorAmendmentsListCmd.Flags().IntVar(&eorAmendmentsLimitFlag, "limit", 100, "Maximum results")
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// eorAmendmentSpec is an EOR amendment as given by eor amend flags or loaded
+// from --changes-file. File keys match the API request body.
+type eorAmendmentSpec struct {
+	Type          string         `json:"type"`
+	EffectiveDate string         `json:"effective_date"`
+	Reason        string         `json:"reason"`
+	Changes       map[string]any `json:"changes"`
+}
+
+// loadEORAmendmentFile reads an amendment template. Unknown top-level keys
+// are rejected so a misspelt field is not silently dropped.
+func loadEORAmendmentFile(path string) (eorAmendmentSpec, error) {
+	var spec eorAmendmentSpec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return spec, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&spec); err != nil {
+		return spec, fmt.Errorf("invalid --changes-file %s: %w", path, err)
+	}
+	return spec, nil
+}
+
+// mergeEORAmendment overlays flags on an amendment loaded from a file. Set
+// flag fields win, and flag changes are added to the file's changes,
+// replacing keys that are in both.
+func mergeEORAmendment(file, flags eorAmendmentSpec) eorAmendmentSpec {
+	out := file
+	if flags.Type != "" {
+		out.Type = flags.Type
+	}
+	if flags.EffectiveDate != "" {
+		out.EffectiveDate = flags.EffectiveDate
+	}
+	if flags.Reason != "" {
+		out.Reason = flags.Reason
+	}
+	out.Changes = make(map[string]any, len(file.Changes)+len(flags.Changes))
+	for k, v := range file.Changes {
+		out.Changes[k] = v
+	}
+	for k, v := range flags.Changes {
+		out.Changes[k] = v
+	}
+	return out
+}
+
+// validate checks the fields the API requires, naming both the flag and the
+// file key for each.
+func (s eorAmendmentSpec) validate() error {
+	if s.Type == "" {
+		return fmt.Errorf(`amendment type is required (--type or "type" in --changes-file)`)
+	}
+	if s.EffectiveDate == "" {
+		return fmt.Errorf(`effective date is required (--effective-date or "effective_date" in --changes-file)`)
+	}
+	if err := validateDate(s.EffectiveDate); err != nil {
+		return fmt.Errorf("invalid effective date: %w", err)
+	}
+	if s.Reason == "" {
+		return fmt.Errorf(`reason is required (--reason or "reason" in --changes-file)`)
+	}
+	if len(s.Changes) == 0 {
+		return fmt.Errorf(`at least one change is required (--salary, --job-title, --seniority, --scope, or "changes" in --changes-file)`)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeAmendmentFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "amendment.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadEORAmendmentFile(t *testing.T) {
+	path := writeAmendmentFile(t, `{
+		"type": "salary_change",
+		"effective_date": "2026-01-01",
+		"reason": "Annual review",
+		"changes": {"salary": 95000, "job_title": "Staff Engineer"}
+	}`)
+
+	spec, err := loadEORAmendmentFile(path)
+	require.NoError(t, err)
+	spec = mergeEORAmendment(spec, eorAmendmentSpec{Changes: map[string]any{}})
+	require.NoError(t, spec.validate())
+
+	assert.Equal(t, "salary_change", spec.Type)
+	assert.Equal(t, "2026-01-01", spec.EffectiveDate)
+	assert.Equal(t, "Annual review", spec.Reason)
+	assert.Equal(t, map[string]any{"salary": 95000.0, "job_title": "Staff Engineer"}, spec.Changes)
+}
+
+func TestLoadEORAmendmentFile_MissingEffectiveDate(t *testing.T) {
+	path := writeAmendmentFile(t, `{"type": "salary_change", "reason": "Annual review", "changes": {"salary": 95000}}`)
+
+	spec, err := loadEORAmendmentFile(path)
+	require.NoError(t, err)
+	err = mergeEORAmendment(spec, eorAmendmentSpec{}).validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "effective_date")
+
+	// The flag can supply what the file lacks.
+	spec = mergeEORAmendment(spec, eorAmendmentSpec{EffectiveDate: "2026-02-01"})
+	assert.NoError(t, spec.validate())
+}
+
+func TestMergeEORAmendment_FlagsOverrideFile(t *testing.T) {
+	file := eorAmendmentSpec{
+		Type:          "salary_change",
+		EffectiveDate: "2026-01-01",
+		Reason:        "Annual review",
+		Changes:       map[string]any{"salary": 95000.0, "scope": "Backend"},
+	}
+
+	spec := mergeEORAmendment(file, eorAmendmentSpec{
+		Reason:  "Promotion",
+		Changes: map[string]any{"salary": 105000.0, "job_title": "Lead"},
+	})
+	assert.Equal(t, "Promotion", spec.Reason)
+	assert.Equal(t, "salary_change", spec.Type)
+	assert.Equal(t, map[string]any{"salary": 105000.0, "scope": "Backend", "job_title": "Lead"}, spec.Changes)
+	assert.Equal(t, 95000.0, file.Changes["salary"], "file spec must not be modified")
+}
+
+func TestLoadEORAmendmentFile_RejectsUnknownKeys(t *testing.T) {
+	path := writeAmendmentFile(t, `{"type": "x", "efective_date": "2026-01-01"}`)
+	_, err := loadEORAmendmentFile(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "efective_date")
+}
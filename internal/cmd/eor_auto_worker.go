@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+)
+
+// eorCreateResult is the JSON payload for `eor create --auto-worker`.
+type eorCreateResult struct {
+	Worker           *api.EORWorker   `json:"worker,omitempty"`
+	WorkerCreated    bool             `json:"worker_created"`
+	ExistingWorkerID string           `json:"existing_worker_id,omitempty"`
+	Contract         *api.EORContract `json:"contract"`
+}
+
+// splitWorkerName splits "First Last" into first and last names. Everything
+// after the first word is treated as the last name.
+func splitWorkerName(name string) (string, string, error) {
+	fields := strings.Fields(name)
+	if len(fields) < 2 {
+		return "", "", fmt.Errorf("--worker-name must include a first and last name to create the worker (got %q)", name)
+	}
+	return fields[0], strings.Join(fields[1:], " "), nil
+}
+
+// findWorkerByEmail returns the person with the given email, or nil if the
+// API reports no match.
+func findWorkerByEmail(ctx context.Context, client *api.Client, email string) (*api.Person, error) {
+	person, err := client.SearchPeopleByEmail(ctx, email)
+	if err != nil {
+		var apiErr *api.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if person == nil || person.ID == "" && person.HRISProfileID == "" {
+		return nil, nil
+	}
+	return person, nil
+}
+
+// createEORContractWithWorker creates the EOR worker when no person with the
+// worker's email exists yet, then creates the contract.
+func createEORContractWithWorker(ctx context.Context, client *api.Client, worker api.CreateEORWorkerParams, contract api.CreateEORContractParams) (*eorCreateResult, error) {
+	result := &eorCreateResult{}
+
+	existing, err := findWorkerByEmail(ctx, client, worker.Email)
+	if err != nil {
+		return nil, fmt.Errorf("looking up worker %s: %w", worker.Email, err)
+	}
+	if existing != nil {
+		result.ExistingWorkerID = existing.HRISProfileID
+		if result.ExistingWorkerID == "" {
+			result.ExistingWorkerID = existing.ID
+		}
+	} else {
+		created, err := client.CreateEORWorker(api.WithIdempotencyPart(ctx, "create-worker"), worker)
+		if err != nil {
+			return nil, fmt.Errorf("creating worker %s: %w", worker.Email, err)
+		}
+		result.Worker = created
+		result.WorkerCreated = true
+	}
+
+	c, err := client.CreateEORContract(ctx, contract)
+	if err != nil {
+		if result.WorkerCreated {
+			return result, fmt.Errorf("worker %s was created but the contract was not: %w", result.Worker.ID, err)
+		}
+		return result, err
+	}
+	result.Contract = c
+	return result, nil
+}
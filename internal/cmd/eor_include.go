@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/batch"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+// eorIncludeNames lists the related records `eor get --include` can fetch.
+var eorIncludeNames = []string{"amendments", "termination"}
+
+// eorIncludes holds the related records fetched for `eor get --include`.
+// Errors maps an include name to the reason it could not be fetched, so one
+// failed lookup does not hide the others.
+type eorIncludes struct {
+	Amendments  []api.EORAmendment
+	Termination *api.EORTermination
+	Errors      map[string]string
+}
+
+// parseEORIncludes validates --include values, accepting comma-separated and
+// repeated flags. Duplicates are dropped.
+func parseEORIncludes(values []string) ([]string, error) {
+	seen := map[string]bool{}
+	var out []string
+	for _, v := range values {
+		for _, name := range strings.Split(v, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name == "" || seen[name] {
+				continue
+			}
+			if !slices.Contains(eorIncludeNames, name) {
+				return nil, fmt.Errorf("unknown --include value %q (valid: %s)", name, strings.Join(eorIncludeNames, ", "))
+			}
+			seen[name] = true
+			out = append(out, name)
+		}
+	}
+	return out, nil
+}
+
+// fetchEORIncludes fetches the requested related records concurrently. A
+// contract without a termination record yields a nil Termination, not an
+// error.
+func fetchEORIncludes(ctx context.Context, client *api.Client, contractID string, include []string) *eorIncludes {
+	results := batch.Run(ctx, len(include), len(include), func(ctx context.Context, i int) (any, error) {
+		switch include[i] {
+		case "amendments":
+			amendments, err := client.ListEORAmendments(ctx, contractID)
+			if err != nil {
+				return nil, err
+			}
+			if amendments == nil {
+				amendments = []api.EORAmendment{}
+			}
+			return amendments, nil
+		case "termination":
+			termination, err := client.GetEORTermination(ctx, contractID)
+			if err != nil {
+				var apiErr *api.APIError
+				if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+					return (*api.EORTermination)(nil), nil
+				}
+				return nil, err
+			}
+			return termination, nil
+		}
+		return nil, nil
+	})
+
+	result := &eorIncludes{}
+	for _, r := range results {
+		name := include[r.Index]
+		if r.Error != nil {
+			if result.Errors == nil {
+				result.Errors = map[string]string{}
+			}
+			result.Errors[name] = r.Error.Error()
+			continue
+		}
+		switch data := r.Data.(type) {
+		case []api.EORAmendment:
+			result.Amendments = data
+		case *api.EORTermination:
+			result.Termination = data
+		}
+	}
+	return result
+}
+
+// eorIncludeFailure returns an error naming the includes that could not be
+// fetched, or nil when all of them were.
+func eorIncludeFailure(inc *eorIncludes) error {
+	if inc == nil || len(inc.Errors) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(inc.Errors))
+	for name := range inc.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Errorf("could not fetch --include %s", strings.Join(names, ", "))
+}
+
+// withEORIncludes nests the fetched records into the contract's JSON object.
+// Each requested include is present even when empty; failed ones are listed
+// under include_errors instead.
+func withEORIncludes(contract any, include []string, inc *eorIncludes) (map[string]any, error) {
+	b, err := json.Marshal(contract)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	for _, name := range include {
+		if _, failed := inc.Errors[name]; failed {
+			continue
+		}
+		switch name {
+		case "amendments":
+			out["amendments"] = inc.Amendments
+		case "termination":
+			out["termination"] = inc.Termination
+		}
+	}
+	if len(inc.Errors) > 0 {
+		out["include_errors"] = inc.Errors
+	}
+	return out, nil
+}
+
+// printEORIncludes renders the fetched records after the contract details.
+func printEORIncludes(f *outfmt.Formatter, include []string, inc *eorIncludes) {
+	for _, name := range include {
+		if _, failed := inc.Errors[name]; failed {
+			continue
+		}
+		switch name {
+		case "amendments":
+			f.PrintText("")
+			if len(inc.Amendments) == 0 {
+				f.PrintText("Amendments:    none")
+				continue
+			}
+			f.PrintText("Amendments:")
+			table := f.NewTable("  ID", "TYPE", "STATUS", "EFFECTIVE")
+			for _, a := range inc.Amendments {
+				table.AddRow("  "+a.ID, a.Type, a.Status, a.EffectiveDate)
+			}
+			table.Render()
+		case "termination":
+			f.PrintText("")
+			t := inc.Termination
+			if t == nil {
+				f.PrintText("Termination:   none")
+				continue
+			}
+			f.PrintText("Termination:")
+			f.PrintText("  Type:             " + t.Type)
+			f.PrintText("  Status:           " + t.Status)
+			f.PrintText("  Effective Date:   " + t.EffectiveDate)
+			if t.LastWorkingDay != "" {
+				f.PrintText("  Last Working Day: " + t.LastWorkingDay)
+			}
+			if t.Reason != "" {
+				f.PrintText("  Reason:           " + t.Reason)
+			}
+		}
+	}
+}
+
+// warnEORIncludeErrors reports each include that could not be fetched.
+func warnEORIncludeErrors(f *outfmt.Formatter, inc *eorIncludes) {
+	names := make([]string, 0, len(inc.Errors))
+	for name := range inc.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		f.PrintWarning("could not fetch %s: %s", name, inc.Errors[name])
+	}
+}
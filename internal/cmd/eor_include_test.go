@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+)
+
+func TestParseEORIncludes(t *testing.T) {
+	include, err := parseEORIncludes([]string{"Amendments, termination", "amendments"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"amendments", "termination"}, include)
+
+	_, err = parseEORIncludes([]string{"payslips"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown --include value "payslips"`)
+}
+
+func TestFetchEORIncludes_Both(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.HandleJSON(http.MethodGet, "/rest/v2/eor/contracts/eor-1/amendments", http.StatusOK, map[string]any{
+		"data": []map[string]any{{"id": "am-1", "type": "salary", "status": "pending", "effective_date": "2026-11-01"}},
+	})
+	server.HandleJSON(http.MethodGet, "/rest/v2/eor/eor-1/terminations/", http.StatusOK, map[string]any{
+		"data": map[string]any{"id": "term-1", "type": "resignation", "status": "requested"},
+	})
+
+	inc := fetchEORIncludes(context.Background(), newEORTestClient(server), "eor-1", []string{"amendments", "termination"})
+	assert.Empty(t, inc.Errors)
+	require.Len(t, inc.Amendments, 1)
+	assert.Equal(t, "am-1", inc.Amendments[0].ID)
+	require.NotNil(t, inc.Termination)
+	assert.Equal(t, "term-1", inc.Termination.ID)
+}
+
+func TestFetchEORIncludes_NoTerminationIsNotAnError(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.HandleError(http.MethodGet, "/rest/v2/eor/eor-1/terminations/", http.StatusNotFound, "not found")
+
+	inc := fetchEORIncludes(context.Background(), newEORTestClient(server), "eor-1", []string{"termination"})
+	assert.Empty(t, inc.Errors)
+	assert.Nil(t, inc.Termination)
+}
+
+func TestFetchEORIncludes_TerminationFailureKeepsAmendments(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.HandleJSON(http.MethodGet, "/rest/v2/eor/contracts/eor-1/amendments", http.StatusOK, map[string]any{
+		"data": []map[string]any{{"id": "am-1"}},
+	})
+	server.HandleError(http.MethodGet, "/rest/v2/eor/eor-1/terminations/", http.StatusForbidden, "forbidden")
+
+	include := []string{"amendments", "termination"}
+	inc := fetchEORIncludes(context.Background(), newEORTestClient(server), "eor-1", include)
+	require.Len(t, inc.Amendments, 1)
+	assert.Contains(t, inc.Errors, "termination")
+	assert.NotContains(t, inc.Errors, "amendments")
+
+	out, err := withEORIncludes(&api.EORContract{ID: "eor-1"}, include, inc)
+	require.NoError(t, err)
+	assert.Contains(t, out, "amendments")
+	assert.NotContains(t, out, "termination")
+	assert.Contains(t, out["include_errors"], "termination")
+
+	failure := eorIncludeFailure(inc)
+	require.Error(t, failure)
+	assert.Equal(t, "could not fetch --include termination", failure.Error())
+	assert.NoError(t, eorIncludeFailure(&eorIncludes{}))
+}
+
+func TestWithEORIncludes_NestedShape(t *testing.T) {
+	inc := &eorIncludes{
+		Amendments:  []api.EORAmendment{{ID: "am-1", Status: "pending"}},
+		Termination: nil,
+	}
+	out, err := withEORIncludes(&api.EORContract{ID: "eor-1", Title: "Engineer"}, []string{"amendments", "termination"}, inc)
+	require.NoError(t, err)
+
+	b, err := json.Marshal(out)
+	require.NoError(t, err)
+	var got struct {
+		ID         string `json:"id"`
+		Title      string `json:"title"`
+		Amendments []struct {
+			ID     string `json:"id"`
+			Status string `json:"status"`
+		} `json:"amendments"`
+		Termination   *json.RawMessage `json:"termination"`
+		IncludeErrors map[string]any   `json:"include_errors"`
+	}
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, "eor-1", got.ID)
+	assert.Equal(t, "Engineer", got.Title)
+	require.Len(t, got.Amendments, 1)
+	assert.Equal(t, "am-1", got.Amendments[0].ID)
+	assert.Contains(t, out, "termination")
+	assert.Nil(t, got.Termination)
+	assert.Nil(t, got.IncludeErrors)
+}
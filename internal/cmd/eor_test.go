@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+)
+
+func newEORTestClient(server *testutil.MockServer) *api.Client {
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	return client
+}
+
+var (
+	testEORWorkerParams = api.CreateEORWorkerParams{
+		Email:     "jane@example.com",
+		FirstName: "Jane",
+		LastName:  "van Doe",
+		Country:   "GB",
+	}
+	testEORContractParams = api.CreateEORContractParams{
+		Title:       "Engineer",
+		WorkerEmail: "jane@example.com",
+		WorkerName:  "Jane van Doe",
+		Country:     "GB",
+	}
+)
+
+func TestSplitWorkerName(t *testing.T) {
+	first, last, err := splitWorkerName("  Jane  van Doe ")
+	require.NoError(t, err)
+	assert.Equal(t, "Jane", first)
+	assert.Equal(t, "van Doe", last)
+
+	_, _, err = splitWorkerName("Jane")
+	assert.Error(t, err)
+}
+
+func TestCreateEORContractWithWorker_CreatesMissingWorker(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+
+	var calls []string
+	server.Handle("GET", "/rest/v2/people/search", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "search")
+		w.WriteHeader(http.StatusNotFound)
+	})
+	server.Handle("POST", "/rest/v2/eor/workers", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "worker")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"w-1","email":"jane@example.com"}}`))
+	})
+	server.Handle("POST", "/rest/v2/eor/contracts", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "contract")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"c-1","title":"Engineer"}}`))
+	})
+
+	result, err := createEORContractWithWorker(context.Background(), newEORTestClient(server), testEORWorkerParams, testEORContractParams)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"search", "worker", "contract"}, calls)
+	assert.True(t, result.WorkerCreated)
+	assert.Equal(t, "w-1", result.Worker.ID)
+	assert.Equal(t, "c-1", result.Contract.ID)
+}
+
+func TestCreateEORContractWithWorker_SkipsExistingWorker(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+
+	server.HandleJSON("GET", "/rest/v2/people/search", http.StatusOK, map[string]any{
+		"data": map[string]any{"id": "p-1", "hris_profile_id": "hris-1", "email": "jane@example.com"},
+	})
+	workerCreated := false
+	server.Handle("POST", "/rest/v2/eor/workers", func(w http.ResponseWriter, r *http.Request) {
+		workerCreated = true
+		w.WriteHeader(http.StatusCreated)
+	})
+	server.HandleJSON("POST", "/rest/v2/eor/contracts", http.StatusCreated, map[string]any{
+		"data": map[string]any{"id": "c-1"},
+	})
+
+	result, err := createEORContractWithWorker(context.Background(), newEORTestClient(server), testEORWorkerParams, testEORContractParams)
+	require.NoError(t, err)
+	assert.False(t, workerCreated)
+	assert.False(t, result.WorkerCreated)
+	assert.Nil(t, result.Worker)
+	assert.Equal(t, "hris-1", result.ExistingWorkerID)
+	assert.Equal(t, "c-1", result.Contract.ID)
+}
+
+func TestCreateEORContractWithWorker_ContractFailureReportsWorker(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+
+	server.HandleError("GET", "/rest/v2/people/search", http.StatusNotFound, "not found")
+	server.HandleJSON("POST", "/rest/v2/eor/workers", http.StatusCreated, map[string]any{
+		"data": map[string]any{"id": "w-1"},
+	})
+	server.HandleError("POST", "/rest/v2/eor/contracts", http.StatusBadRequest, "invalid salary")
+
+	result, err := createEORContractWithWorker(context.Background(), newEORTestClient(server), testEORWorkerParams, testEORContractParams)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "worker w-1 was created")
+	require.NotNil(t, result)
+	assert.True(t, result.WorkerCreated)
+}
+
+func TestEORCreatePreview_AutoWorkerListsBothSteps(t *testing.T) {
+	eorCreateWorkerEmailFlag = "jane@example.com"
+	t.Cleanup(func() { eorCreateWorkerEmailFlag = "" })
+
+	worker := testEORWorkerParams
+	worker.DateOfBirth = "1990-05-15"
+	preview := eorCreatePreview(90000, true, worker)
+
+	var buf bytes.Buffer
+	require.NoError(t, preview.Write(&buf))
+	out := buf.String()
+	assert.Contains(t, out, "Create EOR worker (if missing), then EOR contract")
+	assert.Contains(t, out, "1) create EOR worker Jane van Doe <jane@example.com> unless one already exists; 2) create EOR contract")
+	assert.Contains(t, out, "1990-05-15")
+
+	plain := eorCreatePreview(90000, false, api.CreateEORWorkerParams{})
+	assert.Equal(t, "Create EOR contract", plain.Description)
+	assert.NotContains(t, plain.Details, "Steps")
+}
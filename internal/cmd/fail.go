@@ -32,6 +32,7 @@ func fail(cmd *cobra.Command, f *outfmt.Formatter, operation, category, message
 				"operation":   operation,
 				"category":    category,
 				"message":     message,
+				"retryable":   false,
 				"suggestions": suggestions,
 			},
 		})
@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -25,6 +24,7 @@ var (
 	gpCreateJobTitleFlag     string
 	gpCreateSalaryFlag       string
 	gpCreateCurrencyFlag     string
+	gpCreateSkipCurrencyFlag bool
 	gpCreatePayFrequencyFlag string
 )
 
@@ -62,9 +62,13 @@ var gpCreateCmd = &cobra.Command{
 		}
 
 		// Parse salary
-		salary, err := strconv.ParseFloat(gpCreateSalaryFlag, 64)
+		salary, err := parsePositiveAmount(gpCreateSalaryFlag, "--salary")
 		if err != nil {
-			return failValidation(cmd, f, fmt.Sprintf("Invalid --salary value: %v", err))
+			return failValidation(cmd, f, err.Error())
+		}
+
+		if err := checkCurrency(cmd.Context(), f, getClient, gpCreateCurrencyFlag, gpCreateSkipCurrencyFlag); err != nil {
+			return failValidation(cmd, f, err.Error())
 		}
 
 		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
@@ -151,7 +155,7 @@ var gpBankAccountsListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		accounts, err := client.ListGPBankAccounts(cmd.Context(), gpBankAccountsListWorkerIDFlag)
+		accounts, err := client.ListGPBankAccounts(api.WithServerFields(cmd.Context()), gpBankAccountsListWorkerIDFlag)
 		if err != nil {
 			return HandleError(f, err, "list bank accounts")
 		}
@@ -275,19 +279,34 @@ var gpReportsCmd = &cobra.Command{
 
 // Flags for reports g2n command
 var (
-	gpReportsG2NWorkerIDFlag string
-	gpReportsG2NPeriodFlag   string
+	gpReportsG2NWorkerIDFlag    string
+	gpReportsG2NPeriodFlag      string
+	gpReportsG2NAllWorkersFlag  bool
+	gpReportsG2NConcurrencyFlag int
 )
 
 var gpReportsG2NCmd = &cobra.Command{
 	Use:   "g2n",
 	Short: "List gross-to-net reports",
-	Long:  "List gross-to-net reports for Global Payroll workers. Requires --worker-id flag. Optional --period flag.",
+	Long: `List gross-to-net reports for Global Payroll workers. Requires --worker-id
+or --all-workers. Optional --period flag.
+
+--all-workers fetches every GP worker's reports (--concurrency at a time) and
+adds a total per period and currency. A worker whose reports cannot be fetched
+is listed with the error; the others are still shown.`,
+	Example: `  deel gp reports g2n --worker-id w-123 --period 2026-09
+  deel gp reports g2n --all-workers --period 2026-09 --json`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 
+		if gpReportsG2NAllWorkersFlag {
+			if gpReportsG2NWorkerIDFlag != "" {
+				return failValidation(cmd, f, "--worker-id cannot be used with --all-workers")
+			}
+			return runG2NAllWorkers(cmd)
+		}
 		if gpReportsG2NWorkerIDFlag == "" {
-			return failValidation(cmd, f, "--worker-id flag is required")
+			return failValidation(cmd, f, "--worker-id or --all-workers is required")
 		}
 
 		client, err := getClient()
@@ -498,7 +517,7 @@ var gpRatesListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		rates, err := client.ListGPShiftRates(cmd.Context())
+		rates, err := client.ListGPShiftRates(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "list shift rates")
 		}
@@ -558,9 +577,9 @@ var gpRatesCreateCmd = &cobra.Command{
 		}
 
 		// Parse rate
-		rate, err := strconv.ParseFloat(gpRatesCreateRateFlag, 64)
+		rate, err := parsePositiveAmount(gpRatesCreateRateFlag, "--rate")
 		if err != nil {
-			return failValidation(cmd, f, fmt.Sprintf("Invalid --rate value: %v", err))
+			return failValidation(cmd, f, err.Error())
 		}
 
 		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
@@ -615,6 +634,7 @@ func init() {
 	gpCreateCmd.Flags().StringVar(&gpCreateJobTitleFlag, "job-title", "", "Job title (required)")
 	gpCreateCmd.Flags().StringVar(&gpCreateSalaryFlag, "salary", "", "Annual salary (required)")
 	gpCreateCmd.Flags().StringVar(&gpCreateCurrencyFlag, "currency", "", "Currency code (required)")
+	gpCreateCmd.Flags().BoolVar(&gpCreateSkipCurrencyFlag, "skip-currency-check", false, "Skip checking --currency against the supported currency list (e.g. offline)")
 	gpCreateCmd.Flags().StringVar(&gpCreatePayFrequencyFlag, "pay-frequency", "", "Pay frequency (required)")
 
 	// Bank accounts list command flags
@@ -629,8 +649,10 @@ func init() {
 	gpBankAccountsAddCmd.Flags().StringVar(&gpBankAccountAddCurrencyFlag, "currency", "", "Currency code (required)")
 
 	// Reports g2n command flags
-	gpReportsG2NCmd.Flags().StringVar(&gpReportsG2NWorkerIDFlag, "worker-id", "", "Worker ID (required)")
+	gpReportsG2NCmd.Flags().StringVar(&gpReportsG2NWorkerIDFlag, "worker-id", "", "Worker ID (required unless --all-workers)")
 	gpReportsG2NCmd.Flags().StringVar(&gpReportsG2NPeriodFlag, "period", "", "Period (optional)")
+	gpReportsG2NCmd.Flags().BoolVar(&gpReportsG2NAllWorkersFlag, "all-workers", false, "Fetch reports for every GP worker and total them")
+	gpReportsG2NCmd.Flags().IntVar(&gpReportsG2NConcurrencyFlag, "concurrency", 4, fmt.Sprintf("Workers to fetch in parallel with --all-workers (max %d)", maxBulkConcurrency))
 
 	// Terminate command flags
 	gpTerminateCmd.Flags().StringVar(&gpTerminateWorkerIDFlag, "worker-id", "", "Worker ID (required)")
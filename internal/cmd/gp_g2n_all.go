@@ -0,0 +1,234 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/batch"
+)
+
+// gpWorkersPageSize is the page size used when resolving every GP worker.
+const gpWorkersPageSize = 100
+
+// workerG2N is one worker's entry in g2n --all-workers output. A worker whose
+// fetch failed keeps its entry with Error set.
+type workerG2N struct {
+	WorkerID   string          `json:"worker_id"`
+	WorkerName string          `json:"worker_name"`
+	Reports    []api.G2NReport `json:"reports"`
+	OK         bool            `json:"ok"`
+	Error      string          `json:"error,omitempty"`
+}
+
+// g2nTotal sums the reports of one period and currency.
+type g2nTotal struct {
+	Period     string  `json:"period"`
+	Currency   string  `json:"currency"`
+	Workers    int     `json:"workers"`
+	Gross      float64 `json:"gross_amount"`
+	Net        float64 `json:"net_amount"`
+	Deductions float64 `json:"deductions"`
+	Taxes      float64 `json:"taxes"`
+}
+
+// g2nAllWorkersOutput is the JSON shape of g2n --all-workers.
+type g2nAllWorkersOutput struct {
+	Period  string      `json:"period,omitempty"`
+	Workers []workerG2N `json:"workers"`
+	Totals  []g2nTotal  `json:"totals"`
+}
+
+// runG2NAllWorkers fetches the gross-to-net reports of every GP worker and
+// prints them as one table with per-period totals.
+func runG2NAllWorkers(cmd *cobra.Command) error {
+	f := getFormatter()
+	if gpReportsG2NConcurrencyFlag < 1 || gpReportsG2NConcurrencyFlag > maxBulkConcurrency {
+		return failValidation(cmd, f, fmt.Sprintf("--concurrency must be between 1 and %d", maxBulkConcurrency))
+	}
+
+	client, err := getClient()
+	if err != nil {
+		return HandleError(f, err, "initializing client")
+	}
+
+	workers, _, _, err := collectCursorItems(cmd.Context(), true, "", gpWorkersPageSize, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.GPContract], error) {
+		resp, err := client.ListGPContracts(ctx, api.GPContractsListParams{Limit: limit, Cursor: cursor})
+		if err != nil {
+			return CursorListResult[api.GPContract]{}, err
+		}
+		return CursorListResult[api.GPContract]{
+			Items: resp.Data,
+			Page:  CursorPage{Next: resp.Page.Next, Total: resp.Page.Total},
+		}, nil
+	})
+	if err != nil {
+		return HandleError(f, err, "list GP contracts")
+	}
+
+	results := fetchWorkersG2N(cmd.Context(), client, gpContractWorkers(workers), gpReportsG2NPeriodFlag, gpReportsG2NConcurrencyFlag)
+	out := g2nAllWorkersOutput{
+		Period:  gpReportsG2NPeriodFlag,
+		Workers: results,
+		Totals:  g2nTotals(results),
+	}
+
+	failed := 0
+	for _, r := range results {
+		if !r.OK {
+			failed++
+		}
+	}
+	var failure error
+	if failed > 0 {
+		failure = fmt.Errorf("gross-to-net reports for %d of %d workers could not be fetched", failed, len(results))
+	}
+
+	return outputBatchResults(cmd.Context(), f, func() {
+		if len(results) == 0 {
+			f.PrintText("No GP workers found")
+			return
+		}
+		table := f.NewTable("WORKER", "PERIOD", "GROSS", "NET", "DEDUCTIONS", "TAXES", "STATUS", "ERROR")
+		for _, row := range g2nRows(results) {
+			table.AddRow(row...)
+		}
+		for _, t := range out.Totals {
+			table.AddRow(
+				fmt.Sprintf("TOTAL (%d workers)", t.Workers),
+				t.Period,
+				fmt.Sprintf("%.2f %s", t.Gross, t.Currency),
+				fmt.Sprintf("%.2f %s", t.Net, t.Currency),
+				fmt.Sprintf("%.2f", t.Deductions),
+				fmt.Sprintf("%.2f", t.Taxes),
+				"",
+				"",
+			)
+		}
+		table.Render()
+	}, out, failure)
+}
+
+// gpContractWorkers returns one worker per distinct worker ID, in contract
+// order. Contracts without a worker ID are skipped.
+func gpContractWorkers(contracts []api.GPContract) []workerG2N {
+	seen := map[string]bool{}
+	var workers []workerG2N
+	for _, c := range contracts {
+		if c.WorkerID == "" || seen[c.WorkerID] {
+			continue
+		}
+		seen[c.WorkerID] = true
+		workers = append(workers, workerG2N{WorkerID: c.WorkerID, WorkerName: c.WorkerName})
+	}
+	return workers
+}
+
+// fetchWorkersG2N fetches each worker's gross-to-net reports with at most
+// concurrency requests in flight. Results keep worker order.
+func fetchWorkersG2N(ctx context.Context, client *api.Client, workers []workerG2N, period string, concurrency int) []workerG2N {
+	out := append([]workerG2N(nil), workers...)
+
+	runs := batch.Run(ctx, len(out), concurrency, func(ctx context.Context, i int) (any, error) {
+		return client.ListG2NReports(ctx, api.ListG2NReportsParams{WorkerID: out[i].WorkerID, Period: period})
+	})
+
+	for _, r := range runs {
+		if r.Error != nil {
+			out[r.Index].Error = r.Error.Error()
+			continue
+		}
+		reports := r.Data.([]api.G2NReport)
+		if reports == nil {
+			reports = []api.G2NReport{}
+		}
+		out[r.Index].Reports = reports
+		out[r.Index].OK = true
+	}
+	return out
+}
+
+// g2nTotals sums the fetched reports by period and currency, sorted by
+// period then currency. Failed workers contribute nothing.
+func g2nTotals(results []workerG2N) []g2nTotal {
+	type key struct{ period, currency string }
+	totals := map[key]*g2nTotal{}
+	counted := map[key]map[string]bool{}
+	for _, r := range results {
+		for _, rep := range r.Reports {
+			k := key{rep.Period, rep.Currency}
+			t, ok := totals[k]
+			if !ok {
+				t = &g2nTotal{Period: rep.Period, Currency: rep.Currency}
+				totals[k] = t
+				counted[k] = map[string]bool{}
+			}
+			if !counted[k][r.WorkerID] {
+				counted[k][r.WorkerID] = true
+				t.Workers++
+			}
+			t.Gross += rep.GrossAmount
+			t.Net += rep.NetAmount
+			t.Deductions += rep.Deductions
+			t.Taxes += rep.Taxes
+		}
+	}
+
+	out := make([]g2nTotal, 0, len(totals))
+	for _, t := range totals {
+		t.Gross = roundCents(t.Gross)
+		t.Net = roundCents(t.Net)
+		t.Deductions = roundCents(t.Deductions)
+		t.Taxes = roundCents(t.Taxes)
+		out = append(out, *t)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Period != out[j].Period {
+			return out[i].Period < out[j].Period
+		}
+		return out[i].Currency < out[j].Currency
+	})
+	return out
+}
+
+// g2nRows lists one row per report. A worker whose fetch failed gets a single
+// row carrying the error; a worker with no reports gets a row of dashes.
+func g2nRows(results []workerG2N) [][]string {
+	var rows [][]string
+	for _, r := range results {
+		worker := r.WorkerName
+		if worker == "" {
+			worker = r.WorkerID
+		}
+		if r.Error != "" {
+			rows = append(rows, []string{worker, "-", "-", "-", "-", "-", "-", r.Error})
+			continue
+		}
+		if len(r.Reports) == 0 {
+			rows = append(rows, []string{worker, "-", "-", "-", "-", "-", "-", ""})
+			continue
+		}
+		for _, rep := range r.Reports {
+			rows = append(rows, []string{
+				worker,
+				rep.Period,
+				fmt.Sprintf("%.2f %s", rep.GrossAmount, rep.Currency),
+				fmt.Sprintf("%.2f %s", rep.NetAmount, rep.Currency),
+				fmt.Sprintf("%.2f", rep.Deductions),
+				fmt.Sprintf("%.2f", rep.Taxes),
+				rep.Status,
+				"",
+			})
+		}
+	}
+	return rows
+}
+
+// roundCents rounds v to two decimals so summed floats print cleanly.
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+)
+
+// g2nServer serves one gross-to-net report per worker ID in reports and a 403
+// for any other worker.
+func g2nServer(t *testing.T, reports map[string]map[string]any) *testutil.MockServer {
+	t.Helper()
+	server := testutil.NewMockServer()
+	server.Handle("GET", "/rest/v2/gp/reports/gross-to-net", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2026-09", r.URL.Query().Get("period"))
+		w.Header().Set("Content-Type", "application/json")
+		report, ok := reports[r.URL.Query().Get("worker_id")]
+		if !ok {
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "no access to worker"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]any{report}})
+	})
+	return server
+}
+
+func TestFetchWorkersG2N_TwoWorkerTotal(t *testing.T) {
+	server := g2nServer(t, map[string]map[string]any{
+		"w1": {"id": "r1", "worker_id": "w1", "period": "2026-09", "gross_amount": 5000.10, "net_amount": 3800.05, "deductions": 200, "taxes": 1000.05, "currency": "GBP", "status": "final"},
+		"w2": {"id": "r2", "worker_id": "w2", "period": "2026-09", "gross_amount": 4000.20, "net_amount": 3000.10, "deductions": 150, "taxes": 850.10, "currency": "GBP", "status": "final"},
+	})
+	defer server.Close()
+
+	workers := gpContractWorkers([]api.GPContract{
+		{ID: "gp-1", WorkerID: "w1", WorkerName: "Ann Lee"},
+		{ID: "gp-2", WorkerID: "w2", WorkerName: "Bo Kim"},
+		{ID: "gp-3", WorkerID: "w1", WorkerName: "Ann Lee"},
+	})
+	require.Len(t, workers, 2)
+
+	results := fetchWorkersG2N(context.Background(), entitlementsClient(server), workers, "2026-09", 2)
+	require.Len(t, results, 2)
+	assert.True(t, results[0].OK)
+	assert.True(t, results[1].OK)
+	assert.Equal(t, "r1", results[0].Reports[0].ID)
+	assert.Equal(t, "r2", results[1].Reports[0].ID)
+
+	assert.Equal(t, []g2nTotal{{
+		Period:     "2026-09",
+		Currency:   "GBP",
+		Workers:    2,
+		Gross:      9000.3,
+		Net:        6800.15,
+		Deductions: 350,
+		Taxes:      1850.15,
+	}}, g2nTotals(results))
+}
+
+func TestFetchWorkersG2N_AnnotatesWorkerFailure(t *testing.T) {
+	server := g2nServer(t, map[string]map[string]any{
+		"w1": {"id": "r1", "worker_id": "w1", "period": "2026-09", "gross_amount": 5000, "net_amount": 3800, "currency": "GBP", "status": "final"},
+	})
+	defer server.Close()
+
+	workers := []workerG2N{{WorkerID: "w1", WorkerName: "Ann Lee"}, {WorkerID: "w2", WorkerName: "Bo Kim"}}
+	results := fetchWorkersG2N(context.Background(), entitlementsClient(server), workers, "2026-09", 2)
+	require.Len(t, results, 2)
+	assert.True(t, results[0].OK)
+	assert.False(t, results[1].OK)
+	assert.Contains(t, results[1].Error, "no access to worker")
+
+	totals := g2nTotals(results)
+	require.Len(t, totals, 1)
+	assert.Equal(t, 1, totals[0].Workers)
+	assert.Equal(t, 5000.0, totals[0].Gross)
+
+	rows := g2nRows(results)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "", rows[0][7])
+	assert.Equal(t, "Bo Kim", rows[1][0])
+	assert.Contains(t, rows[1][7], "no access to worker")
+}
+
+func TestG2NTotals_SplitsPeriodsAndCurrencies(t *testing.T) {
+	results := []workerG2N{
+		{WorkerID: "w1", OK: true, Reports: []api.G2NReport{
+			{Period: "2026-09", Currency: "EUR", GrossAmount: 100},
+			{Period: "2026-08", Currency: "EUR", GrossAmount: 90},
+		}},
+		{WorkerID: "w2", OK: true, Reports: []api.G2NReport{
+			{Period: "2026-09", Currency: "USD", GrossAmount: 50},
+		}},
+		{WorkerID: "w3", Error: "boom"},
+	}
+	totals := g2nTotals(results)
+	require.Len(t, totals, 3)
+	assert.Equal(t, "2026-08", totals[0].Period)
+	assert.Equal(t, "EUR", totals[1].Currency)
+	assert.Equal(t, "USD", totals[2].Currency)
+	assert.Equal(t, 1, totals[2].Workers)
+}
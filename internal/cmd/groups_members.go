@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/batch"
+	"github.com/salmonumbrella/deel-cli/internal/dryrun"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+var groupsMembersCmd = &cobra.Command{
+	Use:   "members <group-id>",
+	Short: "List the members of a group",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+		client, err := getClient()
+		if err != nil {
+			return HandleError(f, err, "initializing client")
+		}
+
+		members, err := client.ListGroupMembers(api.WithServerFields(cmd.Context()), args[0])
+		if err != nil {
+			return HandleError(f, err, "list group members")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			if len(members) == 0 {
+				f.PrintText("No members found.")
+				return
+			}
+			table := f.NewTable("ID", "PROFILE ID", "NAME", "EMAIL")
+			for _, m := range members {
+				table.AddRow(m.ID, m.HRISProfileID, m.Name, m.Email)
+			}
+			table.Render()
+		}, members)
+	},
+}
+
+var groupsAddMemberCmd = &cobra.Command{
+	Use:   "add-member <group-id> <profile-id>...",
+	Short: "Add people to a group",
+	Long: `Add one or more people, by HRIS profile ID, to a group.
+
+Every profile is attempted and the output lists each one with ok and any
+error; the command exits non-zero if any could not be added.`,
+	Example: "  deel org groups add-member grp-123 hris-1 hris-2 hris-3",
+	Args:    cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runGroupMemberCommand(cmd, args, groupMemberAdd)
+	},
+}
+
+var groupsRemoveMemberCmd = &cobra.Command{
+	Use:   "remove-member <group-id> <profile-id>...",
+	Short: "Remove people from a group",
+	Long: `Remove one or more people, by HRIS profile ID, from a group.
+
+Every profile is attempted and the output lists each one with ok and any
+error; the command exits non-zero if any could not be removed.`,
+	Example: "  deel org groups remove-member grp-123 hris-1 hris-2",
+	Args:    cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runGroupMemberCommand(cmd, args, groupMemberRemove)
+	},
+}
+
+// groupMemberAction is the membership change made by add-member or
+// remove-member.
+type groupMemberAction struct {
+	operation   string // dry-run operation
+	description string
+	verb        string // past tense, for the summary line
+	failure     string // infinitive, for the error
+	apply       func(ctx context.Context, client *api.Client, groupID, profileID string) error
+}
+
+var (
+	groupMemberAdd = groupMemberAction{
+		operation:   "ADD",
+		description: "Add group members",
+		verb:        "added",
+		failure:     "add",
+		apply: func(ctx context.Context, client *api.Client, groupID, profileID string) error {
+			return client.AddGroupMember(ctx, groupID, profileID)
+		},
+	}
+	groupMemberRemove = groupMemberAction{
+		operation:   "REMOVE",
+		description: "Remove group members",
+		verb:        "removed",
+		failure:     "remove",
+		apply: func(ctx context.Context, client *api.Client, groupID, profileID string) error {
+			return client.RemoveGroupMember(ctx, groupID, profileID)
+		},
+	}
+)
+
+// groupMemberResult is the per-profile outcome of add-member or remove-member.
+type groupMemberResult struct {
+	ProfileID string `json:"profile_id"`
+	OK        bool   `json:"ok"`
+	Error     string `json:"error,omitempty"`
+}
+
+func runGroupMemberCommand(cmd *cobra.Command, args []string, action groupMemberAction) error {
+	f := getFormatter()
+	groupID, profileIDs := args[0], args[1:]
+
+	if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
+		Operation:   action.operation,
+		Resource:    "GroupMember",
+		Description: action.description,
+		Details: map[string]string{
+			"Group":    groupID,
+			"Profiles": strings.Join(profileIDs, ", "),
+		},
+	}); ok {
+		return err
+	}
+
+	client, err := getClient()
+	if err != nil {
+		return HandleError(f, err, "initializing client")
+	}
+	return outputGroupMemberChanges(cmd.Context(), f, client, groupID, profileIDs, action)
+}
+
+// changeGroupMembers applies action to each profile in turn. Results are in
+// input order and a failure never stops the others.
+func changeGroupMembers(ctx context.Context, client *api.Client, groupID string, profileIDs []string, action groupMemberAction) ([]groupMemberResult, batch.Summary) {
+	results := make([]groupMemberResult, len(profileIDs))
+	runs := batch.Run(ctx, len(profileIDs), 1, func(ctx context.Context, i int) (any, error) {
+		ctx = api.WithIdempotencyPart(ctx, "member-"+profileIDs[i])
+		return nil, action.apply(ctx, client, groupID, profileIDs[i])
+	})
+
+	summary := batch.Summary{Total: len(profileIDs)}
+	for _, r := range runs {
+		res := &results[r.Index]
+		res.ProfileID = profileIDs[r.Index]
+		if r.Error != nil {
+			res.Error = r.Error.Error()
+			summary.Failed++
+			continue
+		}
+		res.OK = true
+		summary.Succeeded++
+	}
+	return results, summary
+}
+
+func outputGroupMemberChanges(ctx context.Context, f *outfmt.Formatter, client *api.Client, groupID string, profileIDs []string, action groupMemberAction) error {
+	results, summary := changeGroupMembers(ctx, client, groupID, profileIDs, action)
+
+	var failure error
+	if summary.Failed > 0 {
+		failure = fmt.Errorf("could not %s %d of %d group members", action.failure, summary.Failed, summary.Total)
+	}
+	return outputBatchResults(ctx, f, func() {
+		table := f.NewTable("PROFILE ID", "STATUS", "ERROR")
+		for _, r := range results {
+			status := action.verb
+			if !r.OK {
+				status = "failed"
+			}
+			table.AddRow(r.ProfileID, status, r.Error)
+		}
+		table.Render()
+		f.PrintText("")
+		f.PrintText(fmt.Sprintf("%d profiles: %d %s, %d failed", summary.Total, summary.Succeeded, action.verb, summary.Failed))
+	}, map[string]any{
+		"group_id": groupID,
+		"summary":  batchSummaryJSON(summary),
+		"results":  results,
+	}, failure)
+}
+
+func init() {
+	groupsCmd.AddCommand(groupsMembersCmd)
+	groupsCmd.AddCommand(groupsAddMemberCmd)
+	groupsCmd.AddCommand(groupsRemoveMemberCmd)
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+func TestChangeGroupMembers_AddsEachProfile(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	var added []string
+	server.Handle(http.MethodPost, "/rest/v2/groups/grp-1/members", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		added = append(added, body["hris_profile_id"])
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+
+	results, summary := changeGroupMembers(context.Background(), client, "grp-1", []string{"hris-1", "hris-2"}, groupMemberAdd)
+	assert.Equal(t, []string{"hris-1", "hris-2"}, added)
+	assert.Equal(t, 2, summary.Succeeded)
+	assert.Equal(t, []groupMemberResult{{ProfileID: "hris-1", OK: true}, {ProfileID: "hris-2", OK: true}}, results)
+}
+
+func TestOutputGroupMemberChanges_PartialFailure(t *testing.T) {
+	resetAgentErrorEmitted()
+	defer resetAgentErrorEmitted()
+
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.HandleJSON(http.MethodDelete, "/rest/v2/groups/grp-1/members/hris-1", http.StatusNoContent, nil)
+	server.HandleError(http.MethodDelete, "/rest/v2/groups/grp-1/members/hris-2", http.StatusNotFound, "not a member")
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	client.SetRetryConfig(0, 0, 0)
+
+	var out, errOut bytes.Buffer
+	f := outfmt.New(&out, &errOut, outfmt.FormatJSON, "never")
+	f.SetRaw(true)
+
+	err := outputGroupMemberChanges(context.Background(), f, client, "grp-1", []string{"hris-1", "hris-2"}, groupMemberRemove)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not remove 1 of 2 group members")
+
+	var payload struct {
+		GroupID string              `json:"group_id"`
+		Summary map[string]int      `json:"summary"`
+		Results []groupMemberResult `json:"results"`
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &payload))
+	assert.Equal(t, "grp-1", payload.GroupID)
+	assert.Equal(t, map[string]int{"total": 2, "succeeded": 1, "failed": 1}, payload.Summary)
+	require.Len(t, payload.Results, 2)
+	assert.True(t, payload.Results[0].OK)
+	assert.False(t, payload.Results[1].OK)
+	assert.Contains(t, payload.Results[1].Error, "not a member")
+}
@@ -38,7 +38,7 @@ var immigrationCasesCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		caseDetails, err := client.GetImmigrationCaseDetails(cmd.Context(), args[0])
+		caseDetails, err := client.GetImmigrationCaseDetails(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "get case")
 		}
@@ -73,7 +73,7 @@ var immigrationDocsCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		docs, err := client.ListImmigrationDocs(cmd.Context(), immigrationCaseFlag)
+		docs, err := client.ListImmigrationDocs(api.WithServerFields(cmd.Context()), immigrationCaseFlag)
 		if err != nil {
 			return HandleError(f, err, "list documents")
 		}
@@ -107,7 +107,7 @@ var immigrationVisaTypesCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		types, err := client.ListVisaTypes(cmd.Context(), immigrationCountryFlag)
+		types, err := client.ListVisaTypes(api.WithServerFields(cmd.Context()), immigrationCountryFlag)
 		if err != nil {
 			return HandleError(f, err, "list visa types")
 		}
@@ -34,7 +34,7 @@ var invoicesListCmd = &cobra.Command{
 			return err
 		}
 
-		invoices, page, hasMore, err := collectCursorItems(cmd.Context(), invoicesAllFlag, invoicesCursorFlag, invoicesLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.Invoice], error) {
+		invoices, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), invoicesAllFlag, invoicesCursorFlag, invoicesLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.Invoice], error) {
 			resp, err := client.ListInvoices(ctx, api.InvoicesListParams{
 				Limit:      limit,
 				Cursor:     cursor,
@@ -76,7 +76,7 @@ var invoicesGetCmd = &cobra.Command{
 			return HandleError(f, err, "getting invoice")
 		}
 
-		invoice, err := client.GetInvoice(cmd.Context(), args[0])
+		invoice, err := client.GetInvoice(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "getting invoice")
 		}
@@ -165,7 +165,7 @@ Filter options (when listing all):
 			params.Types = []string{invoiceAdjustmentsTypeFlag}
 		}
 
-		adjustments, err := client.ListAllInvoiceAdjustments(cmd.Context(), params)
+		adjustments, err := client.ListAllInvoiceAdjustments(api.WithServerFields(cmd.Context()), params)
 		if err != nil {
 			return HandleError(f, err, "listing adjustments")
 		}
@@ -207,7 +207,7 @@ var invoicesAdjustmentsGetCmd = &cobra.Command{
 			return HandleError(f, err, "getting adjustment")
 		}
 
-		adjustment, err := client.GetInvoiceAdjustment(cmd.Context(), args[0])
+		adjustment, err := client.GetInvoiceAdjustment(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "getting adjustment")
 		}
@@ -450,8 +450,8 @@ var invoicesPDFCmd = &cobra.Command{
 		}
 
 		if outputPath == "-" {
-			if f.IsJSON() {
-				return fmt.Errorf("cannot write PDF bytes to stdout in --json mode (use --output <path>)")
+			if f.IsJSON() || f.IsYAML() {
+				return fmt.Errorf("cannot write PDF bytes to stdout in --json/--yaml mode (use --output <path>)")
 			}
 			if _, err := os.Stdout.Write(pdfBytes); err != nil {
 				return HandleError(f, err, "writing PDF to stdout")
@@ -490,7 +490,7 @@ var deelInvoicesCmd = &cobra.Command{
 			return err
 		}
 
-		invoices, page, hasMore, err := collectCursorItems(cmd.Context(), deelInvoicesAllFlag, deelInvoicesCursorFlag, deelInvoicesLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.DeelInvoice], error) {
+		invoices, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), deelInvoicesAllFlag, deelInvoicesCursorFlag, deelInvoicesLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.DeelInvoice], error) {
 			resp, err := client.ListDeelInvoices(ctx, api.DeelInvoicesListParams{
 				Limit:  limit,
 				Cursor: cursor,
@@ -521,6 +521,7 @@ var deelInvoicesCmd = &cobra.Command{
 }
 
 func init() {
+	enableWatch(invoicesListCmd)
 	invoicesListCmd.Flags().IntVar(&invoicesLimitFlag, "limit", 100, "Maximum results")
 	invoicesListCmd.Flags().StringVar(&invoicesCursorFlag, "cursor", "", "Pagination cursor")
 	invoicesListCmd.Flags().StringVar(&invoicesStatusFlag, "status", "", "Filter by status")
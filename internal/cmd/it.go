@@ -33,7 +33,7 @@ var itAssetsCmd = &cobra.Command{
 			return err
 		}
 
-		assets, page, hasMore, err := collectCursorItems(cmd.Context(), itAssetsAllFlag, itAssetsCursorFlag, itAssetsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ITAsset], error) {
+		assets, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), itAssetsAllFlag, itAssetsCursorFlag, itAssetsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.ITAsset], error) {
 			resp, err := client.ListITAssets(ctx, api.ITAssetsListParams{
 				Status: itAssetsStatusFlag,
 				Type:   itAssetsTypeFlag,
@@ -73,7 +73,7 @@ var itOrdersCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		orders, err := client.ListITOrders(cmd.Context(), itOrdersLimitFlag)
+		orders, err := client.ListITOrders(api.WithServerFields(cmd.Context()), itOrdersLimitFlag)
 		if err != nil {
 			return HandleError(f, err, "list orders")
 		}
@@ -103,7 +103,7 @@ var itPoliciesCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		policies, err := client.ListHardwarePolicies(cmd.Context())
+		policies, err := client.ListHardwarePolicies(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "list policies")
 		}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+// legalEntityCreateResult is the output of legal-entities create --from-entity.
+type legalEntityCreateResult struct {
+	Entity               *api.LegalEntity     `json:"entity"`
+	SourceEntityID       string               `json:"source_entity_id"`
+	PayrollSettings      *api.PayrollSettings `json:"payroll_settings,omitempty"`
+	PayrollCopied        bool                 `json:"payroll_settings_copied"`
+	PayrollSettingsError string               `json:"payroll_settings_error,omitempty"`
+}
+
+// applyLegalEntityDefaults fills fields the caller left empty from source.
+// Name and registration number are never copied; they identify the entity.
+func applyLegalEntityDefaults(params *api.CreateLegalEntityParams, source *api.LegalEntity) {
+	if params.Country == "" {
+		params.Country = source.Country
+	}
+	if params.Type == "" {
+		params.Type = source.Type
+	}
+}
+
+// payrollSettingsCopy returns the transferable part of settings. Tax ID and
+// bank account belong to the source entity and are left behind. The payroll
+// currency is set by the caller (see payrollCurrency).
+func payrollSettingsCopy(settings *api.PayrollSettings, currency string) api.UpdatePayrollSettingsParams {
+	return api.UpdatePayrollSettingsParams{
+		PayrollFrequency:  settings.PayrollFrequency,
+		PaymentMethod:     settings.PaymentMethod,
+		Currency:          currency,
+		PayrollProvider:   settings.PayrollProvider,
+		AutoApproval:      settings.AutoApproval,
+		NotificationEmail: settings.NotificationEmail,
+	}
+}
+
+// payrollCurrency picks the currency for copied payroll settings. An explicit
+// override wins. Otherwise the source's currency is kept only when the new
+// entity is in the same country; a different country usually pays in a
+// different currency, so the caller must say which.
+func payrollCurrency(settings *api.PayrollSettings, sourceCountry, country, override string) (string, error) {
+	if override != "" {
+		return strings.ToUpper(override), nil
+	}
+	if strings.EqualFold(sourceCountry, country) {
+		return settings.Currency, nil
+	}
+	return "", fmt.Errorf("--copy-payroll-settings into %s from an entity in %s requires --currency (the source pays in %s)", country, sourceCountry, settings.Currency)
+}
+
+// createLegalEntityFrom creates the entity, then applies payroll (when not
+// nil) to it. A payroll failure does not undo the entity; it is reported in
+// the result so the caller can retry the settings update.
+func createLegalEntityFrom(ctx context.Context, client *api.Client, sourceID string, params api.CreateLegalEntityParams, payroll *api.UpdatePayrollSettingsParams) (*legalEntityCreateResult, error) {
+	entity, err := client.CreateLegalEntity(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &legalEntityCreateResult{Entity: entity, SourceEntityID: sourceID}
+	if payroll == nil {
+		return result, nil
+	}
+	settings, err := client.UpdatePayrollSettings(api.WithIdempotencyPart(ctx, "payroll-settings"), entity.ID, *payroll)
+	if err != nil {
+		result.PayrollSettingsError = err.Error()
+		return result, nil
+	}
+	result.PayrollSettings = settings
+	result.PayrollCopied = true
+	return result, nil
+}
+
+// outputLegalEntityCreatedFrom prints the result of create --from-entity. A
+// failed payroll settings copy leaves the entity in place, so the result is
+// still printed, but with "ok": false and a non-zero exit.
+func outputLegalEntityCreatedFrom(ctx context.Context, f *outfmt.Formatter, result *legalEntityCreateResult) error {
+	var failure error
+	if result.PayrollSettingsError != "" {
+		failure = fmt.Errorf("legal entity %s created but payroll settings copy failed: %s", result.Entity.ID, result.PayrollSettingsError)
+	}
+	return outputBatchResults(ctx, f, func() {
+		printLegalEntityCreated(f, result.Entity)
+		f.PrintText("Copied from: " + result.SourceEntityID)
+		switch {
+		case result.PayrollCopied:
+			f.PrintText("Payroll settings copied")
+		case result.PayrollSettingsError != "":
+			f.PrintWarning("Entity created but payroll settings copy failed: %s", result.PayrollSettingsError)
+		}
+	}, result, failure)
+}
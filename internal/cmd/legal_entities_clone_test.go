@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+func TestApplyLegalEntityDefaults_CopiesUnsetFields(t *testing.T) {
+	params := api.CreateLegalEntityParams{Name: "Acme BV"}
+	applyLegalEntityDefaults(&params, &api.LegalEntity{
+		Name: "Acme Inc", Country: "US", Type: "llc", RegistrationNumber: "12-3456789",
+	})
+
+	assert.Equal(t, "Acme BV", params.Name)
+	assert.Equal(t, "US", params.Country)
+	assert.Equal(t, "llc", params.Type)
+	assert.Empty(t, params.RegistrationNumber)
+}
+
+func TestApplyLegalEntityDefaults_FlagsOverride(t *testing.T) {
+	params := api.CreateLegalEntityParams{Name: "Acme BV", Country: "NL", Type: "bv"}
+	applyLegalEntityDefaults(&params, &api.LegalEntity{Country: "US", Type: "llc"})
+
+	assert.Equal(t, "NL", params.Country)
+	assert.Equal(t, "bv", params.Type)
+}
+
+func TestCreateLegalEntityFrom_CopiesPayrollSettings(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+
+	var calls []string
+	server.Handle("POST", "/rest/v2/legal-entities", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "create")
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "NL", body["country"])
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"le-2","name":"Acme BV","country":"NL","type":"llc"}}`))
+	})
+	server.Handle("PATCH", "/rest/v2/legal-entities/le-2/payroll-settings", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "payroll")
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "monthly", body["payroll_frequency"])
+		assert.Equal(t, "EUR", body["currency"])
+		assert.Equal(t, true, body["auto_approval"])
+		assert.NotContains(t, body, "tax_id")
+		assert.NotContains(t, body, "bank_account")
+		_, _ = w.Write([]byte(`{"data":{"id":"ps-2","legal_entity_id":"le-2","payroll_frequency":"monthly"}}`))
+	})
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	source := &api.PayrollSettings{
+		LegalEntityID:    "le-1",
+		PayrollFrequency: "monthly",
+		PaymentMethod:    "ach",
+		TaxID:            "12-3456789",
+		BankAccount:      "****1234",
+		AutoApproval:     true,
+	}
+	settings := payrollSettingsCopy(source, "EUR")
+
+	result, err := createLegalEntityFrom(context.Background(), client, "le-1",
+		api.CreateLegalEntityParams{Name: "Acme BV", Country: "NL", Type: "llc"}, &settings)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"create", "payroll"}, calls)
+	assert.Equal(t, "le-2", result.Entity.ID)
+	assert.Equal(t, "le-1", result.SourceEntityID)
+	assert.True(t, result.PayrollCopied)
+	assert.Equal(t, "le-2", result.PayrollSettings.LegalEntityID)
+}
+
+func TestCreateLegalEntityFrom_WithoutPayrollMakesOneCall(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.HandleJSON("POST", "/rest/v2/legal-entities", http.StatusCreated, map[string]any{
+		"data": map[string]any{"id": "le-2"},
+	})
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+
+	result, err := createLegalEntityFrom(context.Background(), client, "le-1",
+		api.CreateLegalEntityParams{Name: "Acme BV", Country: "NL", Type: "llc"}, nil)
+	require.NoError(t, err)
+	assert.False(t, result.PayrollCopied)
+	assert.Nil(t, result.PayrollSettings)
+}
+
+func TestCreateLegalEntityFrom_ReportsPayrollFailure(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.HandleJSON("POST", "/rest/v2/legal-entities", http.StatusCreated, map[string]any{
+		"data": map[string]any{"id": "le-2"},
+	})
+	server.HandleError("PATCH", "/rest/v2/legal-entities/le-2/payroll-settings", http.StatusBadRequest, "unsupported provider")
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	client.SetRetryConfig(0, 0, 0)
+
+	result, err := createLegalEntityFrom(context.Background(), client, "le-1",
+		api.CreateLegalEntityParams{Name: "Acme BV", Country: "NL", Type: "llc"}, &api.UpdatePayrollSettingsParams{PayrollFrequency: "monthly"})
+	require.NoError(t, err)
+	assert.Equal(t, "le-2", result.Entity.ID)
+	assert.False(t, result.PayrollCopied)
+	assert.Contains(t, result.PayrollSettingsError, "unsupported provider")
+}
+
+func TestPayrollCurrency(t *testing.T) {
+	settings := &api.PayrollSettings{Currency: "USD"}
+
+	got, err := payrollCurrency(settings, "US", "us", "")
+	require.NoError(t, err)
+	assert.Equal(t, "USD", got, "same country keeps the source currency")
+
+	got, err = payrollCurrency(settings, "US", "NL", "eur")
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", got)
+
+	_, err = payrollCurrency(settings, "US", "NL", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires --currency")
+}
+
+func TestOutputLegalEntityCreatedFrom_PayrollFailureExitsNonZero(t *testing.T) {
+	resetAgentErrorEmitted()
+	defer resetAgentErrorEmitted()
+
+	var out, errOut bytes.Buffer
+	f := outfmt.New(&out, &errOut, outfmt.FormatJSON, "never")
+	f.SetAgentMode(true)
+	ctx := outfmt.WithAgent(context.Background(), true)
+
+	result := &legalEntityCreateResult{Entity: &api.LegalEntity{ID: "le-2"}, SourceEntityID: "le-1", PayrollSettingsError: "unsupported provider"}
+	err := outputLegalEntityCreatedFrom(ctx, f, result)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "le-2")
+	assert.NotEqual(t, 0, ExitCode(err))
+	assert.True(t, AgentErrorEmitted(), "main must not print a second error object")
+
+	var payload struct {
+		OK     bool `json:"ok"`
+		Result struct {
+			Data legalEntityCreateResult `json:"data"`
+		} `json:"result"`
+	}
+	require.NoError(t, json.NewDecoder(&out).Decode(&payload))
+	assert.False(t, payload.OK)
+	assert.Equal(t, "le-2", payload.Result.Data.Entity.ID)
+	assert.False(t, payload.Result.Data.PayrollCopied)
+}
@@ -3,8 +3,11 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/salmonumbrella/deel-cli/internal/api"
 	"github.com/salmonumbrella/deel-cli/internal/outfmt"
@@ -12,7 +15,8 @@ import (
 
 const moreResultsMessage = "More results available. Use --cursor to paginate or --all to fetch everything."
 
-// maxPaginationPages is a safety limit to prevent runaway pagination when using --all.
+// maxPaginationPages is a safety limit for internal scans (e.g. search by name)
+// that walk pages on the user's behalf. --all is bounded by --max-pages instead.
 const maxPaginationPages = 100
 
 // CursorPage captures cursor pagination info.
@@ -28,18 +32,27 @@ type CursorListResult[T any] struct {
 }
 
 // makeListResponse builds a ListResponse from items and page info.
-// It clears Page.Next (since --all mode has fetched everything) and preserves Total.
+// Page.Next is echoed as-is so scripts can resume with --cursor; it is already
+// empty when --all has fetched everything.
 func makeListResponse[T any](items []T, page CursorPage) api.ListResponse[T] {
 	return api.ListResponse[T]{
 		Data: items,
 		Page: api.Page{
-			Next:  "", // Always clear - we've collected all items
+			Next:  page.Next,
 			Total: page.Total,
 		},
 	}
 }
 
 func outputList[T any](cmd *cobra.Command, f *outfmt.Formatter, items []T, hasMore bool, emptyMessage string, headers []string, rowFunc func(T) []string, response any) error {
+	if sortByFlag != "" {
+		if hasMore {
+			f.PrintWarning("--sort-by %s is applied client-side and only sorts the fetched page; use --all to sort everything", sortByFlag)
+		}
+		if outfmt.JSONL(cmd.Context()) {
+			f.PrintWarning("--sort-by buffers all results before output (disables streaming)")
+		}
+	}
 	return f.OutputFiltered(cmd.Context(), func() {
 		if len(items) == 0 {
 			f.PrintText(emptyMessage)
@@ -47,16 +60,94 @@ func outputList[T any](cmd *cobra.Command, f *outfmt.Formatter, items []T, hasMo
 		}
 		table := f.NewTable(headers...)
 		for _, item := range items {
-			table.AddRow(rowFunc(item)...)
+			table.AddItemRow(item, rowFunc(item)...)
 		}
 		table.Render()
 		if hasMore {
 			f.PrintText("")
-			f.PrintText(moreResultsMessage)
+			next := ""
+			if r, ok := response.(interface{ NextCursor() string }); ok {
+				next = r.NextCursor()
+			}
+			if next == "" {
+				f.PrintText(moreResultsMessage)
+				return
+			}
+			f.PrintText("More results available. Next page:")
+			f.PrintText("  " + nextPageCommand(cmd, next))
 		}
 	}, response)
 }
 
+// nextPageCommand rebuilds the current invocation with --cursor set to next,
+// keeping every other flag the user passed. Values of --proxy (which may embed
+// credentials) and of flags named like secrets are replaced with a
+// placeholder, since the hint is printed to the terminal and to logs.
+func nextPageCommand(cmd *cobra.Command, next string) string {
+	parts := []string{cmd.CommandPath()}
+	cmd.Flags().Visit(func(fl *pflag.Flag) {
+		switch fl.Name {
+		case "cursor":
+			return
+		case "all":
+			// --all with --max-pages resumes batch by batch; otherwise the
+			// hint is for a single page.
+			if maxPagesFlag <= 0 {
+				return
+			}
+		}
+		if fl.Value.Type() == "bool" {
+			if fl.Value.String() == "true" {
+				parts = append(parts, "--"+fl.Name)
+			} else {
+				parts = append(parts, "--"+fl.Name+"=false")
+			}
+			return
+		}
+		if sensitiveFlag(fl.Name) {
+			parts = append(parts, "--"+fl.Name, shellQuote(redactedFlagValue))
+			return
+		}
+		if sv, ok := fl.Value.(pflag.SliceValue); ok {
+			for _, v := range sv.GetSlice() {
+				parts = append(parts, "--"+fl.Name, shellQuote(v))
+			}
+			return
+		}
+		parts = append(parts, "--"+fl.Name, shellQuote(fl.Value.String()))
+	})
+	return strings.Join(append(parts, "--cursor", shellQuote(next)), " ")
+}
+
+// redactedFlagValue stands in for a sensitive flag value in printed commands.
+const redactedFlagValue = "<redacted>"
+
+// sensitiveFlag reports whether a flag's value must not be echoed back.
+func sensitiveFlag(name string) bool {
+	if name == "proxy" {
+		return true
+	}
+	for _, word := range []string{"secret", "token", "password"} {
+		if strings.Contains(name, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// shellQuote single-quotes s unless it only contains shell-safe characters.
+func shellQuote(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_./:@,=+", r))
+	}) < 0 {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// collectCursorItems fetches one page, or every page when all is set. With
+// --max-pages, --all stops after that many pages and returns the next cursor
+// so the listing can be resumed; otherwise Page.Next is empty once it completes.
 func collectCursorItems[T any](
 	ctx context.Context,
 	all bool,
@@ -64,8 +155,59 @@ func collectCursorItems[T any](
 	limit int,
 	fetch func(ctx context.Context, cursor string, limit int) (CursorListResult[T], error),
 ) ([]T, CursorPage, bool, error) {
+	var items []T
+	page, hasMore, err := walkCursorPages(ctx, all, cursor, limit, fetch, func(batch []T) error {
+		if !all {
+			items = batch
+			return nil
+		}
+		items = append(items, batch...)
+		return nil
+	})
+	if err != nil {
+		return nil, CursorPage{}, false, err
+	}
+	return items, page, hasMore, nil
+}
+
+// countCursorItems counts the items keep accepts (every item when keep is nil)
+// across the pages collectCursorItems would fetch. Items are dropped once
+// counted, so counting any number of pages uses constant memory.
+func countCursorItems[T any](
+	ctx context.Context,
+	all bool,
+	cursor string,
+	limit int,
+	fetch func(ctx context.Context, cursor string, limit int) (CursorListResult[T], error),
+	keep func(item *T) bool,
+) (int, CursorPage, bool, error) {
+	count := 0
+	page, hasMore, err := walkCursorPages(ctx, all, cursor, limit, fetch, func(batch []T) error {
+		for i := range batch {
+			if keep == nil || keep(&batch[i]) {
+				count++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, CursorPage{}, false, err
+	}
+	return count, page, hasMore, nil
+}
+
+// walkCursorPages fetches pages like collectCursorItems but hands each page's
+// items to visit instead of keeping them. Aggregations (--count, summaries)
+// use it so memory does not grow with the number of pages.
+func walkCursorPages[T any](
+	ctx context.Context,
+	all bool,
+	cursor string,
+	limit int,
+	fetch func(ctx context.Context, cursor string, limit int) (CursorListResult[T], error),
+	visit func(items []T) error,
+) (CursorPage, bool, error) {
 	var (
-		items   []T
 		page    CursorPage
 		hasMore bool
 		pages   int
@@ -74,29 +216,49 @@ func collectCursorItems[T any](
 	for {
 		result, err := fetch(ctx, cursor, limit)
 		if err != nil {
-			return nil, CursorPage{}, false, err
+			return CursorPage{}, false, err
 		}
 		pages++
+		if err := visit(result.Items); err != nil {
+			return CursorPage{}, false, err
+		}
 
 		if !all {
-			items = result.Items
 			page = result.Page
 			hasMore = result.Page.Next != ""
 			break
 		}
 
-		items = append(items, result.Items...)
 		if result.Page.Total > 0 {
 			page.Total = result.Page.Total
 		}
 		if result.Page.Next == "" {
 			break
 		}
-		if pages >= maxPaginationPages {
-			return nil, CursorPage{}, false, fmt.Errorf("pagination safety limit reached (%d pages); use --limit and --cursor for manual pagination", maxPaginationPages)
+		if result.Page.Next == cursor {
+			return CursorPage{}, false, fmt.Errorf("pagination stalled: API returned the same cursor %q twice", cursor)
+		}
+		if maxPagesFlag > 0 && pages >= maxPagesFlag {
+			// Stop early but leave the cursor so the caller can resume.
+			page.Next = result.Page.Next
+			hasMore = true
+			break
 		}
 		cursor = result.Page.Next
 	}
 
-	return items, page, hasMore, nil
+	return page, hasMore, nil
+}
+
+// itemCount is the --count output of list commands.
+type itemCount struct {
+	Count int `json:"count"`
+}
+
+// outputCount prints the --count result: the bare number as text, or
+// {"count": N} as JSON.
+func outputCount(cmd *cobra.Command, f *outfmt.Formatter, count int) error {
+	return f.OutputFiltered(cmd.Context(), func() {
+		f.PrintText(strconv.Itoa(count))
+	}, itemCount{Count: count})
 }
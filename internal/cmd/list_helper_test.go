@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -54,3 +56,189 @@ func TestCollectCursorItems_AllPages(t *testing.T) {
 	assert.Equal(t, "", page.Next)
 	assert.False(t, hasMore)
 }
+
+func TestCollectCursorItems_MaxPages(t *testing.T) {
+	prev := maxPagesFlag
+	maxPagesFlag = 2
+	t.Cleanup(func() { maxPagesFlag = prev })
+
+	var cursors []string
+	items, page, hasMore, err := collectCursorItems(context.Background(), true, "", 1, func(ctx context.Context, cursor string, limit int) (CursorListResult[testItem], error) {
+		cursors = append(cursors, cursor)
+		n := len(cursors)
+		return CursorListResult[testItem]{
+			Items: []testItem{{ID: fmt.Sprint(n)}},
+			Page:  CursorPage{Next: fmt.Sprintf("page-%d", n+1)},
+		}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "page-2"}, cursors)
+	assert.Len(t, items, 2)
+	assert.True(t, hasMore)
+	assert.Equal(t, "page-3", page.Next, "cursor to resume from")
+}
+
+func TestCollectCursorItems_AllUnlimitedByDefault(t *testing.T) {
+	calls := 0
+	items, page, hasMore, err := collectCursorItems(context.Background(), true, "", 1, func(ctx context.Context, cursor string, limit int) (CursorListResult[testItem], error) {
+		calls++
+		next := ""
+		if calls < 150 {
+			next = fmt.Sprintf("page-%d", calls+1)
+		}
+		return CursorListResult[testItem]{Items: []testItem{{ID: cursor}}, Page: CursorPage{Next: next}}, nil
+	})
+	require.NoError(t, err)
+	assert.Len(t, items, 150)
+	assert.False(t, hasMore)
+	assert.Empty(t, page.Next)
+	assert.Empty(t, makeListResponse(items, page).NextCursor())
+}
+
+func TestCollectCursorItems_RepeatedCursor(t *testing.T) {
+	_, _, _, err := collectCursorItems(context.Background(), true, "", 1, func(ctx context.Context, cursor string, limit int) (CursorListResult[testItem], error) {
+		return CursorListResult[testItem]{Items: []testItem{{ID: "1"}}, Page: CursorPage{Next: "same"}}, nil
+	})
+	assert.ErrorContains(t, err, "pagination stalled")
+}
+
+// mockPagedFetch serves pages of perPage items from one shared slice with
+// precomputed cursors, so the fetch itself never allocates.
+func mockPagedFetch(pages, perPage int) func(ctx context.Context, cursor string, limit int) (CursorListResult[testItem], error) {
+	page := make([]testItem, perPage)
+	for i := range page {
+		page[i] = testItem{ID: fmt.Sprint(i), Name: "odd"}
+		if i%2 == 0 {
+			page[i].Name = "even"
+		}
+	}
+	cursors := make([]string, pages+1)
+	index := make(map[string]int, pages)
+	for p := 1; p < pages; p++ {
+		cursors[p] = fmt.Sprintf("page-%d", p)
+		index[cursors[p]] = p
+	}
+	return func(ctx context.Context, cursor string, limit int) (CursorListResult[testItem], error) {
+		p := index[cursor]
+		return CursorListResult[testItem]{Items: page, Page: CursorPage{Next: cursors[p+1]}}, nil
+	}
+}
+
+func TestCountCursorItems_StreamsEveryPage(t *testing.T) {
+	ctx := context.Background()
+	isEven := func(item *testItem) bool { return item.Name == "even" }
+
+	count, page, hasMore, err := countCursorItems(ctx, true, "", 50, mockPagedFetch(1000, 50), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 50000, count)
+	assert.Empty(t, page.Next)
+	assert.False(t, hasMore)
+
+	count, _, _, err = countCursorItems(ctx, true, "", 50, mockPagedFetch(1000, 50), isEven)
+	require.NoError(t, err)
+	assert.Equal(t, 25000, count)
+
+	// Nothing is retained per item or per page: counting 1000 pages costs no
+	// more allocations than counting 10.
+	allocs := func(pages int) float64 {
+		fetch := mockPagedFetch(pages, 50)
+		return testing.AllocsPerRun(20, func() {
+			if _, _, _, err := countCursorItems(ctx, true, "", 50, fetch, isEven); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+	assert.Equal(t, allocs(10), allocs(1000))
+}
+
+func TestCountCursorItems_MaxPages(t *testing.T) {
+	prev := maxPagesFlag
+	t.Cleanup(func() { maxPagesFlag = prev })
+	maxPagesFlag = 3
+
+	count, page, hasMore, err := countCursorItems(context.Background(), true, "", 10, mockPagedFetch(100, 10), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 30, count)
+	assert.Equal(t, "page-3", page.Next)
+	assert.True(t, hasMore)
+}
+
+func TestMakeListResponse_EchoesNextCursor(t *testing.T) {
+	ctx := context.Background()
+	pages := map[string]CursorListResult[testItem]{
+		"":       {Items: []testItem{{ID: "1"}}, Page: CursorPage{Next: "page-2", Total: 2}},
+		"page-2": {Items: []testItem{{ID: "2"}}, Page: CursorPage{Total: 2}},
+	}
+	fetch := func(ctx context.Context, cursor string, limit int) (CursorListResult[testItem], error) {
+		return pages[cursor], nil
+	}
+
+	items, page, _, err := collectCursorItems(ctx, false, "", 1, fetch)
+	require.NoError(t, err)
+	first := makeListResponse(items, page)
+	assert.Equal(t, "page-2", first.Page.Next)
+	assert.Equal(t, "page-2", first.NextCursor())
+
+	items, page, hasMore, err := collectCursorItems(ctx, false, first.Page.Next, 1, fetch)
+	require.NoError(t, err)
+	second := makeListResponse(items, page)
+	assert.Equal(t, []testItem{{ID: "2"}}, second.Data)
+	assert.Empty(t, second.Page.Next)
+	assert.False(t, hasMore)
+
+	items, page, _, err = collectCursorItems(ctx, true, "", 1, fetch)
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+	assert.Empty(t, makeListResponse(items, page).Page.Next)
+}
+
+func TestNextPageCommand(t *testing.T) {
+	root := &cobra.Command{Use: "deel"}
+	root.PersistentFlags().String("account", "", "")
+	list := &cobra.Command{Use: "list", RunE: func(*cobra.Command, []string) error { return nil }}
+	list.Flags().String("cursor", "", "")
+	list.Flags().Int("limit", 100, "")
+	list.Flags().StringSlice("status", nil, "")
+	list.Flags().Bool("all", false, "")
+	root.AddCommand(list)
+
+	root.SetArgs([]string{"list", "--account", "acme corp", "--limit", "5", "--status", "active,pending", "--cursor", "old"})
+	require.NoError(t, root.Execute())
+
+	assert.Equal(t, "deel list --account 'acme corp' --limit 5 --status active --status pending --cursor abc=", nextPageCommand(list, "abc="))
+}
+
+func TestNextPageCommand_RedactsSensitiveFlags(t *testing.T) {
+	root := &cobra.Command{Use: "deel"}
+	root.PersistentFlags().String("proxy", "", "")
+	list := &cobra.Command{Use: "list", RunE: func(*cobra.Command, []string) error { return nil }}
+	list.Flags().String("cursor", "", "")
+	list.Flags().String("secret", "", "")
+	list.Flags().Bool("show-secret", false, "")
+	list.Flags().Int("limit", 100, "")
+	root.AddCommand(list)
+
+	root.SetArgs([]string{"list", "--proxy", "http://user:pw@proxy:8080", "--secret", "whsec_abc", "--show-secret", "--limit", "5"})
+	require.NoError(t, root.Execute())
+
+	got := nextPageCommand(list, "next")
+	assert.Equal(t, "deel list --limit 5 --proxy '<redacted>' --secret '<redacted>' --show-secret --cursor next", got)
+	assert.NotContains(t, got, "pw@")
+}
+
+func TestNextPageCommand_KeepsAllWithMaxPages(t *testing.T) {
+	prev := maxPagesFlag
+	t.Cleanup(func() { maxPagesFlag = prev })
+
+	root := &cobra.Command{Use: "deel"}
+	root.PersistentFlags().IntVar(&maxPagesFlag, "max-pages", 0, "")
+	list := &cobra.Command{Use: "list", RunE: func(*cobra.Command, []string) error { return nil }}
+	list.Flags().String("cursor", "", "")
+	list.Flags().Bool("all", false, "")
+	root.AddCommand(list)
+
+	root.SetArgs([]string{"list", "--all", "--max-pages", "3"})
+	require.NoError(t, root.Execute())
+
+	assert.Equal(t, "deel list --all --max-pages 3 --cursor next", nextPageCommand(list, "next"))
+}
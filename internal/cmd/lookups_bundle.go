@@ -0,0 +1,229 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/batch"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+// lookupsBundle is the offline document written by `org lookups export` and
+// read back with --from-file. A section that could not be fetched is null and
+// its error is kept under Errors, so a partial bundle is still usable for the
+// sections it has.
+type lookupsBundle struct {
+	ExportedAt      string               `json:"exported_at"`
+	Countries       []api.Country        `json:"countries"`
+	Currencies      []api.Currency       `json:"currencies"`
+	JobTitles       []api.JobTitle       `json:"job_titles"`
+	SeniorityLevels []api.SeniorityLevel `json:"seniority_levels"`
+	TimeOffTypes    []api.TimeOffType    `json:"time_off_types"`
+	Errors          map[string]string    `json:"errors,omitempty"`
+}
+
+// lookupsFromFileFlag reads a lookup command's rows from an export bundle.
+var lookupsFromFileFlag string
+
+// lookupsExportOutputFlag is the bundle path for `org lookups export`. It
+// shadows the global --output-file because the bundle is a plain JSON
+// document rather than command output.
+var lookupsExportOutputFlag string
+
+var lookupsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export all lookups to one JSON file",
+	Long: "Fetch countries, currencies, job titles, seniority levels, and time off types concurrently and write them to one JSON document. " +
+		"Pass that file to the lookup commands with --from-file to use them without API access.\n\n" +
+		"If an endpoint fails, the other sections are still written, the failure is recorded under \"errors\" in the file, and the command exits non-zero.",
+	Example: "  deel org lookups export --output-file lookups.json\n  deel org lookups countries --from-file lookups.json",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+		if lookupsExportOutputFlag == "" {
+			return failValidation(cmd, f, "--output-file is required")
+		}
+
+		client, err := getClient()
+		if err != nil {
+			return HandleError(f, err, "initializing client")
+		}
+
+		bundle := fetchLookupsBundle(cmd.Context(), client)
+		bundle.ExportedAt = time.Now().UTC().Format(time.RFC3339)
+		if _, err := downloadToFile(lookupsExportOutputFlag, func(w io.Writer) (int64, error) {
+			return 0, writeLookupsBundle(w, bundle)
+		}); err != nil {
+			return HandleError(f, err, "write lookups bundle")
+		}
+
+		var failure error
+		if failed := bundle.failedSections(); len(failed) > 0 {
+			for _, name := range failed {
+				f.PrintWarning("could not fetch %s: %s", name, bundle.Errors[name])
+			}
+			failure = fmt.Errorf("lookups export incomplete: %s failed", strings.Join(failed, ", "))
+		}
+
+		counts := bundle.counts()
+		return outputBatchResults(cmd.Context(), f, func() {
+			f.PrintSuccess("Wrote %s", lookupsExportOutputFlag)
+			for _, name := range lookupsBundleSections {
+				if _, failed := bundle.Errors[name]; failed {
+					continue
+				}
+				f.PrintText(fmt.Sprintf("  %-17s %d", name+":", counts[name]))
+			}
+		}, map[string]any{
+			"path":   lookupsExportOutputFlag,
+			"counts": counts,
+			"errors": bundle.Errors,
+		}, failure)
+	},
+}
+
+// lookupsBundleSections lists the bundle's sections in output order.
+var lookupsBundleSections = []string{"countries", "currencies", "job_titles", "seniority_levels", "time_off_types"}
+
+// fetchLookupsBundle fetches every lookup section concurrently. Sections that
+// fail are left nil and recorded in Errors.
+func fetchLookupsBundle(ctx context.Context, client *api.Client) *lookupsBundle {
+	results := batch.Run(ctx, len(lookupsBundleSections), len(lookupsBundleSections), func(ctx context.Context, i int) (any, error) {
+		switch name := lookupsBundleSections[i]; name {
+		case "countries":
+			return fetchLookupSection(ctx, client, (*api.Client).ListCountries)
+		case "currencies":
+			return fetchLookupSection(ctx, client, (*api.Client).ListCurrencies)
+		case "job_titles":
+			return fetchLookupSection(ctx, client, (*api.Client).ListJobTitles)
+		case "seniority_levels":
+			return fetchLookupSection(ctx, client, (*api.Client).ListSeniorityLevels)
+		case "time_off_types":
+			return fetchLookupSection(ctx, client, (*api.Client).ListTimeOffTypes)
+		default:
+			return nil, fmt.Errorf("unknown lookups section %q", name)
+		}
+	})
+
+	b := &lookupsBundle{}
+	for _, r := range results {
+		name := lookupsBundleSections[r.Index]
+		if r.Error != nil {
+			if b.Errors == nil {
+				b.Errors = map[string]string{}
+			}
+			b.Errors[name] = r.Error.Error()
+			continue
+		}
+		switch rows := r.Data.(type) {
+		case []api.Country:
+			b.Countries = rows
+		case []api.Currency:
+			b.Currencies = rows
+		case []api.JobTitle:
+			b.JobTitles = rows
+		case []api.SeniorityLevel:
+			b.SeniorityLevels = rows
+		case []api.TimeOffType:
+			b.TimeOffTypes = rows
+		}
+	}
+	return b
+}
+
+// fetchLookupSection calls list and returns an empty, non-nil slice when the
+// API has no rows, so an empty section is not mistaken for a failed one.
+func fetchLookupSection[T any](ctx context.Context, client *api.Client, list func(*api.Client, context.Context) ([]T, error)) ([]T, error) {
+	rows, err := list(client, ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rows == nil {
+		rows = []T{}
+	}
+	return rows, nil
+}
+
+// failedSections returns the sections that could not be fetched, sorted.
+func (b *lookupsBundle) failedSections() []string {
+	names := make([]string, 0, len(b.Errors))
+	for name := range b.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// counts returns the number of rows in each fetched section.
+func (b *lookupsBundle) counts() map[string]int {
+	counts := map[string]int{
+		"countries":        len(b.Countries),
+		"currencies":       len(b.Currencies),
+		"job_titles":       len(b.JobTitles),
+		"seniority_levels": len(b.SeniorityLevels),
+		"time_off_types":   len(b.TimeOffTypes),
+	}
+	for name := range b.Errors {
+		delete(counts, name)
+	}
+	return counts
+}
+
+// writeLookupsBundle writes b as indented JSON.
+func writeLookupsBundle(w io.Writer, b *lookupsBundle) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(b)
+}
+
+// readLookupsBundle loads a bundle written by `org lookups export`.
+func readLookupsBundle(path string) (*lookupsBundle, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var b lookupsBundle
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("%s is not a lookups bundle: %w", path, err)
+	}
+	return &b, nil
+}
+
+// lookupRows returns a lookup command's rows from the --from-file bundle when
+// set, and from the API otherwise. Errors are already reported through f.
+func lookupRows[T any](cmd *cobra.Command, f *outfmt.Formatter, section, op string, pick func(*lookupsBundle) []T, list func(*api.Client, context.Context) ([]T, error)) ([]T, error) {
+	if lookupsFromFileFlag != "" {
+		bundle, err := readLookupsBundle(lookupsFromFileFlag)
+		if err != nil {
+			return nil, HandleError(f, err, op)
+		}
+		rows := pick(bundle)
+		if rows == nil {
+			msg := fmt.Sprintf("%s has no %s section", lookupsFromFileFlag, section)
+			if reason := bundle.Errors[section]; reason != "" {
+				msg += " (export failed: " + reason + ")"
+			}
+			return nil, failValidation(cmd, f, msg, "re-run 'deel org lookups export' with API access")
+		}
+		return rows, nil
+	}
+
+	client, err := getClient()
+	if err != nil {
+		return nil, HandleError(f, err, "initializing client")
+	}
+	rows, err := list(client, cmd.Context())
+	if err != nil {
+		return nil, HandleError(f, err, op)
+	}
+	return rows, nil
+}
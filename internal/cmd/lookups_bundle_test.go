@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+func newLookupsTestServer() *testutil.MockServer {
+	server := testutil.NewMockServer()
+	server.HandleJSON(http.MethodGet, "/rest/v2/lookups/countries", http.StatusOK, map[string]any{
+		"data": []map[string]any{{"code": "GB", "name": "United Kingdom"}},
+	})
+	server.HandleJSON(http.MethodGet, "/rest/v2/lookups/currencies", http.StatusOK, map[string]any{
+		"data": []map[string]any{{"code": "GBP", "name": "Pound Sterling", "symbol": "£"}},
+	})
+	server.HandleJSON(http.MethodGet, "/rest/v2/lookups/job-titles", http.StatusOK, map[string]any{
+		"data": []map[string]any{{"id": "jt-1", "name": "Engineer"}},
+	})
+	server.HandleJSON(http.MethodGet, "/rest/v2/lookups/seniorities", http.StatusOK, map[string]any{
+		"data": []map[string]any{{"id": 1, "name": "Junior"}},
+	})
+	server.HandleJSON(http.MethodGet, "/rest/v2/lookups/time-off-types", http.StatusOK, map[string]any{
+		"data": []map[string]any{},
+	})
+	return server
+}
+
+func TestFetchLookupsBundle_AllSections(t *testing.T) {
+	server := newLookupsTestServer()
+	defer server.Close()
+
+	bundle := fetchLookupsBundle(context.Background(), newEORTestClient(server))
+	assert.Empty(t, bundle.Errors)
+	assert.Equal(t, []api.Country{{Code: "GB", Name: "United Kingdom"}}, bundle.Countries)
+	assert.Equal(t, "GBP", bundle.Currencies[0].Code)
+	assert.Equal(t, "jt-1", bundle.JobTitles[0].ID)
+	assert.Equal(t, "1", bundle.SeniorityLevels[0].ID)
+	assert.NotNil(t, bundle.TimeOffTypes, "an empty section is not a failed one")
+	assert.Equal(t, map[string]int{
+		"countries": 1, "currencies": 1, "job_titles": 1, "seniority_levels": 1, "time_off_types": 0,
+	}, bundle.counts())
+}
+
+func TestFetchLookupsBundle_OneEndpointFails(t *testing.T) {
+	server := newLookupsTestServer()
+	defer server.Close()
+	server.HandleError(http.MethodGet, "/rest/v2/lookups/currencies", http.StatusForbidden, "forbidden")
+
+	bundle := fetchLookupsBundle(context.Background(), newEORTestClient(server))
+	assert.Equal(t, []string{"currencies"}, bundle.failedSections())
+	assert.Nil(t, bundle.Currencies)
+	assert.Len(t, bundle.Countries, 1)
+	assert.NotContains(t, bundle.counts(), "currencies")
+}
+
+func TestLookupRows_FromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lookups.json")
+	var buf bytes.Buffer
+	require.NoError(t, writeLookupsBundle(&buf, &lookupsBundle{
+		Countries: []api.Country{{Code: "GB", Name: "United Kingdom"}},
+		Errors:    map[string]string{"currencies": "forbidden"},
+	}))
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+
+	lookupsFromFileFlag = path
+	defer func() { lookupsFromFileFlag = "" }()
+
+	var out bytes.Buffer
+	f := outfmt.New(&out, &out, outfmt.FormatText, "never")
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+	noAPI := func(*api.Client, context.Context) ([]api.Country, error) {
+		t.Fatal("--from-file must not call the API")
+		return nil, nil
+	}
+
+	countries, err := lookupRows(cmd, f, "countries", "list countries",
+		func(b *lookupsBundle) []api.Country { return b.Countries }, noAPI)
+	require.NoError(t, err)
+	assert.Equal(t, []api.Country{{Code: "GB", Name: "United Kingdom"}}, countries)
+
+	_, err = lookupRows(cmd, f, "currencies", "list currencies",
+		func(b *lookupsBundle) []api.Currency { return b.Currencies },
+		func(*api.Client, context.Context) ([]api.Currency, error) { return nil, nil })
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has no currencies section (export failed: forbidden)")
+}
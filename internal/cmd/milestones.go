@@ -35,7 +35,7 @@ var milestonesListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		milestones, err := client.ListMilestones(cmd.Context(), milestonesContractIDFlag)
+		milestones, err := client.ListMilestones(api.WithServerFields(cmd.Context()), milestonesContractIDFlag)
 		if err != nil {
 			return HandleError(f, err, "list milestones")
 		}
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"github.com/spf13/cobra"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
 )
 
 var offboardingCmd = &cobra.Command{
@@ -21,7 +23,7 @@ var offboardingGetCmd = &cobra.Command{
 			return HandleError(f, err, "getting offboarding")
 		}
 
-		record, err := client.GetOffboardingTracker(cmd.Context(), args[0])
+		record, err := client.GetOffboardingTracker(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "getting offboarding")
 		}
@@ -49,7 +51,7 @@ var terminationsGetCmd = &cobra.Command{
 			return HandleError(f, err, "getting termination")
 		}
 
-		termination, err := client.GetTerminationDetails(cmd.Context(), args[0])
+		termination, err := client.GetTerminationDetails(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "getting termination")
 		}
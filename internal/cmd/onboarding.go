@@ -31,7 +31,7 @@ var onboardingListCmd = &cobra.Command{
 			return err
 		}
 
-		employees, page, hasMore, err := collectCursorItems(cmd.Context(), onboardingAllFlag, onboardingCursorFlag, onboardingLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.OnboardingEmployee], error) {
+		employees, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), onboardingAllFlag, onboardingCursorFlag, onboardingLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.OnboardingEmployee], error) {
 			resp, err := client.ListOnboardingEmployees(ctx, api.OnboardingListParams{
 				Status: onboardingStatusFlag,
 				Limit:  limit,
@@ -71,7 +71,7 @@ var onboardingGetCmd = &cobra.Command{
 			return HandleError(f, err, "getting onboarding details")
 		}
 
-		details, err := client.GetOnboardingDetails(cmd.Context(), args[0])
+		details, err := client.GetOnboardingDetails(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "getting onboarding details")
 		}
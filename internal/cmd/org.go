@@ -1,12 +1,14 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/spf13/cobra"
 
 	"github.com/salmonumbrella/deel-cli/internal/api"
 	"github.com/salmonumbrella/deel-cli/internal/dryrun"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
 )
 
 var orgCmd = &cobra.Command{
@@ -25,7 +27,7 @@ var orgGetCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		org, err := client.GetOrganization(cmd.Context())
+		org, err := client.GetOrganization(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "get organization")
 		}
@@ -50,7 +52,7 @@ var orgStructuresCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		structures, err := client.GetOrgStructures(cmd.Context())
+		structures, err := client.GetOrgStructures(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "get structures")
 		}
@@ -69,7 +71,11 @@ var orgStructuresCmd = &cobra.Command{
 	},
 }
 
-var orgEntitiesLimitFlag int
+var (
+	orgEntitiesLimitFlag  int
+	orgEntitiesCursorFlag string
+	orgEntitiesAllFlag    bool
+)
 
 var orgEntitiesCmd = &cobra.Command{
 	Use:   "entities",
@@ -81,30 +87,39 @@ var orgEntitiesCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		entities, err := client.ListLegalEntities(cmd.Context())
+		entities, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), orgEntitiesAllFlag, orgEntitiesCursorFlag, orgEntitiesLimitFlag, legalEntitiesPageFetcher(client))
 		if err != nil {
 			return HandleError(f, err, "list entities")
 		}
 
-		// Apply client-side limit
-		if orgEntitiesLimitFlag > 0 && len(entities) > orgEntitiesLimitFlag {
-			entities = entities[:orgEntitiesLimitFlag]
-		}
-
-		return f.OutputFiltered(cmd.Context(), func() {
-			if len(entities) == 0 {
-				f.PrintText("No legal entities found.")
-				return
-			}
-			table := f.NewTable("ID", "NAME", "COUNTRY", "TYPE", "STATUS")
-			for _, e := range entities {
-				table.AddRow(e.ID, e.Name, e.Country, e.Type, e.Status)
-			}
-			table.Render()
-		}, entities)
+		response := makeListResponse(entities, page)
+		return outputList(cmd, f, entities, hasMore, "No legal entities found.", []string{"ID", "NAME", "COUNTRY", "TYPE", "STATUS"}, func(e api.LegalEntity) []string {
+			return []string{e.ID, e.Name, e.Country, e.Type, e.Status}
+		}, response)
 	},
 }
 
+// legalEntitiesPageFetcher returns a collectCursorItems fetch function for
+// legal entities.
+func legalEntitiesPageFetcher(client *api.Client) func(ctx context.Context, cursor string, limit int) (CursorListResult[api.LegalEntity], error) {
+	return func(ctx context.Context, cursor string, limit int) (CursorListResult[api.LegalEntity], error) {
+		resp, err := client.ListLegalEntities(ctx, api.LegalEntitiesListParams{
+			Limit:  limit,
+			Cursor: cursor,
+		})
+		if err != nil {
+			return CursorListResult[api.LegalEntity]{}, err
+		}
+		return CursorListResult[api.LegalEntity]{
+			Items: resp.Data,
+			Page: CursorPage{
+				Next:  resp.Page.Next,
+				Total: resp.Page.Total,
+			},
+		}, nil
+	}
+}
+
 // Groups commands
 var groupsCmd = &cobra.Command{
 	Use:   "groups",
@@ -116,6 +131,8 @@ var (
 	groupNameFlag        string
 	groupDescriptionFlag string
 	groupsLimitFlag      int
+	groupsCursorFlag     string
+	groupsAllFlag        bool
 )
 
 var groupsListCmd = &cobra.Command{
@@ -128,31 +145,34 @@ var groupsListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		groups, err := client.ListGroups(cmd.Context())
+		groups, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), groupsAllFlag, groupsCursorFlag, groupsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.Group], error) {
+			resp, err := client.ListGroups(ctx, api.GroupsListParams{
+				Limit:  limit,
+				Cursor: cursor,
+			})
+			if err != nil {
+				return CursorListResult[api.Group]{}, err
+			}
+			return CursorListResult[api.Group]{
+				Items: resp.Data,
+				Page: CursorPage{
+					Next:  resp.Page.Next,
+					Total: resp.Page.Total,
+				},
+			}, nil
+		})
 		if err != nil {
 			return HandleError(f, err, "list groups")
 		}
 
-		// Apply client-side limit
-		if groupsLimitFlag > 0 && len(groups) > groupsLimitFlag {
-			groups = groups[:groupsLimitFlag]
-		}
-
-		return f.OutputFiltered(cmd.Context(), func() {
-			if len(groups) == 0 {
-				f.PrintText("No groups found.")
-				return
+		response := makeListResponse(groups, page)
+		return outputList(cmd, f, groups, hasMore, "No groups found.", []string{"ID", "NAME", "DESCRIPTION", "MEMBERS", "CREATED"}, func(g api.Group) []string {
+			desc := g.Description
+			if len(desc) > 40 {
+				desc = desc[:37] + "..."
 			}
-			table := f.NewTable("ID", "NAME", "DESCRIPTION", "MEMBERS", "CREATED")
-			for _, g := range groups {
-				desc := g.Description
-				if len(desc) > 40 {
-					desc = desc[:37] + "..."
-				}
-				table.AddRow(g.ID, g.Name, desc, fmt.Sprintf("%d", g.MemberCount), g.CreatedAt)
-			}
-			table.Render()
-		}, groups)
+			return []string{g.ID, g.Name, desc, fmt.Sprintf("%d", g.MemberCount), g.CreatedAt}
+		}, response)
 	},
 }
 
@@ -167,7 +187,7 @@ var groupsGetCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		group, err := client.GetGroup(cmd.Context(), args[0])
+		group, err := client.GetGroup(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "get group")
 		}
@@ -355,7 +375,12 @@ var (
 	entityCountryFlag            string
 	entityTypeFlag               string
 	entityRegistrationNumberFlag string
+	entityFromEntityFlag         string
+	entityCopyPayrollFlag        bool
+	entityPayrollCurrencyFlag    string
 	legalEntitiesLimitFlag       int
+	legalEntitiesCursorFlag      string
+	legalEntitiesAllFlag         bool
 )
 
 var legalEntitiesListCmd = &cobra.Command{
@@ -369,90 +394,155 @@ var legalEntitiesListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		entities, err := client.ListLegalEntities(cmd.Context())
+		entities, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), legalEntitiesAllFlag, legalEntitiesCursorFlag, legalEntitiesLimitFlag, legalEntitiesPageFetcher(client))
 		if err != nil {
 			return HandleError(f, err, "list legal entities")
 		}
 
-		// Apply client-side limit
-		if legalEntitiesLimitFlag > 0 && len(entities) > legalEntitiesLimitFlag {
-			entities = entities[:legalEntitiesLimitFlag]
-		}
-
-		return f.OutputFiltered(cmd.Context(), func() {
-			if len(entities) == 0 {
-				f.PrintText("No legal entities found.")
-				return
-			}
-			table := f.NewTable("ID", "NAME", "COUNTRY", "TYPE", "STATUS", "REG NUMBER")
-			for _, e := range entities {
-				table.AddRow(e.ID, e.Name, e.Country, e.Type, e.Status, e.RegistrationNumber)
-			}
-			table.Render()
-		}, entities)
+		response := makeListResponse(entities, page)
+		return outputList(cmd, f, entities, hasMore, "No legal entities found.", []string{"ID", "NAME", "COUNTRY", "TYPE", "STATUS", "REG NUMBER"}, func(e api.LegalEntity) []string {
+			return []string{e.ID, e.Name, e.Country, e.Type, e.Status, e.RegistrationNumber}
+		}, response)
 	},
 }
 
 var legalEntitiesCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new legal entity",
-	Long:  "Create a new legal entity. Requires --name, --country, and --type flags.",
+	Long: `Create a new legal entity. Requires --name, --country, and --type flags.
+
+With --from-entity, country and type default to the source entity's values and
+explicit flags win. --copy-payroll-settings also copies the source's payroll
+frequency, payment method, provider, auto-approval, and notification email (not
+its tax ID or bank account) to the new entity. The source's currency is copied
+only when the new entity is in the same country; otherwise pass --currency.`,
+	Example: `  deel org legal-entities create --name "Acme GmbH" --country DE --type gmbh
+  deel org legal-entities create --from-entity le-123 --name "Acme BV" --country NL --copy-payroll-settings --currency EUR`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 
 		if entityNameFlag == "" {
 			return failValidation(cmd, f, "--name flag is required")
 		}
-		if entityCountryFlag == "" {
+		if entityCopyPayrollFlag && entityFromEntityFlag == "" {
+			return failValidation(cmd, f, "--copy-payroll-settings requires --from-entity")
+		}
+		if entityPayrollCurrencyFlag != "" {
+			if !entityCopyPayrollFlag {
+				return failValidation(cmd, f, "--currency requires --copy-payroll-settings")
+			}
+			if err := validateCurrency(entityPayrollCurrencyFlag); err != nil {
+				return failValidation(cmd, f, err.Error())
+			}
+		}
+
+		params := api.CreateLegalEntityParams{
+			Name:               entityNameFlag,
+			Country:            entityCountryFlag,
+			Type:               entityTypeFlag,
+			RegistrationNumber: entityRegistrationNumberFlag,
+		}
+
+		// Reading the source is safe in dry run and lets the preview show
+		// the values that would actually be sent.
+		var client *api.Client
+		var source *api.LegalEntity
+		var sourcePayroll *api.PayrollSettings
+		if entityFromEntityFlag != "" {
+			var err error
+			client, err = getClient()
+			if err != nil {
+				return HandleError(f, err, "initializing client")
+			}
+			source, err = client.GetLegalEntity(cmd.Context(), entityFromEntityFlag)
+			if err != nil {
+				return HandleError(f, err, "get source legal entity")
+			}
+			applyLegalEntityDefaults(&params, source)
+			if entityCopyPayrollFlag {
+				sourcePayroll, err = client.GetPayrollSettings(cmd.Context(), entityFromEntityFlag)
+				if err != nil {
+					return HandleError(f, err, "get source payroll settings")
+				}
+			}
+		}
+
+		if params.Country == "" {
 			return failValidation(cmd, f, "--country flag is required")
 		}
-		if entityTypeFlag == "" {
+		if params.Type == "" {
 			return failValidation(cmd, f, "--type flag is required")
 		}
+		var payroll *api.UpdatePayrollSettingsParams
+		if sourcePayroll != nil {
+			currency, err := payrollCurrency(sourcePayroll, source.Country, params.Country, entityPayrollCurrencyFlag)
+			if err != nil {
+				return failValidation(cmd, f, err.Error())
+			}
+			settings := payrollSettingsCopy(sourcePayroll, currency)
+			payroll = &settings
+		}
 
+		details := map[string]string{
+			"Name":      params.Name,
+			"Country":   params.Country,
+			"Type":      params.Type,
+			"RegNumber": params.RegistrationNumber,
+		}
+		if entityFromEntityFlag != "" {
+			details["FromEntity"] = entityFromEntityFlag
+		}
+		if payroll != nil {
+			details["PayrollSettings"] = fmt.Sprintf("copy from %s (%s, %s, %s)", entityFromEntityFlag, payroll.PayrollFrequency, payroll.PaymentMethod, payroll.Currency)
+		}
 		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
 			Operation:   "CREATE",
 			Resource:    "LegalEntity",
 			Description: "Create legal entity",
-			Details: map[string]string{
-				"Name":      entityNameFlag,
-				"Country":   entityCountryFlag,
-				"Type":      entityTypeFlag,
-				"RegNumber": entityRegistrationNumberFlag,
-			},
+			Details:     details,
 		}); ok {
 			return err
 		}
 
-		client, err := getClient()
-		if err != nil {
-			return HandleError(f, err, "initializing client")
+		if client == nil {
+			var err error
+			client, err = getClient()
+			if err != nil {
+				return HandleError(f, err, "initializing client")
+			}
 		}
 
-		entity, err := client.CreateLegalEntity(cmd.Context(), api.CreateLegalEntityParams{
-			Name:               entityNameFlag,
-			Country:            entityCountryFlag,
-			Type:               entityTypeFlag,
-			RegistrationNumber: entityRegistrationNumberFlag,
-		})
+		if entityFromEntityFlag != "" {
+			result, err := createLegalEntityFrom(cmd.Context(), client, entityFromEntityFlag, params, payroll)
+			if err != nil {
+				return HandleError(f, err, "create legal entity")
+			}
+			return outputLegalEntityCreatedFrom(cmd.Context(), f, result)
+		}
+
+		entity, err := client.CreateLegalEntity(cmd.Context(), params)
 		if err != nil {
 			return HandleError(f, err, "create legal entity")
 		}
 
 		return f.OutputFiltered(cmd.Context(), func() {
-			f.PrintSuccess("Legal entity created successfully")
-			f.PrintText("ID:       " + entity.ID)
-			f.PrintText("Name:     " + entity.Name)
-			f.PrintText("Country:  " + entity.Country)
-			f.PrintText("Type:     " + entity.Type)
-			f.PrintText("Status:   " + entity.Status)
-			if entity.RegistrationNumber != "" {
-				f.PrintText("Reg Num:  " + entity.RegistrationNumber)
-			}
+			printLegalEntityCreated(f, entity)
 		}, entity)
 	},
 }
 
+func printLegalEntityCreated(f *outfmt.Formatter, entity *api.LegalEntity) {
+	f.PrintSuccess("Legal entity created successfully")
+	f.PrintText("ID:       " + entity.ID)
+	f.PrintText("Name:     " + entity.Name)
+	f.PrintText("Country:  " + entity.Country)
+	f.PrintText("Type:     " + entity.Type)
+	f.PrintText("Status:   " + entity.Status)
+	if entity.RegistrationNumber != "" {
+		f.PrintText("Reg Num:  " + entity.RegistrationNumber)
+	}
+}
+
 var legalEntitiesUpdateCmd = &cobra.Command{
 	Use:   "update <entity-id>",
 	Short: "Update a legal entity",
@@ -566,7 +656,7 @@ var legalEntitiesPayrollSettingsCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		settings, err := client.GetPayrollSettings(cmd.Context(), args[0])
+		settings, err := client.GetPayrollSettings(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "get payroll settings")
 		}
@@ -598,7 +688,7 @@ var legalEntitiesPayrollSettingsCmd = &cobra.Command{
 var lookupsCmd = &cobra.Command{
 	Use:   "lookups",
 	Short: "List lookup data",
-	Long:  "List currencies, countries, job titles, seniority levels, and time off types.",
+	Long:  "List currencies, countries, job titles, seniority levels, and time off types.\n\nUse 'export' to save them all to one JSON file, then --from-file on any lookup command to read from it offline.",
 }
 
 var lookupsCurrenciesCmd = &cobra.Command{
@@ -606,14 +696,10 @@ var lookupsCurrenciesCmd = &cobra.Command{
 	Short: "List available currencies",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
-		client, err := getClient()
-		if err != nil {
-			return HandleError(f, err, "initializing client")
-		}
-
-		currencies, err := client.ListCurrencies(cmd.Context())
+		currencies, err := lookupRows(cmd, f, "currencies", "list currencies",
+			func(b *lookupsBundle) []api.Currency { return b.Currencies }, (*api.Client).ListCurrencies)
 		if err != nil {
-			return HandleError(f, err, "list currencies")
+			return err
 		}
 
 		return f.OutputFiltered(cmd.Context(), func() {
@@ -635,14 +721,10 @@ var lookupsCountriesCmd = &cobra.Command{
 	Short: "List available countries",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
-		client, err := getClient()
+		countries, err := lookupRows(cmd, f, "countries", "list countries",
+			func(b *lookupsBundle) []api.Country { return b.Countries }, (*api.Client).ListCountries)
 		if err != nil {
-			return HandleError(f, err, "initializing client")
-		}
-
-		countries, err := client.ListCountries(cmd.Context())
-		if err != nil {
-			return HandleError(f, err, "list countries")
+			return err
 		}
 
 		return f.OutputFiltered(cmd.Context(), func() {
@@ -664,14 +746,10 @@ var lookupsJobTitlesCmd = &cobra.Command{
 	Short: "List available job titles",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
-		client, err := getClient()
+		jobTitles, err := lookupRows(cmd, f, "job_titles", "list job titles",
+			func(b *lookupsBundle) []api.JobTitle { return b.JobTitles }, (*api.Client).ListJobTitles)
 		if err != nil {
-			return HandleError(f, err, "initializing client")
-		}
-
-		jobTitles, err := client.ListJobTitles(cmd.Context())
-		if err != nil {
-			return HandleError(f, err, "list job titles")
+			return err
 		}
 
 		return f.OutputFiltered(cmd.Context(), func() {
@@ -688,19 +766,25 @@ var lookupsJobTitlesCmd = &cobra.Command{
 	},
 }
 
+var lookupsSeniorityForJobFlag string
+
 var lookupsSeniorityLevelsCmd = &cobra.Command{
-	Use:   "seniority-levels",
-	Short: "List available seniority levels",
+	Use:     "seniority-levels",
+	Short:   "List available seniority levels",
+	Long:    "List seniority levels. With --for-job-title only the levels valid for that job title (see 'org lookups job-titles') are shown.",
+	Example: "  deel org lookups seniority-levels --for-job-title 42",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
-		client, err := getClient()
-		if err != nil {
-			return HandleError(f, err, "initializing client")
+		if lookupsFromFileFlag != "" && lookupsSeniorityForJobFlag != "" {
+			return failValidation(cmd, f, "--for-job-title cannot be used with --from-file (the bundle only has the full list)")
 		}
-
-		levels, err := client.ListSeniorityLevels(cmd.Context())
+		levels, err := lookupRows(cmd, f, "seniority_levels", "list seniority levels",
+			func(b *lookupsBundle) []api.SeniorityLevel { return b.SeniorityLevels },
+			func(client *api.Client, ctx context.Context) ([]api.SeniorityLevel, error) {
+				return listSeniorityLevels(ctx, client, lookupsSeniorityForJobFlag)
+			})
 		if err != nil {
-			return HandleError(f, err, "list seniority levels")
+			return err
 		}
 
 		return f.OutputFiltered(cmd.Context(), func() {
@@ -717,19 +801,24 @@ var lookupsSeniorityLevelsCmd = &cobra.Command{
 	},
 }
 
+// listSeniorityLevels returns every seniority level, or only those valid for
+// jobTitleID when it is set.
+func listSeniorityLevels(ctx context.Context, client *api.Client, jobTitleID string) ([]api.SeniorityLevel, error) {
+	if jobTitleID == "" {
+		return client.ListSeniorityLevels(ctx)
+	}
+	return client.ListSeniorityLevelsForJob(ctx, jobTitleID)
+}
+
 var lookupsTimeOffTypesCmd = &cobra.Command{
 	Use:   "time-off-types",
 	Short: "List available time off types",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
-		client, err := getClient()
+		types, err := lookupRows(cmd, f, "time_off_types", "list time off types",
+			func(b *lookupsBundle) []api.TimeOffType { return b.TimeOffTypes }, (*api.Client).ListTimeOffTypes)
 		if err != nil {
-			return HandleError(f, err, "initializing client")
-		}
-
-		types, err := client.ListTimeOffTypes(cmd.Context())
-		if err != nil {
-			return HandleError(f, err, "list time off types")
+			return err
 		}
 
 		return f.OutputFiltered(cmd.Context(), func() {
@@ -763,7 +852,7 @@ var departmentsListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		resp, err := client.ListDepartments(cmd.Context())
+		resp, err := client.ListDepartments(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "list departments")
 		}
@@ -785,6 +874,8 @@ var departmentsListCmd = &cobra.Command{
 func init() {
 	// Groups command flags
 	groupsListCmd.Flags().IntVar(&groupsLimitFlag, "limit", 100, "Maximum results")
+	groupsListCmd.Flags().StringVar(&groupsCursorFlag, "cursor", "", "Pagination cursor")
+	groupsListCmd.Flags().BoolVar(&groupsAllFlag, "all", false, "Fetch all pages")
 	groupsCreateCmd.Flags().StringVar(&groupNameFlag, "name", "", "Group name (required)")
 	groupsCreateCmd.Flags().StringVar(&groupDescriptionFlag, "description", "", "Group description (optional)")
 
@@ -801,10 +892,15 @@ func init() {
 
 	// Legal entities command flags
 	legalEntitiesListCmd.Flags().IntVar(&legalEntitiesLimitFlag, "limit", 100, "Maximum results")
+	legalEntitiesListCmd.Flags().StringVar(&legalEntitiesCursorFlag, "cursor", "", "Pagination cursor")
+	legalEntitiesListCmd.Flags().BoolVar(&legalEntitiesAllFlag, "all", false, "Fetch all pages")
 	legalEntitiesCreateCmd.Flags().StringVar(&entityNameFlag, "name", "", "Entity name (required)")
-	legalEntitiesCreateCmd.Flags().StringVar(&entityCountryFlag, "country", "", "Country code (required)")
-	legalEntitiesCreateCmd.Flags().StringVar(&entityTypeFlag, "type", "", "Entity type (required)")
+	legalEntitiesCreateCmd.Flags().StringVar(&entityCountryFlag, "country", "", "Country code (required unless --from-entity)")
+	legalEntitiesCreateCmd.Flags().StringVar(&entityTypeFlag, "type", "", "Entity type (required unless --from-entity)")
 	legalEntitiesCreateCmd.Flags().StringVar(&entityRegistrationNumberFlag, "reg-number", "", "Registration number (optional)")
+	legalEntitiesCreateCmd.Flags().StringVar(&entityFromEntityFlag, "from-entity", "", "Copy country and type defaults from an existing legal entity")
+	legalEntitiesCreateCmd.Flags().BoolVar(&entityCopyPayrollFlag, "copy-payroll-settings", false, "Also copy the source entity's payroll settings (requires --from-entity)")
+	legalEntitiesCreateCmd.Flags().StringVar(&entityPayrollCurrencyFlag, "currency", "", "Payroll currency for --copy-payroll-settings (required when the country differs from the source)")
 
 	legalEntitiesUpdateCmd.Flags().StringVar(&entityNameFlag, "name", "", "Entity name")
 	legalEntitiesUpdateCmd.Flags().StringVar(&entityTypeFlag, "type", "", "Entity type")
@@ -817,15 +913,24 @@ func init() {
 	legalEntitiesCmd.AddCommand(legalEntitiesDeleteCmd)
 	legalEntitiesCmd.AddCommand(legalEntitiesPayrollSettingsCmd)
 
+	lookupsSeniorityLevelsCmd.Flags().StringVar(&lookupsSeniorityForJobFlag, "for-job-title", "", "Only show levels valid for this job title ID")
+	for _, c := range []*cobra.Command{lookupsCurrenciesCmd, lookupsCountriesCmd, lookupsJobTitlesCmd, lookupsSeniorityLevelsCmd, lookupsTimeOffTypesCmd} {
+		c.Flags().StringVar(&lookupsFromFileFlag, "from-file", "", "Read from a bundle written by 'org lookups export' instead of the API")
+	}
+	lookupsExportCmd.Flags().StringVar(&lookupsExportOutputFlag, "output-file", "", "Path of the JSON bundle to write (required)")
+
 	// Add lookups subcommands
 	lookupsCmd.AddCommand(lookupsCurrenciesCmd)
 	lookupsCmd.AddCommand(lookupsCountriesCmd)
 	lookupsCmd.AddCommand(lookupsJobTitlesCmd)
 	lookupsCmd.AddCommand(lookupsSeniorityLevelsCmd)
 	lookupsCmd.AddCommand(lookupsTimeOffTypesCmd)
+	lookupsCmd.AddCommand(lookupsExportCmd)
 
 	// org entities flags
 	orgEntitiesCmd.Flags().IntVar(&orgEntitiesLimitFlag, "limit", 100, "Maximum results")
+	orgEntitiesCmd.Flags().StringVar(&orgEntitiesCursorFlag, "cursor", "", "Pagination cursor")
+	orgEntitiesCmd.Flags().BoolVar(&orgEntitiesAllFlag, "all", false, "Fetch all pages")
 
 	// Add departments subcommands
 	departmentsCmd.AddCommand(departmentsListCmd)
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+)
+
+func TestListSeniorityLevels_ForJobTitle(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.Handle("GET", "/rest/v2/lookups/seniorities", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("job_title_id") == "jt-eng" {
+			_, _ = w.Write([]byte(`{"data":[{"id":2,"name":"Mid-Level"},{"id":3,"name":"Senior"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"id":1,"name":"Junior"},{"id":2,"name":"Mid-Level"},{"id":3,"name":"Senior"},{"id":4,"name":"Director"}]}`))
+	})
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	client.SetRetryConfig(0, 0, 0)
+
+	filtered, err := listSeniorityLevels(context.Background(), client, "jt-eng")
+	require.NoError(t, err)
+	assert.Equal(t, []api.SeniorityLevel{{ID: "2", Name: "Mid-Level"}, {ID: "3", Name: "Senior"}}, filtered)
+
+	all, err := listSeniorityLevels(context.Background(), client, "")
+	require.NoError(t, err)
+	assert.Len(t, all, 4)
+	assert.Equal(t, "Junior", all[0].Name)
+}
+
+func TestLegalEntitiesPageFetcher_AllPages(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.Handle("GET", "/rest/v2/legal-entities", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("cursor") == "p2" {
+			_, _ = w.Write([]byte(`{"data":[{"id":"le-3"}],"page":{"total":3}}`))
+			return
+		}
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+		_, _ = w.Write([]byte(`{"data":[{"id":"le-1"},{"id":"le-2"}],"page":{"next":"p2","total":3}}`))
+	})
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	client.SetRetryConfig(0, 0, 0)
+
+	first, page, hasMore, err := collectCursorItems(context.Background(), false, "", 2, legalEntitiesPageFetcher(client))
+	require.NoError(t, err)
+	assert.Len(t, first, 2)
+	assert.True(t, hasMore)
+	assert.Equal(t, "p2", page.Next)
+
+	all, page, hasMore, err := collectCursorItems(context.Background(), true, "", 2, legalEntitiesPageFetcher(client))
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+	assert.False(t, hasMore)
+	assert.Empty(t, page.Next)
+}
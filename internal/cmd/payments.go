@@ -38,7 +38,7 @@ var offCycleListCmd = &cobra.Command{
 			return err
 		}
 
-		payments, page, hasMore, err := collectCursorItems(cmd.Context(), offCycleAllFlag, offCycleCursorFlag, offCycleLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.OffCyclePayment], error) {
+		payments, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), offCycleAllFlag, offCycleCursorFlag, offCycleLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.OffCyclePayment], error) {
 			resp, err := client.ListOffCyclePayments(ctx, api.OffCyclePaymentsListParams{
 				ContractID: offCycleContractFlag,
 				Status:     offCycleStatusFlag,
@@ -139,7 +139,7 @@ var breakdownCmd = &cobra.Command{
 			return HandleError(f, err, "getting payment")
 		}
 
-		breakdown, err := client.GetIndividualPaymentBreakdown(cmd.Context(), args[0])
+		breakdown, err := client.GetIndividualPaymentBreakdown(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "getting payment")
 		}
@@ -182,7 +182,7 @@ var receiptsCmd = &cobra.Command{
 			return err
 		}
 
-		receipts, page, hasMore, err := collectCursorItems(cmd.Context(), receiptsAllFlag, receiptsCursorFlag, receiptsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.DetailedPaymentReceipt], error) {
+		receipts, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), receiptsAllFlag, receiptsCursorFlag, receiptsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.DetailedPaymentReceipt], error) {
 			resp, err := client.ListDetailedPaymentReceipts(ctx, api.DetailedPaymentReceiptsListParams{
 				Limit:      limit,
 				Cursor:     cursor,
@@ -214,6 +214,7 @@ var receiptsCmd = &cobra.Command{
 }
 
 func init() {
+	enableWatch(offCycleListCmd)
 	offCycleListCmd.Flags().StringVar(&offCycleContractFlag, "contract", "", "Filter by contract ID")
 	offCycleListCmd.Flags().StringVar(&offCycleStatusFlag, "status", "", "Filter by status")
 	offCycleListCmd.Flags().IntVar(&offCycleLimitFlag, "limit", 100, "Maximum results")
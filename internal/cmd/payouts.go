@@ -80,7 +80,7 @@ var autoWithdrawalCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		settings, err := client.GetAutoWithdrawal(cmd.Context())
+		settings, err := client.GetAutoWithdrawal(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "get auto-withdrawal settings")
 		}
@@ -186,7 +186,7 @@ var balancesCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		balances, err := client.ListContractorBalances(cmd.Context())
+		balances, err := client.ListContractorBalances(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "list contractor balances")
 		}
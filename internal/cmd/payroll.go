@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
 )
 
 var payrollCmd = &cobra.Command{
@@ -35,7 +37,7 @@ var payrollPayslipsCmd = &cobra.Command{
 		}
 
 		if payrollGPFlag {
-			payslips, err := client.GetGPWorkerPayslips(cmd.Context(), payrollWorkerFlag)
+			payslips, err := client.GetGPWorkerPayslips(api.WithServerFields(cmd.Context()), payrollWorkerFlag)
 			if err != nil {
 				return HandleError(f, err, "get payslips")
 			}
@@ -56,7 +58,7 @@ var payrollPayslipsCmd = &cobra.Command{
 			}, payslips)
 		}
 
-		payslips, err := client.GetEORWorkerPayslips(cmd.Context(), payrollWorkerFlag)
+		payslips, err := client.GetEORWorkerPayslips(api.WithServerFields(cmd.Context()), payrollWorkerFlag)
 		if err != nil {
 			return HandleError(f, err, "get payslips")
 		}
@@ -93,7 +95,7 @@ var payrollPaymentsCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		breakdown, err := client.GetPaymentBreakdown(cmd.Context(), payrollCycleFlag)
+		breakdown, err := client.GetPaymentBreakdown(api.WithServerFields(cmd.Context()), payrollCycleFlag)
 		if err != nil {
 			return HandleError(f, err, "get breakdown")
 		}
@@ -117,7 +119,7 @@ var payrollReceiptsCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		receipts, err := client.ListPaymentReceipts(cmd.Context(), payrollLimitFlag)
+		receipts, err := client.ListPaymentReceipts(api.WithServerFields(cmd.Context()), payrollLimitFlag)
 		if err != nil {
 			return HandleError(f, err, "list receipts")
 		}
@@ -171,6 +173,7 @@ var payrollDownloadCmd = &cobra.Command{
 }
 
 func init() {
+	enableWatch(payrollPaymentsCmd)
 	payrollPayslipsCmd.Flags().StringVar(&payrollWorkerFlag, "worker", "", "Worker ID (required)")
 	payrollPayslipsCmd.Flags().BoolVar(&payrollGPFlag, "gp", false, "Use Global Payroll API")
 
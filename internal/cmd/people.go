@@ -4,13 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/salmonumbrella/deel-cli/internal/api"
 	"github.com/salmonumbrella/deel-cli/internal/dryrun"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
 )
 
 var peopleCmd = &cobra.Command{
@@ -42,25 +45,80 @@ var (
 )
 
 var (
-	peopleLimitFlag  int
-	peopleCursorFlag string
-	peopleAllFlag    bool
-	peopleLightFlag  bool
+	peopleLimitFlag    int
+	peopleCursorFlag   string
+	peopleAllFlag      bool
+	peopleLightFlag    bool
+	peopleDeptFlag     string
+	peopleByDeptFlag   bool
+	peopleActiveOnFlag string
+	peopleCountFlag    bool
 )
 
+// tenureSortKey computes the "tenure" --sort-by key for people: whole days
+// since start_date as of now.
+func tenureSortKey(now time.Time) outfmt.SortKeyFunc {
+	return func(item map[string]any) any {
+		start, _ := item["start_date"].(string)
+		days, ok := tenureDays(start, now)
+		if !ok {
+			return nil
+		}
+		return float64(days)
+	}
+}
+
+// parseStartDate parses a YYYY-MM-DD or RFC3339 start date.
+func parseStartDate(s string) (time.Time, bool) {
+	if len(s) < len(dateFormat) {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(dateFormat, s[:len(dateFormat)])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// tenureDays returns the number of whole days between startDate and now.
+func tenureDays(startDate string, now time.Time) (int, bool) {
+	start, ok := parseStartDate(startDate)
+	if !ok {
+		return 0, false
+	}
+	return int(now.Sub(start).Hours() / 24), true
+}
+
 var peopleListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all people",
 	Long: `List all people in your organization.
 
 Tip: To find someone by name, use 'deel people search --name "Name"' instead.`,
+	Example: "  deel people list --all --sort-by tenure --sort-desc\n  deel people list --department Engineering --all\n  deel people list --by-department\n  deel people list --department Engineering --count\n  deel people list --all --active-on 2024-01-01 --by-department",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		client, f, err := initClient("listing people")
+		f := getFormatter()
+
+		if peopleCountFlag && peopleByDeptFlag {
+			return failValidation(cmd, f, "--count cannot be used with --by-department")
+		}
+
+		var activeOn time.Time
+		if peopleActiveOnFlag != "" {
+			if err := validateDate(peopleActiveOnFlag); err != nil {
+				return failValidation(cmd, f, "invalid --active-on: "+err.Error())
+			}
+			activeOn, _ = time.Parse(dateFormat, peopleActiveOnFlag)
+		}
+
+		f.SetSortKey("tenure", tenureSortKey(time.Now()))
+
+		client, err := getClient()
 		if err != nil {
-			return err
+			return HandleError(f, err, "listing people")
 		}
 
-		people, page, hasMore, err := collectCursorItems(cmd.Context(), peopleAllFlag, peopleCursorFlag, peopleLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.Person], error) {
+		fetch := func(ctx context.Context, cursor string, limit int) (CursorListResult[api.Person], error) {
 			resp, err := client.ListPeople(ctx, api.PeopleListParams{
 				Limit:  limit,
 				Cursor: cursor,
@@ -75,11 +133,81 @@ Tip: To find someone by name, use 'deel people search --name "Name"' instead.`,
 					Total: resp.Page.Total,
 				},
 			}, nil
-		})
+		}
+
+		if peopleCountFlag || peopleByDeptFlag {
+			// Counts cover every page and are accumulated as pages arrive,
+			// so no people are kept in memory.
+			var undated []string
+			keep := func(p *api.Person) bool {
+				if peopleDeptFlag != "" && !personInDepartment(p, peopleDeptFlag) {
+					return false
+				}
+				if peopleActiveOnFlag != "" {
+					active, dated := personActiveOn(p, activeOn)
+					if !dated {
+						undated = append(undated, p.HRISProfileID)
+					}
+					return active
+				}
+				return true
+			}
+
+			var (
+				count   int
+				summary = departmentHeadcount{ByDepartment: map[string]int{}}
+				hasMore bool
+			)
+			if peopleCountFlag {
+				count, _, hasMore, err = countCursorItems(api.WithServerFields(cmd.Context(), peopleListNeededFields()...), true, peopleCursorFlag, peopleLimitFlag, fetch, keep)
+			} else {
+				_, hasMore, err = walkCursorPages(api.WithServerFields(cmd.Context(), peopleListNeededFields()...), true, peopleCursorFlag, peopleLimitFlag, fetch, func(batch []api.Person) error {
+					for i := range batch {
+						if keep(&batch[i]) {
+							summary.add(&batch[i])
+						}
+					}
+					return nil
+				})
+			}
+			if err != nil {
+				return HandleError(f, err, "listing people")
+			}
+			if len(undated) > 0 {
+				return HandleError(f, activeOnUndatedError(undated), "listing people")
+			}
+			if peopleActiveOnFlag != "" {
+				f.PrintWarning(activeOnCoverageWarning)
+			}
+			if hasMore {
+				f.PrintWarning("Stopped at --max-pages; counts cover only the fetched people")
+			}
+			if peopleCountFlag {
+				return outputCount(cmd, f, count)
+			}
+			return outputDepartmentHeadcount(cmd, f, summary)
+		}
+
+		people, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context(), peopleListNeededFields()...), peopleAllFlag, peopleCursorFlag, peopleLimitFlag, fetch)
 		if err != nil {
 			return HandleError(f, err, "listing people")
 		}
 
+		if peopleDeptFlag != "" {
+			people = filterPeopleByDepartment(people, peopleDeptFlag)
+		}
+		if peopleActiveOnFlag != "" {
+			var undated []string
+			people, undated = filterPeopleActiveOn(people, activeOn)
+			if len(undated) > 0 {
+				return HandleError(f, activeOnUndatedError(undated), "listing people")
+			}
+			f.PrintWarning(activeOnCoverageWarning)
+			if hasMore {
+				f.PrintWarning("--active-on filters only the fetched page; use --all for the full roster")
+			}
+		}
+
 		if peopleAllFlag {
 			page.Total = len(people)
 		}
@@ -99,7 +227,158 @@ Tip: To find someone by name, use 'deel people search --name "Name"' instead.`,
 	},
 }
 
-var peoplePersonalFlag bool
+// peopleListNeededFields names the person fields people list reads itself
+// for the active filters, counts, and computed sort, so --server-fields
+// cannot drop them from the request.
+func peopleListNeededFields() []string {
+	var fields []string
+	if peopleDeptFlag != "" || peopleByDeptFlag {
+		fields = append(fields, "department")
+	}
+	if peopleActiveOnFlag != "" {
+		fields = append(fields, "hris_profile_id", "start_date", "termination_date", "end_date", "employments")
+	}
+	if sortByFlag == "tenure" {
+		fields = append(fields, "start_date")
+	}
+	return fields
+}
+
+// activeOnCoverageWarning notes that --active-on rosters are built from
+// the people list, which may not include every former worker.
+const activeOnCoverageWarning = "--active-on covers only people the people endpoint returns; former workers it no longer lists are not included"
+
+// filterPeopleByDepartment keeps people whose department name matches dept,
+// case-insensitively.
+func filterPeopleByDepartment(people []api.Person, dept string) []api.Person {
+	filtered := make([]api.Person, 0, len(people))
+	for i := range people {
+		if personInDepartment(&people[i], dept) {
+			filtered = append(filtered, people[i])
+		}
+	}
+	return filtered
+}
+
+func personInDepartment(p *api.Person, dept string) bool {
+	return strings.EqualFold(strings.TrimSpace(p.Department()), strings.TrimSpace(dept))
+}
+
+// filterPeopleActiveOn keeps people whose employment window contains on:
+// started on or before it and ended on or after it, or not ended at all.
+// undated holds the IDs of people whose window cannot be placed (no start
+// date, or ended employment without an end date); they are not kept.
+func filterPeopleActiveOn(people []api.Person, on time.Time) (active []api.Person, undated []string) {
+	active = make([]api.Person, 0, len(people))
+	for i := range people {
+		ok, dated := personActiveOn(&people[i], on)
+		if !dated {
+			undated = append(undated, people[i].HRISProfileID)
+			continue
+		}
+		if ok {
+			active = append(active, people[i])
+		}
+	}
+	return active, undated
+}
+
+// personActiveOn reports whether p was employed on on. dated is false when
+// p's employment window cannot be placed; active is then false too.
+// Whether employment ended comes from the employments' is_ended flags; the
+// end date itself is only used when the API includes one.
+func personActiveOn(p *api.Person, on time.Time) (active, dated bool) {
+	start, ok := parseStartDate(p.StartDate)
+	if !ok {
+		return false, false
+	}
+	if start.After(on) {
+		return false, true
+	}
+	if !personEnded(p) {
+		return true, true
+	}
+	endDate := p.TerminationDate
+	if endDate == "" {
+		endDate = p.EndDate
+	}
+	end, ok := parseStartDate(endDate)
+	if !ok {
+		return false, false
+	}
+	return !end.Before(on), true
+}
+
+// personEnded reports whether all of p's employments have ended.
+func personEnded(p *api.Person) bool {
+	if len(p.Employments) == 0 {
+		return false
+	}
+	for _, e := range p.Employments {
+		if !e.IsEnded {
+			return false
+		}
+	}
+	return true
+}
+
+// activeOnUndatedError is returned when --active-on cannot place some
+// people, rather than guessing whether they were employed on the date.
+func activeOnUndatedError(undated []string) error {
+	examples := undated
+	if len(examples) > 3 {
+		examples = examples[:3]
+	}
+	return fmt.Errorf("--active-on cannot place %d people whose start date, or end date for ended employment, is missing from the API response (e.g. %s)",
+		len(undated), strings.Join(examples, ", "))
+}
+
+// departmentHeadcount is the --by-department output of people list.
+type departmentHeadcount struct {
+	Total        int            `json:"total"`
+	ByDepartment map[string]int `json:"byDepartment"`
+}
+
+// summarizeDepartments counts people per department. People without a
+// department count as "unknown".
+func summarizeDepartments(people []api.Person) departmentHeadcount {
+	summary := departmentHeadcount{ByDepartment: map[string]int{}}
+	for i := range people {
+		summary.add(&people[i])
+	}
+	return summary
+}
+
+func (s *departmentHeadcount) add(p *api.Person) {
+	s.Total++
+	s.ByDepartment[valueOrUnknown(p.Department())]++
+}
+
+func outputDepartmentHeadcount(cmd *cobra.Command, f *outfmt.Formatter, summary departmentHeadcount) error {
+	return f.OutputFiltered(cmd.Context(), func() {
+		if summary.Total == 0 {
+			f.PrintText("No people found.")
+			return
+		}
+		departments := make([]string, 0, len(summary.ByDepartment))
+		for dept := range summary.ByDepartment {
+			departments = append(departments, dept)
+		}
+		sort.Strings(departments)
+
+		table := f.NewTable("DEPARTMENT", "HEADCOUNT")
+		for _, dept := range departments {
+			table.AddRow(dept, fmt.Sprintf("%d", summary.ByDepartment[dept]))
+		}
+		table.AddRow("TOTAL", fmt.Sprintf("%d", summary.Total))
+		table.Render()
+	}, summary)
+}
+
+var (
+	peoplePersonalFlag            bool
+	peopleIncludeCompensationFlag bool
+)
 
 var peopleGetCmd = &cobra.Command{
 	Use:   "get <hris-profile-id>",
@@ -107,6 +386,9 @@ var peopleGetCmd = &cobra.Command{
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
+		if peoplePersonalFlag && peopleIncludeCompensationFlag {
+			return failValidation(cmd, f, "cannot use --personal and --include-compensation together")
+		}
 		client, err := getClient()
 		if err != nil {
 			return HandleError(f, err, "getting person")
@@ -114,7 +396,7 @@ var peopleGetCmd = &cobra.Command{
 
 		// If --personal flag is set, use the /personal endpoint
 		if peoplePersonalFlag {
-			rawData, err := client.GetPersonPersonal(cmd.Context(), args[0])
+			rawData, err := client.GetPersonPersonal(api.WithServerFields(cmd.Context()), args[0])
 			if err != nil {
 				return HandleError(f, err, "getting personal info")
 			}
@@ -141,7 +423,12 @@ var peopleGetCmd = &cobra.Command{
 			}, data)
 		}
 
-		person, err := client.GetPerson(cmd.Context(), args[0])
+		var needed []string
+		if peopleIncludeCompensationFlag {
+			// The active contracts are found through the employments.
+			needed = append(needed, "employments")
+		}
+		person, err := client.GetPerson(api.WithServerFields(cmd.Context(), needed...), args[0])
 		if err != nil {
 			return HandleError(f, err, "getting person")
 		}
@@ -151,6 +438,18 @@ var peopleGetCmd = &cobra.Command{
 			jsonPayload = toLightPerson(*person)
 		}
 
+		var compensation []compensationSummary
+		if peopleIncludeCompensationFlag {
+			compensation, err = fetchCompensation(cmd.Context(), client, *person)
+			if err != nil {
+				return HandleError(f, err, "getting compensation")
+			}
+			jsonPayload, err = withCompensation(jsonPayload, compensation)
+			if err != nil {
+				return HandleError(f, err, "getting compensation")
+			}
+		}
+
 		return f.OutputFiltered(cmd.Context(), func() {
 			f.PrintText("Name:       " + person.Name)
 			f.PrintText("Email:      " + person.Email)
@@ -159,6 +458,17 @@ var peopleGetCmd = &cobra.Command{
 			f.PrintText("Status:     " + person.Status)
 			f.PrintText("Country:    " + person.Country)
 			f.PrintText("Start Date: " + person.StartDate)
+			if peopleIncludeCompensationFlag {
+				f.PrintText("")
+				if len(compensation) == 0 {
+					f.PrintText("Compensation: no active contracts")
+					return
+				}
+				f.PrintText("Compensation:")
+				for _, c := range compensation {
+					f.PrintText(fmt.Sprintf("  %s  %s", c.ContractID, formatCompensation(c)))
+				}
+			}
 		}, jsonPayload)
 	},
 }
@@ -282,7 +592,7 @@ var customFieldsListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		fields, err := client.ListCustomFields(cmd.Context())
+		fields, err := client.ListCustomFields(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "list custom fields")
 		}
@@ -312,7 +622,7 @@ var customFieldsGetCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		field, err := client.GetCustomField(cmd.Context(), args[0])
+		field, err := client.GetCustomField(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "get custom field")
 		}
@@ -411,16 +721,26 @@ var (
 	peopleUpdateLastNameFlag    string
 	peopleUpdatePhoneFlag       string
 	peopleUpdateNationalityFlag string
+	peopleUpdateEffectiveFlag   string
 )
 
 var peopleUpdateCmd = &cobra.Command{
 	Use:   "update <id>",
 	Short: "Update personal info",
-	Long:  "Update personal information for a person. Optional flags: --first-name, --last-name, --phone, --nationality.",
-	Args:  cobra.ExactArgs(1),
+	Long: `Update personal information for a person. Optional flags: --first-name, --last-name, --phone, --nationality.
+
+Use --effective-date YYYY-MM-DD (today or later) to schedule the change instead
+of applying it immediately.`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 
+		if peopleUpdateEffectiveFlag != "" {
+			if err := validateEffectiveDate(peopleUpdateEffectiveFlag, time.Now()); err != nil {
+				return failValidation(cmd, f, "--effective-date: "+err.Error())
+			}
+		}
+
 		details := map[string]string{
 			"ID": args[0],
 		}
@@ -436,6 +756,9 @@ var peopleUpdateCmd = &cobra.Command{
 		if peopleUpdateNationalityFlag != "" {
 			details["Nationality"] = peopleUpdateNationalityFlag
 		}
+		if peopleUpdateEffectiveFlag != "" {
+			details["EffectiveDate"] = peopleUpdateEffectiveFlag
+		}
 		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
 			Operation:   "UPDATE",
 			Resource:    "Person",
@@ -451,10 +774,11 @@ var peopleUpdateCmd = &cobra.Command{
 		}
 
 		info := api.PersonalInfo{
-			FirstName:   peopleUpdateFirstNameFlag,
-			LastName:    peopleUpdateLastNameFlag,
-			Phone:       peopleUpdatePhoneFlag,
-			Nationality: peopleUpdateNationalityFlag,
+			FirstName:     peopleUpdateFirstNameFlag,
+			LastName:      peopleUpdateLastNameFlag,
+			Phone:         peopleUpdatePhoneFlag,
+			Nationality:   peopleUpdateNationalityFlag,
+			EffectiveDate: peopleUpdateEffectiveFlag,
 		}
 
 		updated, err := client.UpdatePersonalInfo(cmd.Context(), args[0], info)
@@ -463,7 +787,11 @@ var peopleUpdateCmd = &cobra.Command{
 		}
 
 		return f.OutputFiltered(cmd.Context(), func() {
-			f.PrintSuccess("Personal info updated successfully")
+			if peopleUpdateEffectiveFlag != "" {
+				f.PrintSuccess("Personal info change scheduled for %s", peopleUpdateEffectiveFlag)
+			} else {
+				f.PrintSuccess("Personal info updated successfully")
+			}
 			if updated.ID != "" {
 				f.PrintText("ID:          " + updated.ID)
 			}
@@ -655,7 +983,7 @@ var adjustmentsListCmd = &cobra.Command{
 			CategoryID: adjustmentsListCategoryIDFlag,
 		}
 
-		adjustments, err := client.ListAdjustments(cmd.Context(), params)
+		adjustments, err := client.ListAdjustments(api.WithServerFields(cmd.Context()), params)
 		if err != nil {
 			return HandleError(f, err, "list adjustments")
 		}
@@ -688,6 +1016,7 @@ var (
 	adjustmentsCreateCategoryIDFlag     string
 	adjustmentsCreateAmountFlag         string
 	adjustmentsCreateCurrencyFlag       string
+	adjustmentsCreateSkipCurrencyFlag   bool
 	adjustmentsCreateDescriptionFlag    string
 	adjustmentsCreateDateFlag           string
 	adjustmentsCreateCycleReferenceFlag string
@@ -723,9 +1052,13 @@ var adjustmentsCreateCmd = &cobra.Command{
 		}
 
 		// Parse amount
-		amount, err := strconv.ParseFloat(adjustmentsCreateAmountFlag, 64)
+		amount, err := parsePositiveAmount(adjustmentsCreateAmountFlag, "--amount")
 		if err != nil {
-			return failValidation(cmd, f, fmt.Sprintf("Invalid --amount value: %v", err))
+			return failValidation(cmd, f, err.Error())
+		}
+
+		if err := checkCurrency(cmd.Context(), f, getClient, adjustmentsCreateCurrencyFlag, adjustmentsCreateSkipCurrencyFlag); err != nil {
+			return failValidation(cmd, f, err.Error())
 		}
 
 		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
@@ -801,7 +1134,7 @@ var adjustmentsGetCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		adjustment, err := client.GetAdjustment(cmd.Context(), args[0])
+		adjustment, err := client.GetAdjustment(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "get adjustment")
 		}
@@ -904,7 +1237,7 @@ var adjustmentsCategoriesCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		categories, err := client.ListAdjustmentCategories(cmd.Context())
+		categories, err := client.ListAdjustmentCategories(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "list adjustment categories")
 		}
@@ -978,7 +1311,7 @@ var managersListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		managers, err := client.ListManagers(cmd.Context())
+		managers, err := client.ListManagers(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "list managers")
 		}
@@ -1262,7 +1595,7 @@ var relationsListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		relations, err := client.ListWorkerRelations(cmd.Context(), args[0])
+		relations, err := client.ListWorkerRelations(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "list worker relations")
 		}
@@ -1386,11 +1719,16 @@ var relationsDeleteCmd = &cobra.Command{
 }
 
 func init() {
+	enableWatch(peopleListCmd)
 	peopleListCmd.Flags().IntVar(&peopleLimitFlag, "limit", 100, "Maximum results")
 	peopleListCmd.Flags().StringVar(&peopleCursorFlag, "cursor", "", "Pagination cursor")
 	peopleListCmd.Flags().BoolVar(&peopleAllFlag, "all", false, "Fetch all pages")
 	peopleListCmd.Flags().BoolVar(&peopleLightFlag, "light", false, "Minimal payload (saves tokens)")
 	flagAlias(peopleListCmd.Flags(), "light", "li")
+	peopleListCmd.Flags().StringVar(&peopleDeptFlag, "department", "", "Filter by department name, case-insensitive (client-side; applies to fetched pages, use --all for everyone)")
+	peopleListCmd.Flags().StringVar(&peopleActiveOnFlag, "active-on", "", "Only people employed on this date, YYYY-MM-DD (start date <= date <= end date, or employment not ended; client-side, fails when an ended employment has no end date)")
+	peopleListCmd.Flags().BoolVar(&peopleByDeptFlag, "by-department", false, "Print headcount per department instead of rows (fetches all pages)")
+	peopleListCmd.Flags().BoolVar(&peopleCountFlag, "count", false, "Print only the number of matching people (fetches all pages without keeping them)")
 
 	peopleSearchCmd.Flags().StringVar(&peopleEmailFlag, "email", "", "Email to search for (exact match)")
 	peopleSearchCmd.Flags().StringVar(&peopleNameFlag, "name", "", "Name to search for (partial match, case-insensitive)")
@@ -1399,6 +1737,7 @@ func init() {
 	// People get command flags
 	peopleGetCmd.Flags().BoolVar(&peoplePersonalFlag, "personal", false, "Get personal info including numeric worker_id")
 	peopleGetCmd.Flags().BoolVar(&peopleLightFlag, "light", false, "Minimal payload (saves tokens)")
+	peopleGetCmd.Flags().BoolVar(&peopleIncludeCompensationFlag, "include-compensation", false, "Include current salary/rate from each active contract")
 	flagAlias(peopleGetCmd.Flags(), "light", "li")
 
 	// People create command flags
@@ -1413,6 +1752,7 @@ func init() {
 	peopleUpdateCmd.Flags().StringVar(&peopleUpdateLastNameFlag, "last-name", "", "Last name (optional)")
 	peopleUpdateCmd.Flags().StringVar(&peopleUpdatePhoneFlag, "phone", "", "Phone number (optional)")
 	peopleUpdateCmd.Flags().StringVar(&peopleUpdateNationalityFlag, "nationality", "", "Nationality (optional)")
+	peopleUpdateCmd.Flags().StringVar(&peopleUpdateEffectiveFlag, "effective-date", "", "Schedule the change for this date, YYYY-MM-DD (today or later; default: now)")
 
 	// Set-department command flags
 	setDepartmentCmd.Flags().StringVar(&setDepartmentIDFlag, "department-id", "", "Department ID (required)")
@@ -1434,6 +1774,7 @@ func init() {
 	adjustmentsCreateCmd.Flags().StringVar(&adjustmentsCreateCategoryIDFlag, "category-id", "", "Category ID (required)")
 	adjustmentsCreateCmd.Flags().StringVar(&adjustmentsCreateAmountFlag, "amount", "", "Amount (required)")
 	adjustmentsCreateCmd.Flags().StringVar(&adjustmentsCreateCurrencyFlag, "currency", "", "Currency code (required)")
+	adjustmentsCreateCmd.Flags().BoolVar(&adjustmentsCreateSkipCurrencyFlag, "skip-currency-check", false, "Skip checking --currency against the supported currency list (e.g. offline)")
 	adjustmentsCreateCmd.Flags().StringVar(&adjustmentsCreateDescriptionFlag, "description", "", "Description (required)")
 	adjustmentsCreateCmd.Flags().StringVar(&adjustmentsCreateDateFlag, "date", "", "Date YYYY-MM-DD (required)")
 	adjustmentsCreateCmd.Flags().StringVar(&adjustmentsCreateCycleReferenceFlag, "cycle-reference", "", "Payroll cycle reference (optional)")
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+)
+
+// compensationSummary is the current pay on one active contract, nested under
+// "compensation" in `people get --include-compensation` output.
+type compensationSummary struct {
+	ContractID   string  `json:"contract_id"`
+	Title        string  `json:"title,omitempty"`
+	Type         string  `json:"type,omitempty"`
+	Kind         string  `json:"kind"` // salary or rate
+	Amount       float64 `json:"amount"`
+	Currency     string  `json:"currency"`
+	Scale        string  `json:"scale,omitempty"`
+	PayFrequency string  `json:"pay_frequency,omitempty"`
+}
+
+// endedContractStatuses are employment statuses that no longer pay the worker.
+var endedContractStatuses = map[string]bool{
+	"terminated": true,
+	"cancelled":  true,
+	"completed":  true,
+	"rejected":   true,
+}
+
+// activeContractIDs returns the contract IDs of a person's active employments.
+func activeContractIDs(person api.Person) []string {
+	var ids []string
+	for _, e := range person.Employments {
+		if e.ID == "" || e.IsEnded || endedContractStatuses[strings.ToLower(e.ContractStatus)] {
+			continue
+		}
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+func summarizeCompensation(c api.Contract) compensationSummary {
+	kind := "salary"
+	switch strings.ToLower(c.CompensationScale) {
+	case "hourly", "daily", "weekly", "hour", "day", "week":
+		kind = "rate"
+	}
+	return compensationSummary{
+		ContractID:   c.ID,
+		Title:        c.Title,
+		Type:         c.Type,
+		Kind:         kind,
+		Amount:       c.CompensationAmount,
+		Currency:     c.Currency,
+		Scale:        c.CompensationScale,
+		PayFrequency: c.PayFrequency,
+	}
+}
+
+// fetchCompensation loads each active contract and summarizes its pay.
+func fetchCompensation(ctx context.Context, client *api.Client, person api.Person) ([]compensationSummary, error) {
+	ids := activeContractIDs(person)
+	summaries := make([]compensationSummary, 0, len(ids))
+	for _, id := range ids {
+		contract, err := client.GetContract(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("contract %s: %w", id, err)
+		}
+		summaries = append(summaries, summarizeCompensation(*contract))
+	}
+	return summaries, nil
+}
+
+// withCompensation nests the summaries under "compensation" in a person payload.
+func withCompensation(payload any, summaries []compensationSummary) (map[string]any, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	out["compensation"] = summaries
+	return out, nil
+}
+
+// formatCompensation renders one summary for text output, e.g.
+// "85000.00 USD annual (salary, paid monthly)".
+func formatCompensation(s compensationSummary) string {
+	amount := fmt.Sprintf("%.2f %s", s.Amount, s.Currency)
+	if s.Scale != "" {
+		amount += " " + s.Scale
+	}
+	detail := s.Kind
+	if s.PayFrequency != "" {
+		detail += ", paid " + s.PayFrequency
+	}
+	return fmt.Sprintf("%s (%s)", amount, detail)
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+)
+
+func contractResponse(id, title, amount, currency, scale, frequency string) map[string]any {
+	return map[string]any{
+		"data": map[string]any{
+			"id":    id,
+			"title": title,
+			"compensation_details": map[string]any{
+				"amount":        amount,
+				"currency_code": currency,
+				"scale":         scale,
+				"frequency":     frequency,
+			},
+		},
+	}
+}
+
+func TestActiveContractIDs(t *testing.T) {
+	person := api.Person{Employments: []api.Employment{
+		{ID: "c1", ContractStatus: "in_progress"},
+		{ID: "c2", IsEnded: true},
+		{ID: "c3", ContractStatus: "Terminated"},
+		{ID: "c4", ContractStatus: "active"},
+	}}
+	assert.Equal(t, []string{"c1", "c4"}, activeContractIDs(person))
+}
+
+func TestFetchCompensation_SingleContract(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.HandleJSON("GET", "/rest/v2/contracts/c1", http.StatusOK, contractResponse("c1", "Engineer", "85000", "USD", "annual", "monthly"))
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+
+	person := api.Person{Employments: []api.Employment{{ID: "c1", ContractStatus: "in_progress"}}}
+	summaries, err := fetchCompensation(context.Background(), client, person)
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, compensationSummary{
+		ContractID:   "c1",
+		Title:        "Engineer",
+		Kind:         "salary",
+		Amount:       85000,
+		Currency:     "USD",
+		Scale:        "annual",
+		PayFrequency: "monthly",
+	}, summaries[0])
+	assert.Equal(t, "85000.00 USD annual (salary, paid monthly)", formatCompensation(summaries[0]))
+
+	payload, err := withCompensation(api.Person{Name: "Ada"}, summaries)
+	require.NoError(t, err)
+	assert.Equal(t, "Ada", payload["name"])
+	assert.Equal(t, summaries, payload["compensation"])
+}
+
+func TestFetchCompensation_MultipleContracts(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.HandleJSON("GET", "/rest/v2/contracts/c1", http.StatusOK, contractResponse("c1", "Engineer", "60000", "EUR", "annual", "monthly"))
+	server.HandleJSON("GET", "/rest/v2/contracts/c2", http.StatusOK, contractResponse("c2", "Consulting", "75", "USD", "hourly", "biweekly"))
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+
+	person := api.Person{Employments: []api.Employment{
+		{ID: "c1", ContractStatus: "in_progress"},
+		{ID: "c2", ContractStatus: "in_progress"},
+		{ID: "c3", IsEnded: true},
+	}}
+	summaries, err := fetchCompensation(context.Background(), client, person)
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+	assert.Equal(t, "c1", summaries[0].ContractID)
+	assert.Equal(t, "salary", summaries[0].Kind)
+	assert.Equal(t, "c2", summaries[1].ContractID)
+	assert.Equal(t, "rate", summaries[1].Kind)
+	assert.Equal(t, 75.0, summaries[1].Amount)
+	assert.Equal(t, "biweekly", summaries[1].PayFrequency)
+}
@@ -1,10 +1,18 @@
 package cmd
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
 )
 
 func TestPeopleGetCmd_HasPersonalFlagWithCorrectDefaults(t *testing.T) {
@@ -14,3 +22,153 @@ func TestPeopleGetCmd_HasPersonalFlagWithCorrectDefaults(t *testing.T) {
 	assert.Equal(t, "false", flag.DefValue)
 	assert.Contains(t, flag.Usage, "personal info")
 }
+
+func TestPeopleListCmd_UsesGlobalSortFlags(t *testing.T) {
+	// A local --sort-by would shadow the global one and its column handling.
+	assert.Nil(t, peopleListCmd.LocalNonPersistentFlags().Lookup("sort-by"))
+	assert.Nil(t, peopleListCmd.LocalNonPersistentFlags().Lookup("sort-desc"))
+	assert.NotNil(t, peopleListCmd.InheritedFlags().Lookup("sort-by"))
+}
+
+func TestTenureSortKey(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	key := tenureSortKey(now)
+
+	assert.Equal(t, float64(31), key(map[string]any{"start_date": "2026-05-01"}))
+	assert.Equal(t, float64(31), key(map[string]any{"start_date": "2026-05-01T00:00:00Z"}))
+	assert.Nil(t, key(map[string]any{"name": "Unknown"}))
+}
+
+func TestTenureDays(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	days, ok := tenureDays("2026-05-01", now)
+	require.True(t, ok)
+	assert.Equal(t, 31, days)
+
+	_, ok = tenureDays("", now)
+	assert.False(t, ok)
+}
+
+func TestFilterPeopleByDepartment(t *testing.T) {
+	people := []api.Person{
+		{Name: "Ada", DepartmentRaw: "Engineering"},
+		{Name: "Grace", DepartmentRaw: map[string]any{"name": "engineering"}},
+		{Name: "Linus", DepartmentRaw: "Sales"},
+		{Name: "Nobody"},
+	}
+
+	names := func(ps []api.Person) []string {
+		out := make([]string, len(ps))
+		for i, p := range ps {
+			out[i] = p.Name
+		}
+		return out
+	}
+
+	assert.Equal(t, []string{"Ada", "Grace"}, names(filterPeopleByDepartment(people, "Engineering")))
+	assert.Equal(t, []string{"Linus"}, names(filterPeopleByDepartment(people, " sales ")))
+	assert.Empty(t, filterPeopleByDepartment(people, "Finance"))
+}
+
+func TestFilterPeopleActiveOn(t *testing.T) {
+	on := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ended := []api.Employment{{IsEnded: true}}
+	people := []api.Person{
+		{HRISProfileID: "p1", Name: "Ada", StartDate: "2021-03-01", Employments: []api.Employment{{IsEnded: false}}},
+		{HRISProfileID: "p2", Name: "Grace", StartDate: "2020-05-01T00:00:00Z", TerminationDate: "2023-06-30", Employments: ended},
+		{HRISProfileID: "p3", Name: "Linus", StartDate: "2024-02-01"},
+		{HRISProfileID: "p4", Name: "Margaret", StartDate: "2022-01-10", EndDate: "2024-01-01", Employments: ended},
+		{HRISProfileID: "p5", Name: "Ken", StartDate: "2024-01-01", Status: "offboarded"},
+		{HRISProfileID: "p6", Name: "Barbara"},
+		{HRISProfileID: "p7", Name: "Dennis", StartDate: "2019-01-01", Employments: ended},
+		{HRISProfileID: "p8", Name: "Alan", StartDate: "2019-01-01", Employments: []api.Employment{{IsEnded: true}, {IsEnded: false}}},
+	}
+
+	active, undated := filterPeopleActiveOn(people, on)
+	var names []string
+	for _, p := range active {
+		names = append(names, p.Name)
+	}
+	// Grace ended and Linus started outside the date; the window is inclusive
+	// at both ends. Ken's status is not read: only is_ended marks an end.
+	// Alan still has an employment running. Barbara has no start date and
+	// Dennis ended on an unknown date, so neither can be placed.
+	assert.Equal(t, []string{"Ada", "Margaret", "Ken", "Alan"}, names)
+	assert.Equal(t, []string{"p6", "p7"}, undated)
+}
+
+func TestActiveOnUndatedError(t *testing.T) {
+	err := activeOnUndatedError([]string{"p1", "p2", "p3", "p4"})
+	assert.EqualError(t, err, "--active-on cannot place 4 people whose start date, or end date for ended employment, is missing from the API response (e.g. p1, p2, p3)")
+}
+
+func TestSummarizeDepartments(t *testing.T) {
+	people := []api.Person{
+		{DepartmentRaw: "Engineering"},
+		{DepartmentRaw: map[string]any{"name": "Engineering"}},
+		{DepartmentRaw: "Sales"},
+		{DepartmentRaw: "Engineering"},
+		{},
+	}
+
+	summary := summarizeDepartments(people)
+	assert.Equal(t, 5, summary.Total)
+	assert.Equal(t, map[string]int{"Engineering": 3, "Sales": 1, "unknown": 1}, summary.ByDepartment)
+
+	empty := summarizeDepartments(nil)
+	assert.Equal(t, 0, empty.Total)
+	assert.Empty(t, empty.ByDepartment)
+}
+
+func TestPeopleUpdateCmd_RejectsPastEffectiveDate(t *testing.T) {
+	defer func() { peopleUpdateFirstNameFlag, peopleUpdateEffectiveFlag = "", "" }()
+
+	rootCmd.SetArgs([]string{"people", "update", "p-123", "--first-name", "Jane", "--effective-date", "2000-01-01"})
+	err := rootCmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--effective-date: effective date 2000-01-01 is in the past")
+}
+
+func TestPeopleListNeededFields(t *testing.T) {
+	assert.Empty(t, peopleListNeededFields())
+
+	peopleDeptFlag, peopleActiveOnFlag, sortByFlag = "Engineering", "2024-01-01", "tenure"
+	t.Cleanup(func() { peopleDeptFlag, peopleActiveOnFlag, sortByFlag = "", "", "" })
+	fields := peopleListNeededFields()
+	for _, want := range []string{"department", "start_date", "termination_date", "end_date", "employments"} {
+		assert.Contains(t, fields, want)
+	}
+}
+
+func TestPeopleList_SortByTenureDesc(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	var out, errOut bytes.Buffer
+	f := outfmt.New(&out, &errOut, outfmt.FormatJSON, "never")
+	f.SetSort("tenure", true)
+	f.SetSortKey("tenure", tenureSortKey(now))
+	sortByFlag = "tenure"
+	t.Cleanup(func() { sortByFlag = "" })
+
+	people := []api.Person{
+		{HRISProfileID: "recent", StartDate: "2026-05-01"},
+		{HRISProfileID: "undated"},
+		{HRISProfileID: "veteran", StartDate: "2019-03-15"},
+		{HRISProfileID: "mid", StartDate: "2023-01-10T00:00:00Z"},
+	}
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+	require.NoError(t, outputList(cmd, f, people, true, "No people found.", []string{"ID"}, func(p api.Person) []string {
+		return []string{p.HRISProfileID}
+	}, makeListResponse(people, CursorPage{})))
+
+	var got struct {
+		Data []api.Person `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &got))
+	ids := make([]string, 0, len(got.Data))
+	for _, p := range got.Data {
+		ids = append(ids, p.HRISProfileID)
+	}
+	assert.Equal(t, []string{"veteran", "mid", "recent", "undated"}, ids, "longest tenure first, undated last")
+	assert.Contains(t, errOut.String(), "only sorts the fetched page; use --all")
+}
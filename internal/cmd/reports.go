@@ -31,7 +31,7 @@ var paymentsReportCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		report, err := client.GetDetailedPaymentsReport(cmd.Context(), api.DetailedPaymentsReportParams{
+		report, err := client.GetDetailedPaymentsReport(api.WithServerFields(cmd.Context()), api.DetailedPaymentsReportParams{
 			StartDate:  paymentsReportStartDateFlag,
 			EndDate:    paymentsReportEndDateFlag,
 			ContractID: paymentsReportContractFlag,
@@ -68,5 +68,8 @@ func init() {
 	paymentsReportCmd.Flags().StringVar(&paymentsReportContractFlag, "contract", "", "Filter by contract ID")
 	paymentsReportCmd.Flags().StringVar(&paymentsReportStatusFlag, "status", "", "Filter by status")
 
+	reportsRunCmd.Flags().StringVar(&reportsRunParamsFileFlag, "params-file", "", "JSON object of report parameters (required)")
+
 	reportsCmd.AddCommand(paymentsReportCmd)
+	reportsCmd.AddCommand(reportsRunCmd)
 }
@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/dryrun"
+)
+
+var reportsRunParamsFileFlag string
+
+var reportsRunCmd = &cobra.Command{
+	Use:   "run <report-type>",
+	Short: "Generate a report from a parameters file",
+	Long: `Submit a report of the given type with parameters read from a JSON object
+file. The file is checked against the report type's parameter schema before
+anything is submitted: required parameters must be present, unknown ones are
+rejected, and each value must match its declared type (string, date as
+YYYY-MM-DD, number, integer, boolean, currency as a 3-letter code, or array).`,
+	Example: `  deel reports run payroll-summary --params-file params.json
+  deel reports run payroll-summary --params-file params.json --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+		reportType := args[0]
+
+		if reportsRunParamsFileFlag == "" {
+			return failValidation(cmd, f, "--params-file is required")
+		}
+		params, err := loadReportParams(reportsRunParamsFileFlag)
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
+		client, err := getClient()
+		if err != nil {
+			return HandleError(f, err, "initializing client")
+		}
+
+		schema, err := client.GetReportSchema(api.WithServerFields(cmd.Context()), reportType)
+		if err != nil {
+			return HandleError(f, err, "get report schema")
+		}
+		if problems := validateReportParams(schema, params); len(problems) > 0 {
+			return failValidation(cmd, f, fmt.Sprintf("invalid --params-file for report %s", reportType), problems...)
+		}
+
+		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
+			Operation:   "CREATE",
+			Resource:    "Report",
+			Description: "Generate " + reportType + " report",
+			Details:     reportParamDetails(params),
+		}); ok {
+			return err
+		}
+
+		run, err := client.RunReport(cmd.Context(), reportType, params)
+		if err != nil {
+			return HandleError(f, err, "run report")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			f.PrintSuccess("Report submitted")
+			f.PrintText("ID:       " + run.ID)
+			f.PrintText("Type:     " + run.ReportType)
+			f.PrintText("Status:   " + run.Status)
+			if run.DownloadURL != "" {
+				f.PrintText("Download: " + run.DownloadURL)
+			}
+		}, run)
+	},
+}
+
+// loadReportParams reads a --params-file JSON object. Numbers are kept as
+// json.Number so integer parameters can be told apart from fractional ones.
+func loadReportParams(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var params map[string]any
+	if err := dec.Decode(&params); err != nil {
+		return nil, fmt.Errorf("invalid --params-file %s: %w", path, err)
+	}
+	if params == nil {
+		return nil, fmt.Errorf("invalid --params-file %s: expected a JSON object", path)
+	}
+	return params, nil
+}
+
+// validateReportParams checks params against schema and returns one message
+// per problem, in a stable order: missing required parameters, then unknown
+// parameters, then type mismatches.
+func validateReportParams(schema *api.ReportSchema, params map[string]any) []string {
+	var missing, unknown, invalid []string
+	known := make(map[string]api.ReportParameter, len(schema.Parameters))
+	for _, p := range schema.Parameters {
+		known[p.Name] = p
+		if _, ok := params[p.Name]; p.Required && !ok {
+			missing = append(missing, fmt.Sprintf("missing required parameter %q (%s)", p.Name, p.Type))
+		}
+	}
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p, ok := known[name]
+		if !ok {
+			unknown = append(unknown, fmt.Sprintf("unknown parameter %q", name))
+			continue
+		}
+		if err := checkReportParamValue(p, params[name]); err != nil {
+			invalid = append(invalid, fmt.Sprintf("parameter %q: %v", name, err))
+		}
+	}
+
+	problems := append(missing, unknown...)
+	return append(problems, invalid...)
+}
+
+// checkReportParamValue checks one value against its declared type and enum.
+// Unrecognized types are left for the API to judge.
+func checkReportParamValue(p api.ReportParameter, value any) error {
+	switch strings.ToLower(p.Type) {
+	case "string", "date", "currency":
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected %s, got %s", p.Type, jsonTypeName(value))
+		}
+		switch strings.ToLower(p.Type) {
+		case "date":
+			if err := validateDate(s); err != nil {
+				return err
+			}
+		case "currency":
+			if err := validateCurrency(s); err != nil {
+				return err
+			}
+		}
+		if len(p.Enum) > 0 && !slices.Contains(p.Enum, s) {
+			return fmt.Errorf("must be one of %s, got %q", strings.Join(p.Enum, ", "), s)
+		}
+	case "number":
+		if _, ok := value.(json.Number); !ok {
+			return fmt.Errorf("expected number, got %s", jsonTypeName(value))
+		}
+	case "integer":
+		n, ok := value.(json.Number)
+		if !ok {
+			return fmt.Errorf("expected integer, got %s", jsonTypeName(value))
+		}
+		if _, err := n.Int64(); err != nil {
+			return fmt.Errorf("expected integer, got %s", n)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("expected boolean, got %s", jsonTypeName(value))
+		}
+	case "array":
+		if _, ok := value.([]any); !ok {
+			return fmt.Errorf("expected array, got %s", jsonTypeName(value))
+		}
+	}
+	return nil
+}
+
+// jsonTypeName names the JSON type of a value decoded with UseNumber.
+func jsonTypeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// reportParamDetails renders params for a dry-run preview.
+func reportParamDetails(params map[string]any) map[string]string {
+	details := make(map[string]string, len(params))
+	for k, v := range params {
+		if b, err := json.Marshal(v); err == nil {
+			details[k] = strings.Trim(string(b), `"`)
+		}
+	}
+	return details
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+)
+
+var testReportSchema = &api.ReportSchema{
+	ReportType: "payroll-summary",
+	Parameters: []api.ReportParameter{
+		{Name: "start_date", Type: "date", Required: true},
+		{Name: "end_date", Type: "date", Required: true},
+		{Name: "entity_ids", Type: "array"},
+		{Name: "currency", Type: "currency"},
+		{Name: "max_rows", Type: "integer"},
+		{Name: "include_contractors", Type: "boolean"},
+		{Name: "group_by", Type: "string", Enum: []string{"entity", "country"}},
+	},
+}
+
+func writeReportParams(t *testing.T, content string) map[string]any {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "params.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	params, err := loadReportParams(path)
+	require.NoError(t, err)
+	return params
+}
+
+func TestValidateReportParams_Valid(t *testing.T) {
+	params := writeReportParams(t, `{
+		"start_date": "2026-01-01",
+		"end_date": "2026-03-31",
+		"entity_ids": ["le-1", "le-2"],
+		"currency": "USD",
+		"max_rows": 500,
+		"include_contractors": true,
+		"group_by": "country"
+	}`)
+	assert.Empty(t, validateReportParams(testReportSchema, params))
+}
+
+func TestValidateReportParams_MissingRequired(t *testing.T) {
+	params := writeReportParams(t, `{"start_date": "2026-01-01"}`)
+	assert.Equal(t, []string{`missing required parameter "end_date" (date)`}, validateReportParams(testReportSchema, params))
+}
+
+func TestValidateReportParams_TypeMismatch(t *testing.T) {
+	params := writeReportParams(t, `{
+		"start_date": "01/01/2026",
+		"end_date": "2026-03-31",
+		"entity_ids": "le-1",
+		"currency": "US",
+		"max_rows": 10.5,
+		"include_contractors": "yes",
+		"group_by": "team",
+		"colour": "blue"
+	}`)
+	assert.Equal(t, []string{
+		`unknown parameter "colour"`,
+		`parameter "currency": invalid currency code "US" (must be 3 letters)`,
+		`parameter "entity_ids": expected array, got string`,
+		`parameter "group_by": must be one of entity, country, got "team"`,
+		`parameter "include_contractors": expected boolean, got string`,
+		`parameter "max_rows": expected integer, got 10.5`,
+		`parameter "start_date": invalid date format "01/01/2026" (expected YYYY-MM-DD)`,
+	}, validateReportParams(testReportSchema, params))
+}
+
+func TestLoadReportParams_NotAnObject(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "params.json")
+	require.NoError(t, os.WriteFile(path, []byte(`["start_date"]`), 0o600))
+	_, err := loadReportParams(path)
+	assert.ErrorContains(t, err, "invalid --params-file")
+
+	require.NoError(t, os.WriteFile(path, []byte(`null`), 0o600))
+	_, err = loadReportParams(path)
+	assert.ErrorContains(t, err, "expected a JSON object")
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// resourceVerbs are the standard operations, in display order. Any other
+// subcommand of a resource is reported as a custom operation.
+var resourceVerbs = []string{"list", "get", "create", "update", "delete"}
+
+// nonResourceCommands are top-level commands that manage the CLI itself
+// rather than a Deel resource.
+var nonResourceCommands = map[string]bool{
+	"auth":       true,
+	"completion": true,
+	"config":     true,
+	"help":       true,
+	"meta":       true,
+	"resources":  true,
+	"upgrade":    true,
+	"version":    true,
+}
+
+type resourceInfo struct {
+	Name       string   `json:"name"`
+	Aliases    []string `json:"aliases,omitempty"`
+	Operations []string `json:"operations"`
+	Custom     []string `json:"custom,omitempty"`
+}
+
+// buildResources derives the resource manifest from the top-level commands
+// of root. Operations lists the standard verbs a resource supports, plus
+// "custom" when it has other subcommands, which are named in Custom.
+func buildResources(root *cobra.Command) []resourceInfo {
+	var out []resourceInfo
+	for _, c := range root.Commands() {
+		if c.Hidden || c.Deprecated != "" || nonResourceCommands[c.Name()] {
+			continue
+		}
+		info := resourceInfo{Name: c.Name(), Aliases: c.Aliases, Operations: []string{}}
+		have := map[string]bool{}
+		for _, sc := range c.Commands() {
+			if sc.Hidden || sc.Deprecated != "" || sc.Name() == "help" {
+				continue
+			}
+			if slices.Contains(resourceVerbs, sc.Name()) {
+				have[sc.Name()] = true
+				continue
+			}
+			info.Custom = append(info.Custom, sc.Name())
+		}
+		for _, verb := range resourceVerbs {
+			if have[verb] {
+				info.Operations = append(info.Operations, verb)
+			}
+		}
+		if len(info.Custom) > 0 {
+			sort.Strings(info.Custom)
+			info.Operations = append(info.Operations, "custom")
+		}
+		out = append(out, info)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+var resourcesCmd = &cobra.Command{
+	Use:   "resources",
+	Short: "List resource types and their supported operations",
+	Long: `List each resource the CLI manages with the operations it supports:
+list, get, create, update, delete, and custom for any other subcommand.
+
+The manifest is derived from the registered commands, so it always matches
+this build. Use --json for tooling.`,
+	Example: "  deel resources\n  deel resources --json --jq '.[] | select(.operations | index(\"delete\")) | .name'",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+		resources := buildResources(cmd.Root())
+		return f.OutputFiltered(cmd.Context(), func() {
+			table := f.NewTable("RESOURCE", "OPERATIONS", "CUSTOM")
+			for _, r := range resources {
+				table.AddRow(r.Name, strings.Join(r.Operations, ", "), strings.Join(r.Custom, ", "))
+			}
+			table.Render()
+		}, resources)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(resourcesCmd)
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func findResource(t *testing.T, resources []resourceInfo, name string) resourceInfo {
+	t.Helper()
+	for _, r := range resources {
+		if r.Name == name {
+			return r
+		}
+	}
+	require.Failf(t, "resource not found", "%q missing from manifest", name)
+	return resourceInfo{}
+}
+
+func TestBuildResources_Webhooks(t *testing.T) {
+	webhooks := findResource(t, buildResources(rootCmd), "webhooks")
+	assert.Equal(t, []string{"list", "get", "create", "update", "delete", "custom"}, webhooks.Operations)
+	assert.Contains(t, webhooks.Custom, "enable")
+	assert.Contains(t, webhooks.Custom, "event-types")
+	assert.NotContains(t, webhooks.Custom, "list")
+}
+
+func TestBuildResources_MatchesCommandTree(t *testing.T) {
+	resources := buildResources(rootCmd)
+	for _, r := range resources {
+		assert.False(t, nonResourceCommands[r.Name], "%s is not a resource", r.Name)
+		c, err := findCommand(rootCmd, []string{r.Name})
+		require.NoError(t, err)
+		for _, op := range r.Operations {
+			if op == "custom" {
+				continue
+			}
+			_, err := findCommand(c, []string{op})
+			assert.NoError(t, err, "%s %s", r.Name, op)
+		}
+	}
+	findResource(t, resources, "contracts")
+}
@@ -3,10 +3,18 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	_ "embed"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,6 +24,7 @@ import (
 	"github.com/salmonumbrella/deel-cli/internal/climerrors"
 	"github.com/salmonumbrella/deel-cli/internal/config"
 	"github.com/salmonumbrella/deel-cli/internal/dryrun"
+	"github.com/salmonumbrella/deel-cli/internal/filter"
 	"github.com/salmonumbrella/deel-cli/internal/outfmt"
 	"github.com/salmonumbrella/deel-cli/internal/secrets"
 )
@@ -35,6 +44,7 @@ func emitAgentFlagError(ctx context.Context, message string) {
 			"operation": "validating flags",
 			"category":  "validation",
 			"message":   message,
+			"retryable": false,
 		},
 	})
 	markAgentErrorEmitted()
@@ -55,23 +65,54 @@ var (
 
 // Global flags
 var (
-	accountFlag        string
-	outputFlag         string
-	colorFlag          string
-	debugFlag          bool
-	agentFlag          bool
-	timeoutFlag        time.Duration
-	retriesFlag        int
-	retryBaseFlag      time.Duration
-	retryMaxFlag       time.Duration
-	jsonlFlag          bool
-	queryFlag          string
-	jqFlag             string
-	jsonFlag           bool
-	dryRunFlag         bool
-	dataOnlyFlag       bool
-	rawFlag            bool
-	idempotencyKeyFlag string
+	accountFlag         string
+	profileFlag         string
+	outputFlag          string
+	colorFlag           string
+	debugFlag           bool
+	agentFlag           bool
+	timeoutFlag         time.Duration
+	retriesFlag         int
+	retryBaseFlag       time.Duration
+	retryMaxFlag        time.Duration
+	jsonlFlag           bool
+	print0Flag          bool
+	queryFlag           string
+	jqFlag              string
+	jsonFlag            bool
+	yamlFlag            bool
+	dryRunFlag          bool
+	dataOnlyFlag        bool
+	rawFlag             bool
+	idempotencyKeyFlag  string
+	autoIdempotencyFlag bool
+	envelopeVersionFlag bool
+	columnsFlag         []string
+	selectFlag          []string
+	fieldsFlag          []string
+	serverFieldsFlag    []string
+	normalizeTSFlag     bool
+	includeEmptyFlag    bool
+	outputHashFlag      bool
+	normalizeEnumsFlag  string
+	jsonIndentFlag      string
+	sortByFlag          string
+	sortDescFlag        bool
+	showRateLimitFlag   bool
+	traceFlag           bool
+	circuitLimitFlag    int
+	circuitWindowFlag   time.Duration
+	proxyFlag           string
+	baseURLFlag         string
+	outputFileFlag      string
+	logFileFlag         string
+	cacheTTLFlag        time.Duration
+	noCacheFlag         bool
+	etagCacheFlag       bool
+	storeFlag           string
+	maxPagesFlag        int
+	moneyAsFlag         string
+	showErrorBodyFlag   bool
 )
 
 // rootCmd is the base command
@@ -89,6 +130,7 @@ Get started:
 
 JSON output:
   --json              # Output JSON (lists include data/page; single resources are wrapped in data)
+  --yaml              # Output the same envelope as YAML
   --json --items      # Output only the data array/object (for piping to jq)
   --json --raw        # Output raw JSON without the data envelope
   --json --jq '.data[].name'  # Apply JQ filter to JSON output
@@ -100,6 +142,22 @@ Agent mode:
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 
+		// The config commands load the file themselves so a broken file
+		// can still be inspected and repaired.
+		if !isConfigCommand(cmd) {
+			if err := loadFileSettings(cmd); err != nil {
+				emitAgentFlagError(ctx, err.Error())
+				return err
+			}
+		}
+
+		if print0Flag {
+			if outputFlag != "" && outputFlag != "id0" {
+				emitAgentFlagError(ctx, fmt.Sprintf("cannot use --print0 with --output %q", outputFlag))
+				return fmt.Errorf("cannot use --print0 with --output %q", outputFlag)
+			}
+			outputFlag = "id0"
+		}
 		if jsonFlag {
 			if outputFlag != "" && outputFlag != "json" {
 				emitAgentFlagError(ctx, fmt.Sprintf("cannot use --json with --output %q", outputFlag))
@@ -107,6 +165,13 @@ Agent mode:
 			}
 			outputFlag = "json"
 		}
+		if yamlFlag {
+			if outputFlag != "" && outputFlag != "yaml" {
+				emitAgentFlagError(ctx, fmt.Sprintf("cannot use --yaml with --output %q", outputFlag))
+				return fmt.Errorf("cannot use --yaml with --output %q", outputFlag)
+			}
+			outputFlag = "yaml"
+		}
 		if jqFlag != "" {
 			if queryFlag != "" && queryFlag != jqFlag {
 				emitAgentFlagError(ctx, "cannot use --jq and --query with different values")
@@ -115,6 +180,11 @@ Agent mode:
 			queryFlag = jqFlag
 		}
 
+		if outputHashFlag && (jsonlFlag || print0Flag) {
+			emitAgentFlagError(ctx, "cannot use --output-hash with --jsonl or --print0")
+			return fmt.Errorf("cannot use --output-hash with --jsonl or --print0")
+		}
+
 		if jsonlFlag {
 			if rawFlag {
 				emitAgentFlagError(ctx, "cannot use --jsonl with --raw")
@@ -128,6 +198,13 @@ Agent mode:
 				emitAgentFlagError(ctx, fmt.Sprintf("cannot use --jsonl with --output %q (JSONL requires JSON output)", outputFlag))
 				return fmt.Errorf("cannot use --jsonl with --output %q (JSONL requires JSON output)", outputFlag)
 			}
+			if queryFlag != "" {
+				// Reject whole-array filters before any API calls are made.
+				if _, err := filter.CompileStream(queryFlag); err != nil {
+					emitAgentFlagError(ctx, err.Error())
+					return err
+				}
+			}
 			outputFlag = "json"
 			jsonFlag = true
 			ctx = outfmt.WithPrettyJSON(ctx, false)
@@ -165,11 +242,17 @@ Agent mode:
 		// Validate output format
 		if outputFlag != "" {
 			switch outputFlag {
-			case "text", "json":
+			case "text", "json", "yaml", "id0":
 				// Valid
 			default:
-				emitAgentFlagError(ctx, fmt.Sprintf("invalid output format %q (must be 'text' or 'json')", outputFlag))
-				return fmt.Errorf("invalid output format %q (must be 'text' or 'json')", outputFlag)
+				emitAgentFlagError(ctx, fmt.Sprintf("invalid output format %q (must be 'text', 'json', 'yaml', or 'id0')", outputFlag))
+				return fmt.Errorf("invalid output format %q (must be 'text', 'json', 'yaml', or 'id0')", outputFlag)
+			}
+		}
+		if outputFlag == "id0" {
+			if err := validateID0Flags(); err != nil {
+				emitAgentFlagError(ctx, err.Error())
+				return err
 			}
 		}
 		// Validate color mode
@@ -183,11 +266,51 @@ Agent mode:
 			}
 		}
 
+		if _, err := outfmt.ParseJSONIndent(jsonIndentFlag); err != nil {
+			emitAgentFlagError(ctx, err.Error())
+			return err
+		}
+
+		if _, err := outfmt.ParseEnumCase(normalizeEnumsFlag); err != nil {
+			emitAgentFlagError(ctx, err.Error())
+			return err
+		}
+
+		store := storeFlag
+		if store == "" {
+			store = os.Getenv(config.EnvStore)
+		}
+		backend, err := secrets.ParseBackend(store)
+		if err != nil {
+			emitAgentFlagError(ctx, err.Error())
+			return err
+		}
+		secrets.SetBackend(backend)
+
+		if err := outfmt.ValidateSelectPaths(selectFlag); err != nil {
+			emitAgentFlagError(ctx, err.Error())
+			return err
+		}
+
+		if err := api.SetMoneyFormat(api.MoneyFormat(moneyAsFlag)); err != nil {
+			emitAgentFlagError(ctx, fmt.Sprintf("invalid --money-as: %v", err))
+			return fmt.Errorf("invalid --money-as: %w", err)
+		}
+
+		if outputFileFlag != "" && outputSink == nil {
+			file, err := os.Create(outputFileFlag)
+			if err != nil {
+				emitAgentFlagError(ctx, fmt.Sprintf("cannot open --output-file: %v", err))
+				return fmt.Errorf("cannot open --output-file: %w", err)
+			}
+			outputSink = outfmt.NewLineWriter(file)
+		}
+
 		// Set output format in context (used by helpers that need to know if we're in JSON mode).
 		format := "text"
 		if outputFlag != "" {
 			format = outputFlag
-		} else if envOutput := os.Getenv(config.EnvOutput); envOutput != "" {
+		} else if envOutput := envOrSetting(config.EnvOutput, config.KeyOutput); envOutput != "" {
 			format = envOutput
 		}
 		ctx = outfmt.WithFormat(ctx, format)
@@ -203,6 +326,15 @@ Agent mode:
 		if rawFlag {
 			ctx = outfmt.WithRaw(ctx, true)
 		}
+		if envelopeVersionFlag {
+			ctx = outfmt.WithEnvelopeVersion(ctx, true)
+		}
+		ctx = outfmt.WithOperation(ctx, commandOperation(cmd))
+		autoIdempotencyKey = ""
+		if autoIdempotencyFlag {
+			ctx = outfmt.WithIdempotencyKey(ctx, sentAutoIdempotencyKey)
+			ctx = outfmt.WithIdempotencyParts(ctx, sentIdempotencyParts)
+		}
 		// Set dry-run mode in context
 		if dryRunFlag {
 			ctx = dryrun.WithDryRun(ctx, true)
@@ -213,25 +345,56 @@ Agent mode:
 }
 
 func init() {
-	rootCmd.PersistentFlags().StringVar(&accountFlag, "account", "", "Account to use (overrides DEEL_ACCOUNT)")
-	rootCmd.PersistentFlags().StringVarP(&outputFlag, "output", "o", "", "Output format: text or json (default: text)")
+	rootCmd.PersistentFlags().StringVar(&accountFlag, "account", "", "Account to use (overrides DEEL_ACCOUNT); 'name/profile' also selects a profile")
+	rootCmd.PersistentFlags().StringVar(&profileFlag, "profile", "", "Named token of the account to use, e.g. readonly (overrides DEEL_PROFILE)")
+	rootCmd.PersistentFlags().StringVarP(&outputFlag, "output", "o", "", "Output format: text, json, yaml, or id0 (default: text)")
 	rootCmd.PersistentFlags().BoolVar(&jsonFlag, "json", false, "Output JSON (alias for --output json)")
+	rootCmd.PersistentFlags().BoolVar(&yamlFlag, "yaml", false, "Output YAML (alias for --output yaml)")
 	rootCmd.PersistentFlags().BoolVar(&agentFlag, "agent", agentEnabledFromEnv(), "Agent mode: force JSON output, disable color, emit compact JSON")
+	rootCmd.PersistentFlags().StringVar(&outputFileFlag, "output-file", "", "Write command output to this file (only complete lines are written; safe for large --jsonl exports)")
+	rootCmd.PersistentFlags().BoolVar(&print0Flag, "print0", false, "Print only resource IDs, each followed by a NUL byte (for xargs -0); alias for --output id0")
 	rootCmd.PersistentFlags().BoolVar(&jsonlFlag, "jsonl", false, "Stream JSON lines output (one JSON value per line; implies JSON output)")
 	rootCmd.PersistentFlags().StringVar(&colorFlag, "color", "", "Color mode: auto, always, or never (default: auto)")
 	rootCmd.PersistentFlags().BoolVar(&debugFlag, "debug", false, "Enable debug output")
 	rootCmd.PersistentFlags().StringVar(&queryFlag, "query", "", "JQ filter for JSON output")
 	rootCmd.PersistentFlags().StringVar(&jqFlag, "jq", "", "JQ filter for JSON output (alias for --query)")
 	rootCmd.PersistentFlags().BoolVar(&dryRunFlag, "dry-run", false, "Preview changes without executing")
-	rootCmd.PersistentFlags().BoolVar(&dataOnlyFlag, "data-only", false, "Output only the data array/object (use with --json)")
+	rootCmd.PersistentFlags().BoolVar(&dataOnlyFlag, "data-only", false, "Output only the data array/object (use with --json or --yaml)")
 	rootCmd.PersistentFlags().BoolVar(&dataOnlyFlag, "data", false, "Alias for --data-only")
 	rootCmd.PersistentFlags().BoolVar(&dataOnlyFlag, "items", false, "Alias for --data-only")
-	rootCmd.PersistentFlags().BoolVar(&rawFlag, "raw", false, "Output raw JSON without the data envelope (use with --json)")
+	rootCmd.PersistentFlags().BoolVar(&rawFlag, "raw", false, "Output raw JSON/YAML without the data envelope (use with --json or --yaml)")
+	rootCmd.PersistentFlags().BoolVar(&envelopeVersionFlag, "envelope-version", false, "Include envelope_version in JSON envelopes (use with --json)")
+	rootCmd.PersistentFlags().StringSliceVar(&columnsFlag, "columns", nil, "Comma-separated columns to show in tables and keys to keep in JSON (case-insensitive)")
+	rootCmd.PersistentFlags().StringSliceVar(&selectFlag, "select", nil, "Comma-separated dotted key paths to keep in JSON/YAML output, e.g. data.*.worker.name (tables are unaffected)")
+	rootCmd.PersistentFlags().StringSliceVar(&fieldsFlag, "fields", nil, "Comma-separated top-level keys to keep from each item in JSON/YAML output, e.g. id,status (no jq needed; tables are unaffected)")
+	rootCmd.PersistentFlags().StringSliceVar(&serverFieldsFlag, "server-fields", nil, "Comma-separated fields to request from the API (sent as ?fields= on the main list/get request; endpoints that ignore it return everything)")
+	rootCmd.PersistentFlags().BoolVar(&normalizeTSFlag, "normalize-timestamps", false, "Rewrite timestamp fields in JSON/YAML output as RFC 3339 UTC (unparseable values are kept with a warning)")
+	rootCmd.PersistentFlags().BoolVar(&includeEmptyFlag, "include-empty", false, "Keep unset optional fields in JSON/YAML output as null or zero values (by default they are omitted)")
+	rootCmd.PersistentFlags().BoolVar(&outputHashFlag, "output-hash", false, "After JSON/YAML output, print a SHA-256 of the canonical (sorted-key) result to stderr for change detection in CI")
+	rootCmd.PersistentFlags().StringVar(&normalizeEnumsFlag, "normalize-enums", "", "Recase enum fields (status, type, ...) as 'lower' or 'upper' in all output, including table columns")
+	rootCmd.PersistentFlags().StringVar(&jsonIndentFlag, "json-indent", "2", "Indentation for pretty JSON output: a space count (1-8) or 'tab'")
+	rootCmd.PersistentFlags().StringVar(&sortByFlag, "sort-by", "", "Sort list output by column (client-side; numbers and YYYY-MM-DD dates sort naturally)")
+	rootCmd.PersistentFlags().BoolVar(&sortDescFlag, "sort-desc", false, "Sort in descending order (use with --sort-by)")
+	rootCmd.PersistentFlags().BoolVar(&showRateLimitFlag, "show-rate-limit", false, "Print the remaining API rate-limit quota to stderr when the command finishes")
+	rootCmd.PersistentFlags().StringVar(&storeFlag, "store", "", "Credential store: auto (keychain, falling back to an encrypted file), keychain, or file (default: $DEEL_STORE or auto)")
+	rootCmd.PersistentFlags().StringVar(&logFileFlag, "log-file", "", "Append a JSONL audit record per API request (time, account, method, path, status, latency; secrets redacted) to this file")
+	rootCmd.PersistentFlags().BoolVar(&traceFlag, "trace", false, "Print one line per API request to stderr (method, path, status, bytes, attempt, latency) and the total elapsed time at exit")
 	rootCmd.PersistentFlags().StringVar(&idempotencyKeyFlag, "idempotency-key", "", "Idempotency key for write requests")
+	rootCmd.PersistentFlags().BoolVar(&autoIdempotencyFlag, "auto-idempotency", false, "Send a generated idempotency key with this run's write requests and print it; protects retries within the run only, so pass the printed key to --idempotency-key to re-run (ignored when --idempotency-key or DEEL_IDEMPOTENCY_KEY is set)")
 	rootCmd.PersistentFlags().DurationVar(&timeoutFlag, "timeout", 30*time.Second, "HTTP request timeout")
-	rootCmd.PersistentFlags().IntVar(&retriesFlag, "retries", 3, "Max retry attempts for transient failures")
+	rootCmd.PersistentFlags().IntVar(&retriesFlag, "retries", 3, "Max retry attempts for transient failures (POST/PATCH only retry with --idempotency-key or --auto-idempotency)")
 	rootCmd.PersistentFlags().DurationVar(&retryBaseFlag, "retry-base", 1*time.Second, "Base backoff for retries")
-	rootCmd.PersistentFlags().DurationVar(&retryMaxFlag, "retry-max", 30*time.Second, "Max backoff for retries")
+	rootCmd.PersistentFlags().DurationVar(&retryMaxFlag, "retry-max", 30*time.Second, "Max backoff for retries; a longer Retry-After on 429 fails the command instead of waiting")
+	rootCmd.PersistentFlags().StringVar(&baseURLFlag, "base-url", "", "Deel API base URL, e.g. the sandbox environment (https only; overrides DEEL_BASE_URL)")
+	rootCmd.PersistentFlags().StringVar(&proxyFlag, "proxy", "", "Proxy URL for API requests: http://, https://, or socks5:// (overrides DEEL_PROXY)")
+	rootCmd.PersistentFlags().StringVar(&moneyAsFlag, "money-as", "object", "How amounts paired with a currency appear in JSON: object ({amount, currency}) or string (\"1234.56 USD\")")
+	rootCmd.PersistentFlags().IntVar(&maxPagesFlag, "max-pages", 0, "Stop --all after this many pages and print the cursor to resume (0 = unlimited)")
+	rootCmd.PersistentFlags().DurationVar(&cacheTTLFlag, "cache-ttl", 0, "Cache lookup responses (countries, currencies, job titles, seniority levels) on disk for this long (0 disables); a response's Cache-Control max-age or no-store wins")
+	rootCmd.PersistentFlags().BoolVar(&noCacheFlag, "no-cache", false, "Bypass the lookup cache entirely (ignores --cache-ttl and --etag-cache)")
+	rootCmd.PersistentFlags().BoolVar(&etagCacheFlag, "etag-cache", false, "Store responses that carry an ETag on disk and revalidate them with If-None-Match")
+	rootCmd.PersistentFlags().BoolVar(&showErrorBodyFlag, "show-error-body", false, "In JSON mode, print a structured error that includes the raw API error body under error.body")
+	rootCmd.PersistentFlags().IntVar(&circuitLimitFlag, "circuit-limit", 5, "Consecutive server failures before the circuit breaker opens (0 disables)")
+	rootCmd.PersistentFlags().DurationVar(&circuitWindowFlag, "circuit-window", 30*time.Second, "How long the circuit breaker stays open")
 
 	// Override help: static help.txt for root, JSON schema for agent mode,
 	// Cobra default for subcommands.
@@ -256,6 +419,7 @@ func init() {
 
 	// Add subcommands
 	rootCmd.AddCommand(authCmd)
+	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(peopleCmd)
 	rootCmd.AddCommand(contractsCmd)
@@ -292,7 +456,201 @@ func init() {
 // ExecuteContext runs the root command with context
 func ExecuteContext(ctx context.Context, args []string) error {
 	rootCmd.SetArgs(args)
-	return rootCmd.ExecuteContext(ctx)
+	start := time.Now()
+	err := rootCmd.ExecuteContext(ctx)
+	if outputSink != nil {
+		if closeErr := outputSink.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("writing --output-file: %w", closeErr)
+		}
+		outputSink = nil
+	}
+	if logFileSink != nil {
+		_ = logFileSink.Close()
+		logFileSink = nil
+	}
+	if showRateLimitFlag && lastClient != nil {
+		info, ok := lastClient.LastRateLimit()
+		_, _ = fmt.Fprintln(os.Stderr, formatRateLimit(info, ok, time.Now()))
+	}
+	if traceFlag && lastClient != nil {
+		_, _ = fmt.Fprintln(os.Stderr, formatTraceSummary(lastClient.Trace(), time.Since(start)))
+	}
+	if lastClient != nil {
+		if report := formatIdempotencyReport(sentAutoIdempotencyKey(), lastClient.IdempotencyKeysSent()); report != "" {
+			_, _ = fmt.Fprint(os.Stderr, report)
+		}
+	}
+	return err
+}
+
+// autoIdempotencyKey is the key the CLI generated for the current command
+// (--auto-idempotency, or contracts create --idempotency-scope), or "" before
+// one is set.
+var autoIdempotencyKey string
+
+// sentAutoIdempotencyKey returns the --auto-idempotency key once a write
+// request has carried it, so read-only commands report nothing.
+func sentAutoIdempotencyKey() string {
+	if autoIdempotencyKey == "" || lastClient == nil || !lastClient.IdempotencyKeySent() {
+		return ""
+	}
+	return autoIdempotencyKey
+}
+
+// formatIdempotencyReport renders the stderr report of the idempotency keys a
+// command sent: the generated key, when there is one, and every per-part key
+// derived from it (or from --idempotency-key).
+func formatIdempotencyReport(autoKey string, sent []api.SentIdempotencyKey) string {
+	var b strings.Builder
+	if autoKey != "" {
+		fmt.Fprintf(&b, "Idempotency key: %s (to retry this exact request: --idempotency-key %s)\n", autoKey, autoKey)
+	}
+	var parts []api.SentIdempotencyKey
+	for _, k := range sent {
+		if k.Part != "" {
+			parts = append(parts, k)
+		}
+	}
+	if len(parts) > 0 {
+		b.WriteString("Per-request idempotency keys (derived from the command's key):\n")
+		for _, k := range parts {
+			fmt.Fprintf(&b, "  %s: %s\n", k.Part, k.Key)
+		}
+	}
+	return b.String()
+}
+
+// sentIdempotencyParts returns the per-part keys write requests carried,
+// keyed by part (e.g. "row-2", "then-sign"), or nil when there were none.
+// Each is derived from the command's key, so retrying with that key resends
+// the same per-part keys.
+func sentIdempotencyParts() map[string]string {
+	if lastClient == nil {
+		return nil
+	}
+	var parts map[string]string
+	for _, k := range lastClient.IdempotencyKeysSent() {
+		if k.Part == "" {
+			continue
+		}
+		if parts == nil {
+			parts = map[string]string{}
+		}
+		parts[k.Part] = k.Key
+	}
+	return parts
+}
+
+// explicitIdempotencyKey reports whether the user supplied an idempotency key
+// with --idempotency-key or DEEL_IDEMPOTENCY_KEY.
+func explicitIdempotencyKey() bool {
+	return idempotencyKeyFlag != "" || os.Getenv(config.EnvIdempotencyKey) != ""
+}
+
+// outputSink receives formatter output when --output-file is set.
+var outputSink *outfmt.LineWriter
+
+// logFileSink is the --log-file target, opened by the first getClient call
+// and shared by every client the command creates.
+var logFileSink *os.File
+
+// lastClient is the most recent client returned by getClient, kept so
+// --show-rate-limit and --trace can report after the command finishes.
+var lastClient *api.Client
+
+// formatTraceSummary renders the --trace totals printed at exit.
+func formatTraceSummary(summary api.TraceSummary, elapsed time.Duration) string {
+	noun := "requests"
+	if summary.Requests == 1 {
+		noun = "request"
+	}
+	return fmt.Sprintf("trace: %d API %s, %s in API, %s total",
+		summary.Requests, noun, summary.APITime.Round(time.Millisecond), elapsed.Round(time.Millisecond))
+}
+
+// formatRateLimit renders rate-limit info for --show-rate-limit.
+func formatRateLimit(info api.RateLimitInfo, ok bool, now time.Time) string {
+	if !ok {
+		return "Rate limit: no rate-limit headers returned"
+	}
+	msg := fmt.Sprintf("Rate limit: %d remaining", info.Remaining)
+	if info.Limit > 0 {
+		msg = fmt.Sprintf("Rate limit: %d/%d remaining", info.Remaining, info.Limit)
+	}
+	if !info.Reset.IsZero() {
+		wait := info.Reset.Sub(now).Round(time.Second)
+		if wait < 0 {
+			wait = 0
+		}
+		msg += fmt.Sprintf(" (resets in %s)", wait)
+	}
+	return msg
+}
+
+// validateID0Flags rejects flags that shape JSON output, which --print0 does
+// not produce.
+func validateID0Flags() error {
+	switch {
+	case queryFlag != "":
+		return fmt.Errorf("cannot use --print0 with --jq/--query")
+	case len(selectFlag) > 0:
+		return fmt.Errorf("cannot use --print0 with --select")
+	case len(fieldsFlag) > 0:
+		return fmt.Errorf("cannot use --print0 with --fields")
+	case len(columnsFlag) > 0:
+		return fmt.Errorf("cannot use --print0 with --columns")
+	}
+	return nil
+}
+
+// fileSettings holds defaults from the config file (see loadFileSettings).
+var fileSettings config.Settings
+
+// loadFileSettings reads the config file into fileSettings and applies its
+// timeout and retries to flags the user did not set. Output, color, and
+// account are consulted through envOrSetting, after their DEEL_* variables.
+func loadFileSettings(cmd *cobra.Command) error {
+	path, err := config.FilePath()
+	if err != nil {
+		return err
+	}
+	settings, err := config.LoadSettings(path)
+	if err != nil {
+		return &climerrors.CLIError{
+			Operation:   "loading config file",
+			Err:         err,
+			Category:    climerrors.CategoryValidation,
+			Suggestions: []string{"Run 'deel config list' to see the entries in use; any 'deel config set' rewrites the file without invalid ones"},
+		}
+	}
+	fileSettings = settings
+
+	if v := settings[config.KeyTimeout]; v != "" && !cmd.Flags().Changed("timeout") {
+		timeoutFlag, _ = time.ParseDuration(v)
+	}
+	if v := settings[config.KeyRetries]; v != "" && !cmd.Flags().Changed("retries") {
+		retriesFlag, _ = strconv.Atoi(v)
+	}
+	return nil
+}
+
+// envOrSetting returns the environment variable env, falling back to the
+// config file value for key.
+func envOrSetting(env, key string) string {
+	if v := os.Getenv(env); v != "" {
+		return v
+	}
+	return fileSettings[key]
+}
+
+// isConfigCommand reports whether cmd is in the "deel config" group.
+func isConfigCommand(cmd *cobra.Command) bool {
+	for c := cmd; c != nil; c = c.Parent() {
+		if c == configCmd {
+			return true
+		}
+	}
+	return false
 }
 
 // getFormatter creates a formatter based on flags and environment
@@ -300,18 +658,22 @@ func getFormatter() *outfmt.Formatter {
 	format := outfmt.FormatText
 	if outputFlag != "" {
 		format = outfmt.Format(outputFlag)
-	} else if envOutput := os.Getenv(config.EnvOutput); envOutput != "" {
+	} else if envOutput := envOrSetting(config.EnvOutput, config.KeyOutput); envOutput != "" {
 		format = outfmt.Format(envOutput)
 	}
 
 	colorMode := "auto"
 	if colorFlag != "" {
 		colorMode = colorFlag
-	} else if envColor := os.Getenv(config.EnvColor); envColor != "" {
+	} else if envColor := envOrSetting(config.EnvColor, config.KeyColor); envColor != "" {
 		colorMode = envColor
 	}
 
-	f := outfmt.New(os.Stdout, os.Stderr, format, colorMode)
+	var out io.Writer = os.Stdout
+	if outputSink != nil {
+		out = outputSink
+	}
+	f := outfmt.New(out, os.Stderr, format, colorMode)
 	f.SetAgentMode(agentFlag)
 	if agentFlag {
 		f.SetPrettyJSON(false)
@@ -319,9 +681,31 @@ func getFormatter() *outfmt.Formatter {
 	f.SetQuery(queryFlag)
 	f.SetDataOnly(dataOnlyFlag)
 	f.SetRaw(rawFlag)
+	f.SetColumns(columnsFlag)
+	f.SetSelect(selectFlag)
+	f.SetFields(fieldsFlag)
+	f.SetNormalizeTimestamps(normalizeTSFlag)
+	f.SetIncludeEmpty(includeEmptyFlag)
+	f.SetOutputHash(outputHashFlag)
+	if enumCase, err := outfmt.ParseEnumCase(normalizeEnumsFlag); err == nil {
+		f.SetNormalizeEnums(enumCase)
+	}
+	if indent, err := outfmt.ParseJSONIndent(jsonIndentFlag); err == nil {
+		f.SetJSONIndent(indent)
+	}
+	f.SetSort(sortByFlag, sortDescFlag)
 	return f
 }
 
+// isRetryable reports whether a failed request is worth retrying: the category
+// must be transient and the client's circuit breaker must not be open.
+func isRetryable(c climerrors.Category) bool {
+	if !c.Retryable() {
+		return false
+	}
+	return lastClient == nil || !lastClient.CircuitOpen()
+}
+
 func categoryString(c climerrors.Category) string {
 	switch c {
 	case climerrors.CategoryAuth:
@@ -357,16 +741,24 @@ func HandleError(f *outfmt.Formatter, err error, operation string) error {
 	f.PrintError("%s", strings.TrimSpace(buf.String()))
 
 	// In agent mode, emit a structured JSON error on stdout so tools can parse it.
+	// --show-error-body opts plain JSON mode into the same object.
 	// Only emit the first error object to avoid breaking stdout with multiple JSON blobs.
-	if f.IsJSON() && f.IsAgentMode() && !AgentErrorEmitted() {
+	if f.IsJSON() && (f.IsAgentMode() || showErrorBodyFlag) && !AgentErrorEmitted() {
+		payload := map[string]any{
+			"operation":   cliErr.Operation,
+			"category":    categoryString(cliErr.Category),
+			"message":     climerrors.FriendlyMessage(cliErr.Err),
+			"retryable":   isRetryable(cliErr.Category),
+			"suggestions": cliErr.Suggestions,
+		}
+		if showErrorBodyFlag {
+			if body, ok := apiErrorBody(err); ok {
+				payload["body"] = body
+			}
+		}
 		_ = f.PrintJSON(map[string]any{
-			"ok": false,
-			"error": map[string]any{
-				"operation":   cliErr.Operation,
-				"category":    categoryString(cliErr.Category),
-				"message":     climerrors.FriendlyMessage(cliErr.Err),
-				"suggestions": cliErr.Suggestions,
-			},
+			"ok":    false,
+			"error": payload,
 		})
 		markAgentErrorEmitted()
 	}
@@ -376,20 +768,49 @@ func HandleError(f *outfmt.Formatter, err error, operation string) error {
 	return fmt.Errorf("failed %s: %s", operation, friendlyMsg)
 }
 
+// apiErrorBody returns the raw body of an API error for --show-error-body:
+// JSON bodies are passed through verbatim, anything else as a string.
+func apiErrorBody(err error) (any, bool) {
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Body) == 0 {
+		return nil, false
+	}
+	if json.Valid(apiErr.Body) {
+		return json.RawMessage(apiErr.Body), true
+	}
+	return string(apiErr.Body), true
+}
+
 // getClient creates an API client using the configured credentials
 func getClient() (*api.Client, error) {
+	creds, err := activeCredentials()
+	if err != nil {
+		return nil, err
+	}
+	return configureClient(api.NewClient(creds.Token), clientCacheAccount(creds))
+}
+
+// clientCacheAccount names the cache scope for creds: the account and
+// profile, or a token fingerprint for DEEL_TOKEN.
+func clientCacheAccount(creds secrets.Credentials) string {
+	switch {
+	case creds.Name == "":
+		return tokenCacheAccount(creds.Token)
+	case creds.Profile != "":
+		return creds.Name + "/" + creds.Profile
+	}
+	return creds.Name
+}
+
+// activeCredentials returns the credentials commands run with: DEEL_TOKEN
+// (with an empty Name), or the stored account picked by --account,
+// DEEL_ACCOUNT, the config file, or the only stored account. The token is
+// the profile's when one is selected by --profile, an "account/profile"
+// reference, or DEEL_PROFILE.
+func activeCredentials() (secrets.Credentials, error) {
 	// First check for direct token in environment
 	if token := os.Getenv(config.EnvToken); token != "" {
-		client := api.NewClient(token)
-		client.SetDebug(debugFlag)
-		client.SetTimeout(timeoutFlag)
-		client.SetRetryConfig(retriesFlag, retryBaseFlag, retryMaxFlag)
-		if idempotencyKeyFlag != "" {
-			client.SetIdempotencyKey(idempotencyKeyFlag)
-		} else if envKey := os.Getenv(config.EnvIdempotencyKey); envKey != "" {
-			client.SetIdempotencyKey(envKey)
-		}
-		return client, nil
+		return secrets.Credentials{Token: token}, nil
 	}
 
 	var store secrets.Store
@@ -398,7 +819,7 @@ func getClient() (*api.Client, error) {
 	// Get account name
 	account := accountFlag
 	if account == "" {
-		account = os.Getenv(config.EnvAccount)
+		account = envOrSetting(config.EnvAccount, config.KeyAccount)
 	}
 	if account == "" {
 		var hint string
@@ -420,7 +841,7 @@ func getClient() (*api.Client, error) {
 			if hint == "" {
 				hint = "Use --account flag, DEEL_ACCOUNT env, or DEEL_TOKEN for direct auth"
 			}
-			return nil, fmt.Errorf("no account specified. %s", hint)
+			return secrets.Credentials{}, fmt.Errorf("no account specified. %s", hint)
 		}
 	}
 
@@ -429,26 +850,144 @@ func getClient() (*api.Client, error) {
 		store, storeErr = secrets.OpenDefault()
 	}
 	if storeErr != nil {
-		return nil, fmt.Errorf("failed to open credential store: %w", storeErr)
+		return secrets.Credentials{}, fmt.Errorf("failed to open credential store: %w", storeErr)
 	}
 
+	account, profile, err := resolveProfile(account)
+	if err != nil {
+		return secrets.Credentials{}, err
+	}
+	if profile == "" {
+		profile = strings.ToLower(strings.TrimSpace(os.Getenv(config.EnvProfile)))
+	}
 	creds, err := store.Get(account)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get credentials for account %q: %w", account, err)
+		return secrets.Credentials{}, fmt.Errorf("failed to get credentials for account %q: %w", account, err)
 	}
+	creds.Name = account
+	return creds.ForProfile(profile)
+}
 
-	client := api.NewClient(creds.Token)
+// resolveProfile splits an "account/profile" reference and applies --profile
+// when the reference names no profile.
+func resolveProfile(ref string) (account, profile string, err error) {
+	account, profile = secrets.SplitProfile(ref)
+	flag := strings.ToLower(strings.TrimSpace(profileFlag))
+	switch {
+	case profile != "" && flag != "" && flag != profile:
+		return "", "", fmt.Errorf("account %q names profile %q but --profile is %q", ref, profile, flag)
+	case profile == "":
+		profile = flag
+	}
+	return account, profile, nil
+}
+
+// configureClient applies global flags and environment settings to a new client.
+// account scopes the lookup cache so accounts never see each other's entries.
+func configureClient(client *api.Client, account string) (*api.Client, error) {
 	client.SetDebug(debugFlag)
 	client.SetTimeout(timeoutFlag)
 	client.SetRetryConfig(retriesFlag, retryBaseFlag, retryMaxFlag)
+	client.SetCircuitBreaker(circuitLimitFlag, circuitWindowFlag)
+	client.SetServerFields(serverFieldsFlag)
+	if traceFlag {
+		client.SetTrace(os.Stderr)
+	}
 	if idempotencyKeyFlag != "" {
 		client.SetIdempotencyKey(idempotencyKeyFlag)
 	} else if envKey := os.Getenv(config.EnvIdempotencyKey); envKey != "" {
 		client.SetIdempotencyKey(envKey)
+	} else if autoIdempotencyFlag {
+		// One key per command, shared by every client it creates.
+		if autoIdempotencyKey == "" {
+			autoIdempotencyKey = api.NewIdempotencyKey()
+		}
+		client.SetIdempotencyKey(autoIdempotencyKey)
+	}
+	baseURL := baseURLFlag
+	if baseURL == "" {
+		baseURL = os.Getenv(config.EnvBaseURL)
+	}
+	if baseURL != "" {
+		normalized, err := validateBaseURL(baseURL)
+		if err != nil {
+			return nil, err
+		}
+		client.SetBaseURL(normalized)
+	}
+	proxy := proxyFlag
+	if proxy == "" {
+		proxy = os.Getenv(config.EnvProxy)
+	}
+	if proxy != "" {
+		if err := client.SetProxy(proxy); err != nil {
+			return nil, err
+		}
+	}
+	if logFileFlag != "" {
+		if logFileSink == nil {
+			file, err := os.OpenFile(logFileFlag, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+			if err != nil {
+				return nil, fmt.Errorf("opening --log-file: %w", err)
+			}
+			logFileSink = file
+		}
+		client.WrapTransport(func(next http.RoundTripper) http.RoundTripper {
+			return api.NewLogTransport(next, logFileSink, account)
+		})
 	}
+	if cacheTTLFlag > 0 && !noCacheFlag {
+		if dir, err := os.UserCacheDir(); err == nil {
+			client.SetCache(filepath.Join(dir, config.AppName, "lookups"), account, cacheTTLFlag)
+		}
+	}
+	if etagCacheFlag && !noCacheFlag {
+		if dir, err := os.UserCacheDir(); err == nil {
+			client.SetETagCache(filepath.Join(dir, config.AppName, "etags"), account)
+		}
+	}
+	lastClient = client
 	return client, nil
 }
 
+// validateBaseURL checks that raw is an absolute https URL with a host and no
+// query or fragment, and returns it without a trailing slash so request paths
+// can be appended directly.
+func validateBaseURL(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL: %w", err)
+	}
+	if u.Scheme != "https" {
+		return "", fmt.Errorf("invalid base URL %q: scheme must be https", u.Redacted())
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("invalid base URL %q: missing host", u.Redacted())
+	}
+	if u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("invalid base URL %q: must not include credentials, a query, or a fragment", u.Redacted())
+	}
+	return strings.TrimRight(u.String(), "/"), nil
+}
+
+// commandOperation names the logical operation for cmd by joining its path
+// below the root with dots, e.g. "contracts.create". Aliases resolve to the
+// canonical command name.
+func commandOperation(cmd *cobra.Command) string {
+	parts := strings.Fields(cmd.CommandPath())
+	if len(parts) <= 1 {
+		return ""
+	}
+	return strings.Join(parts[1:], ".")
+}
+
+// tokenCacheAccount derives a cache namespace for DEEL_TOKEN auth, where there
+// is no account name. Only a digest of the token is used.
+func tokenCacheAccount(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return "token-" + hex.EncodeToString(sum[:8])
+}
+
 // versionCmd shows version info
 var versionCmd = &cobra.Command{
 	Use:   "version",
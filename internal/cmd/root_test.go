@@ -0,0 +1,229 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/config"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+func TestFormatRateLimit(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "Rate limit: no rate-limit headers returned", formatRateLimit(api.RateLimitInfo{}, false, now))
+	assert.Equal(t, "Rate limit: 12 remaining", formatRateLimit(api.RateLimitInfo{Remaining: 12}, true, now))
+	assert.Equal(t, "Rate limit: 87/100 remaining (resets in 42s)", formatRateLimit(api.RateLimitInfo{
+		Limit:     100,
+		Remaining: 87,
+		Reset:     now.Add(42 * time.Second),
+	}, true, now))
+}
+
+func TestHandleError_AgentPayloadRetryable(t *testing.T) {
+	cases := []struct {
+		status    int
+		category  string
+		retryable bool
+	}{
+		{503, "server", true},
+		{429, "rate_limit", true},
+		{401, "auth", false},
+		{404, "not_found", false},
+	}
+
+	for _, tc := range cases {
+		resetAgentErrorEmitted()
+		var out, errOut bytes.Buffer
+		f := outfmt.New(&out, &errOut, outfmt.FormatJSON, "never")
+		f.SetAgentMode(true)
+
+		err := HandleError(f, &api.APIError{StatusCode: tc.status, Message: "boom"}, "listing people")
+		require.Error(t, err)
+
+		var payload struct {
+			OK    bool `json:"ok"`
+			Error struct {
+				Category  string `json:"category"`
+				Retryable bool   `json:"retryable"`
+			} `json:"error"`
+		}
+		require.NoError(t, json.Unmarshal(out.Bytes(), &payload), "status %d", tc.status)
+		assert.False(t, payload.OK)
+		assert.Equal(t, tc.category, payload.Error.Category, "status %d", tc.status)
+		assert.Equal(t, tc.retryable, payload.Error.Retryable, "status %d", tc.status)
+	}
+	resetAgentErrorEmitted()
+}
+
+func TestCommandOperation(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"contracts", "create"}, "contracts.create"},
+		{[]string{"visa", "cases"}, "immigration.cases"},
+		{[]string{"people", "list"}, "people.list"},
+		{[]string{"org", "lookups", "countries"}, "org.lookups.countries"},
+	}
+	for _, tt := range tests {
+		cmd, _, err := rootCmd.Find(tt.args)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, commandOperation(cmd), "args: %v", tt.args)
+	}
+	assert.Equal(t, "", commandOperation(rootCmd))
+}
+
+func TestValidateID0Flags(t *testing.T) {
+	defer func() {
+		queryFlag, selectFlag, columnsFlag, outputFileFlag = "", nil, nil, ""
+	}()
+
+	require.NoError(t, validateID0Flags())
+
+	queryFlag = ".data[].id"
+	assert.ErrorContains(t, validateID0Flags(), "--jq")
+	queryFlag = ""
+
+	selectFlag = []string{"data.*.id"}
+	assert.ErrorContains(t, validateID0Flags(), "--select")
+	selectFlag = nil
+
+	columnsFlag = []string{"id"}
+	assert.ErrorContains(t, validateID0Flags(), "--columns")
+	columnsFlag = nil
+
+	// The output file is closed with its final NUL-terminated ID written.
+	outputFileFlag = "ids.txt"
+	assert.NoError(t, validateID0Flags())
+}
+
+func TestHandleError_ShowErrorBody(t *testing.T) {
+	apiErr := &api.APIError{
+		StatusCode: 404,
+		Message:    "contract not found",
+		Body:       []byte(`{"error":"contract not found","suggestions":["c-124"]}`),
+	}
+	defer func() {
+		showErrorBodyFlag = false
+		resetAgentErrorEmitted()
+	}()
+
+	run := func(agent, show bool) []byte {
+		resetAgentErrorEmitted()
+		showErrorBodyFlag = show
+		var out, errOut bytes.Buffer
+		f := outfmt.New(&out, &errOut, outfmt.FormatJSON, "never")
+		f.SetAgentMode(agent)
+		require.Error(t, HandleError(f, apiErr, "getting contract"))
+		return out.Bytes()
+	}
+
+	var payload struct {
+		Error struct {
+			Category string          `json:"category"`
+			Body     json.RawMessage `json:"body"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(run(false, true), &payload))
+	assert.Equal(t, "not_found", payload.Error.Category)
+	assert.JSONEq(t, `{"error":"contract not found","suggestions":["c-124"]}`, string(payload.Error.Body))
+
+	// Without the flag, plain JSON mode prints nothing on stdout and agent
+	// mode omits the body.
+	assert.Empty(t, run(false, false))
+	payload.Error.Body = nil
+	require.NoError(t, json.Unmarshal(run(true, false), &payload))
+	assert.Equal(t, "not_found", payload.Error.Category)
+	assert.Nil(t, payload.Error.Body)
+}
+
+func TestAPIErrorBody_NonJSON(t *testing.T) {
+	body, ok := apiErrorBody(&api.APIError{StatusCode: 502, Body: []byte("<html>bad gateway</html>")})
+	require.True(t, ok)
+	assert.Equal(t, "<html>bad gateway</html>", body)
+
+	_, ok = apiErrorBody(&api.APIError{StatusCode: 404, Message: "not found"})
+	assert.False(t, ok)
+}
+
+func TestValidateBaseURL(t *testing.T) {
+	got, err := validateBaseURL("https://api-sandbox.demo.deel.com/")
+	require.NoError(t, err)
+	assert.Equal(t, "https://api-sandbox.demo.deel.com", got)
+
+	got, err = validateBaseURL(" https://gateway.example.com/deel ")
+	require.NoError(t, err)
+	assert.Equal(t, "https://gateway.example.com/deel", got)
+
+	for raw, want := range map[string]string{
+		"http://api.letsdeel.com":           "scheme must be https",
+		"api.letsdeel.com":                  "scheme must be https",
+		"https://":                          "missing host",
+		"https://user:pw@api.letsdeel.com":  "must not include credentials",
+		"https://api.letsdeel.com/?env=dev": "must not include credentials, a query",
+		"https://api.letsdeel.com/#frag":    "a fragment",
+	} {
+		_, err := validateBaseURL(raw)
+		assert.ErrorContains(t, err, want, raw)
+	}
+}
+
+func TestConfigureClient_BaseURLFromEnv(t *testing.T) {
+	t.Setenv(config.EnvBaseURL, "http://insecure.example.com")
+	_, err := configureClient(api.NewClient("token"), "acct")
+	assert.ErrorContains(t, err, "scheme must be https")
+
+	baseURLFlag = "https://api-sandbox.demo.deel.com"
+	defer func() { baseURLFlag = "" }()
+	_, err = configureClient(api.NewClient("token"), "acct")
+	assert.NoError(t, err, "--base-url overrides DEEL_BASE_URL")
+}
+
+func TestConfigureClient_AutoIdempotency(t *testing.T) {
+	autoIdempotencyFlag = true
+	t.Cleanup(func() { autoIdempotencyFlag, autoIdempotencyKey, idempotencyKeyFlag = false, "", "" })
+
+	_, err := configureClient(api.NewClient("token"), "acct")
+	require.NoError(t, err)
+	key := autoIdempotencyKey
+	assert.Len(t, key, 36)
+	_, err = configureClient(api.NewClient("token"), "acct")
+	require.NoError(t, err)
+	assert.Equal(t, key, autoIdempotencyKey, "one key per command")
+
+	autoIdempotencyKey = ""
+	idempotencyKeyFlag = "explicit"
+	_, err = configureClient(api.NewClient("token"), "acct")
+	require.NoError(t, err)
+	assert.Empty(t, autoIdempotencyKey, "--idempotency-key wins")
+}
+
+func TestFormatIdempotencyReport(t *testing.T) {
+	assert.Empty(t, formatIdempotencyReport("", nil))
+
+	report := formatIdempotencyReport("base", []api.SentIdempotencyKey{
+		{Part: "row-1", Key: "k1"},
+		{Part: "row-2", Key: "k2"},
+	})
+	assert.Equal(t, "Idempotency key: base (to retry this exact request: --idempotency-key base)\n"+
+		"Per-request idempotency keys (derived from the command's key):\n"+
+		"  row-1: k1\n"+
+		"  row-2: k2\n", report)
+
+	assert.Equal(t, "Idempotency key: base (to retry this exact request: --idempotency-key base)\n",
+		formatIdempotencyReport("base", []api.SentIdempotencyKey{{Key: "base"}}))
+}
+
+func TestFormatTraceSummary(t *testing.T) {
+	assert.Equal(t, "trace: 1 API request, 120ms in API, 150ms total",
+		formatTraceSummary(api.TraceSummary{Requests: 1, APITime: 120 * time.Millisecond}, 150*time.Millisecond))
+	assert.Equal(t, "trace: 3 API requests, 1.2s in API, 1.5s total",
+		formatTraceSummary(api.TraceSummary{Requests: 3, APITime: 1200 * time.Millisecond}, 1500*time.Millisecond))
+}
@@ -85,7 +85,7 @@ var screeningsKYCCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		kyc, err := client.GetKYCDetails(cmd.Context(), args[0])
+		kyc, err := client.GetKYCDetails(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "get KYC details")
 		}
@@ -123,7 +123,7 @@ var screeningsAMLCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		aml, err := client.GetAMLData(cmd.Context())
+		aml, err := client.GetAMLData(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "get AML data")
 		}
@@ -32,7 +32,7 @@ var shiftsListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		shifts, err := client.ListShifts(cmd.Context(), api.ShiftsListParams{
+		shifts, err := client.ListShifts(api.WithServerFields(cmd.Context()), api.ShiftsListParams{
 			WorkerID:  shiftsWorkerFlag,
 			StartDate: shiftsStartFlag,
 			EndDate:   shiftsEndFlag,
@@ -71,7 +71,7 @@ var shiftsRatesCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		rates, err := client.ListShiftRates(cmd.Context(), shiftsCountryFlag)
+		rates, err := client.ListShiftRates(api.WithServerFields(cmd.Context()), shiftsCountryFlag)
 		if err != nil {
 			return HandleError(f, err, "list rates")
 		}
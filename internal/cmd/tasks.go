@@ -46,7 +46,7 @@ var tasksListCmd = &cobra.Command{
 			return HandleError(f, err, "listing tasks")
 		}
 
-		tasks, page, hasMore, err := collectCursorItems(cmd.Context(), tasksAllFlag, tasksCursorFlag, tasksLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.Task], error) {
+		tasks, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), tasksAllFlag, tasksCursorFlag, tasksLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.Task], error) {
 			resp, err := client.ListTasks(ctx, api.TasksListParams{
 				ContractID: tasksContractIDFlag,
 				Status:     tasksStatusFlag,
@@ -250,7 +250,7 @@ task-based contracts to find the task (this may take a few seconds).`,
 			f.PrintText(fmt.Sprintf("Found task in contract: %s", contractID))
 		}
 
-		task, err := client.GetTask(cmd.Context(), contractID, taskID)
+		task, err := client.GetTask(api.WithServerFields(cmd.Context()), contractID, taskID)
 		if err != nil {
 			return HandleError(f, err, "getting task")
 		}
@@ -331,7 +331,8 @@ var tasksReviewManyCmd = &cobra.Command{
 		}
 
 		for contractID, taskIDs := range contractToTasks {
-			if err := client.ReviewMultipleTasks(cmd.Context(), contractID, taskIDs, status); err != nil {
+			ctx := api.WithIdempotencyPart(cmd.Context(), "contract-"+contractID)
+			if err := client.ReviewMultipleTasks(ctx, contractID, taskIDs, status); err != nil {
 				return HandleError(f, err, "review tasks")
 			}
 		}
@@ -539,6 +540,7 @@ task-based contracts to find the task (this may take a few seconds).`,
 }
 
 func init() {
+	enableWatch(tasksListCmd)
 	// List command flags
 	tasksListCmd.Flags().StringVar(&tasksContractIDFlag, "contract-id", "", "Contract ID (required)")
 	tasksListCmd.Flags().StringVar(&tasksStatusFlag, "status", "", "Filter by status")
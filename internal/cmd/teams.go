@@ -30,7 +30,7 @@ var teamsListCmd = &cobra.Command{
 			return err
 		}
 
-		teams, page, hasMore, err := collectCursorItems(cmd.Context(), teamsAllFlag, teamsCursorFlag, teamsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.Team], error) {
+		teams, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), teamsAllFlag, teamsCursorFlag, teamsLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.Team], error) {
 			resp, err := client.ListTeams(ctx, limit, cursor)
 			if err != nil {
 				return CursorListResult[api.Team]{}, err
@@ -66,7 +66,7 @@ var teamsGetCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		team, err := client.GetTeam(cmd.Context(), args[0])
+		team, err := client.GetTeam(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "get team")
 		}
@@ -26,15 +26,16 @@ var (
 )
 
 var timeOffListCmd = &cobra.Command{
-	Use:   "list",
-	Short: "List time off requests",
+	Use:     "list",
+	Short:   "List time off requests",
+	Example: "  deel time-off list --all --sort-by days --sort-desc",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		client, f, err := initClient("listing time off")
 		if err != nil {
 			return err
 		}
 
-		requests, page, hasMore, err := collectCursorItems(cmd.Context(), timeOffAllFlag, timeOffCursorFlag, timeOffLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.TimeOffRequest], error) {
+		requests, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), timeOffAllFlag, timeOffCursorFlag, timeOffLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.TimeOffRequest], error) {
 			resp, err := client.ListTimeOffRequests(ctx, api.TimeOffListParams{
 				HRISProfileID: timeOffProfileFlag,
 				Status:        timeOffStatusFlag,
@@ -65,6 +66,41 @@ var timeOffListCmd = &cobra.Command{
 	},
 }
 
+var timeOffGetCmd = &cobra.Command{
+	Use:   "get <request-id>",
+	Short: "Get time off request details",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, f, err := initClient("initializing client")
+		if err != nil {
+			return err
+		}
+
+		request, err := client.GetTimeOffRequest(api.WithServerFields(cmd.Context()), args[0])
+		if err != nil {
+			return HandleError(f, err, "get time off request")
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			f.PrintText("ID:       " + request.ID)
+			f.PrintText("Worker:   " + request.WorkerName)
+			f.PrintText("Type:     " + request.Type)
+			if request.PolicyName != "" {
+				f.PrintText("Policy:   " + request.PolicyName)
+			}
+			f.PrintText("Dates:    " + request.StartDate + " - " + request.EndDate)
+			f.PrintText(fmt.Sprintf("Days:     %.1f", request.Days))
+			f.PrintText("Status:   " + request.Status)
+			if request.Reason != "" {
+				f.PrintText("Reason:   " + request.Reason)
+			}
+			if request.ApproverComment != "" {
+				f.PrintText("Comment:  " + request.ApproverComment)
+			}
+		}, request)
+	},
+}
+
 var timeOffPoliciesCmd = &cobra.Command{
 	Use:   "policies",
 	Short: "List time off policies",
@@ -75,7 +111,7 @@ var timeOffPoliciesCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		policies, err := client.ListTimeOffPolicies(cmd.Context())
+		policies, err := client.ListTimeOffPolicies(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "list policies")
 		}
@@ -100,6 +136,7 @@ var (
 	timeOffCreateStartFlag   string
 	timeOffCreateEndFlag     string
 	timeOffCreateReasonFlag  string
+	timeOffCreateAttachFlag  string
 )
 
 var timeOffCreateCmd = &cobra.Command{
@@ -112,18 +149,34 @@ var timeOffCreateCmd = &cobra.Command{
 			timeOffCreateStartFlag == "" || timeOffCreateEndFlag == "" {
 			return failValidation(cmd, f, "required: --profile, --policy, --start, --end")
 		}
+		if err := validateDateRange(timeOffCreateStartFlag, timeOffCreateEndFlag); err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
+		var attachment *timeOffAttachment
+		if timeOffCreateAttachFlag != "" {
+			a, err := readTimeOffAttachment(timeOffCreateAttachFlag)
+			if err != nil {
+				return failValidation(cmd, f, err.Error())
+			}
+			attachment = a
+		}
 
+		details := map[string]string{
+			"ProfileID": timeOffCreateProfileFlag,
+			"PolicyID":  timeOffCreatePolicyFlag,
+			"StartDate": timeOffCreateStartFlag,
+			"EndDate":   timeOffCreateEndFlag,
+			"Reason":    timeOffCreateReasonFlag,
+		}
+		if attachment != nil {
+			details["Attachment"] = fmt.Sprintf("%s (%s, %s)", attachment.Name, attachment.ContentType, formatBytes(int64(len(attachment.Data))))
+		}
 		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
 			Operation:   "CREATE",
 			Resource:    "TimeOffRequest",
 			Description: "Create time off request",
-			Details: map[string]string{
-				"ProfileID": timeOffCreateProfileFlag,
-				"PolicyID":  timeOffCreatePolicyFlag,
-				"StartDate": timeOffCreateStartFlag,
-				"EndDate":   timeOffCreateEndFlag,
-				"Reason":    timeOffCreateReasonFlag,
-			},
+			Details:     details,
 		}); ok {
 			return err
 		}
@@ -133,13 +186,23 @@ var timeOffCreateCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		req, err := client.CreateTimeOffRequest(cmd.Context(), api.CreateTimeOffParams{
+		params := api.CreateTimeOffParams{
 			HRISProfileID: timeOffCreateProfileFlag,
 			PolicyID:      timeOffCreatePolicyFlag,
 			StartDate:     timeOffCreateStartFlag,
 			EndDate:       timeOffCreateEndFlag,
 			Reason:        timeOffCreateReasonFlag,
-		})
+		}
+
+		if attachment != nil {
+			result, err := createTimeOffWithAttachment(cmd.Context(), client, params, attachment)
+			if err != nil {
+				return HandleError(f, err, "create request")
+			}
+			return outputTimeOffCreate(cmd.Context(), f, result, attachment.Name)
+		}
+
+		req, err := client.CreateTimeOffRequest(cmd.Context(), params)
 		if err != nil {
 			return HandleError(f, err, "create request")
 		}
@@ -186,32 +249,57 @@ var timeOffCancelCmd = &cobra.Command{
 	},
 }
 
+// timeOffDecisionParams builds the approve/reject request. The notify choice
+// is always sent explicitly so --no-notify cannot be lost to an API default.
+func timeOffDecisionParams(requestID, action, comment string, noNotify bool) api.ApproveRejectParams {
+	notify := !noNotify
+	return api.ApproveRejectParams{
+		RequestID: requestID,
+		Action:    action,
+		Comment:   comment,
+		Notify:    &notify,
+	}
+}
+
+// timeOffDecisionPreview describes an approve/reject request for --dry-run.
+func timeOffDecisionPreview(params api.ApproveRejectParams) *dryrun.Preview {
+	notify := "yes"
+	if params.Notify != nil && !*params.Notify {
+		notify = "no"
+	}
+	operation, description := "APPROVE", "Approve time off request"
+	if params.Action == "reject" {
+		operation, description = "REJECT", "Reject time off request"
+	}
+	return &dryrun.Preview{
+		Operation:   operation,
+		Resource:    "TimeOffRequest",
+		Description: description,
+		Details: map[string]string{
+			"ID":            params.RequestID,
+			"Comment":       params.Comment,
+			"Notify Worker": notify,
+		},
+	}
+}
+
 // Flags for approve command
-var timeOffApproveCommentFlag string
+var (
+	timeOffApproveCommentFlag  string
+	timeOffApproveNoNotifyFlag bool
+)
 
 var timeOffApproveCmd = &cobra.Command{
 	Use:   "approve <request-id>",
 	Short: "Approve time off request",
-	Long:  "Approve a time off request. Optional --comment flag to add approval notes.",
+	Long:  "Approve a time off request. Optional --comment flag to add approval notes. The worker is notified by email unless --no-notify is set.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 
-		params := api.ApproveRejectParams{
-			RequestID: args[0],
-			Action:    "approve",
-			Comment:   timeOffApproveCommentFlag,
-		}
+		params := timeOffDecisionParams(args[0], "approve", timeOffApproveCommentFlag, timeOffApproveNoNotifyFlag)
 
-		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
-			Operation:   "APPROVE",
-			Resource:    "TimeOffRequest",
-			Description: "Approve time off request",
-			Details: map[string]string{
-				"ID":      args[0],
-				"Comment": timeOffApproveCommentFlag,
-			},
-		}); ok {
+		if ok, err := handleDryRun(cmd, f, timeOffDecisionPreview(params)); ok {
 			return err
 		}
 
@@ -237,12 +325,15 @@ var timeOffApproveCmd = &cobra.Command{
 }
 
 // Flags for reject command
-var timeOffRejectCommentFlag string
+var (
+	timeOffRejectCommentFlag  string
+	timeOffRejectNoNotifyFlag bool
+)
 
 var timeOffRejectCmd = &cobra.Command{
 	Use:   "reject <request-id>",
 	Short: "Reject time off request",
-	Long:  "Reject a time off request. Requires --comment flag to provide rejection reason.",
+	Long:  "Reject a time off request. Requires --comment flag to provide rejection reason. The worker is notified by email unless --no-notify is set.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
@@ -251,21 +342,9 @@ var timeOffRejectCmd = &cobra.Command{
 			return failValidation(cmd, f, "--comment flag is required for rejections")
 		}
 
-		params := api.ApproveRejectParams{
-			RequestID: args[0],
-			Action:    "reject",
-			Comment:   timeOffRejectCommentFlag,
-		}
+		params := timeOffDecisionParams(args[0], "reject", timeOffRejectCommentFlag, timeOffRejectNoNotifyFlag)
 
-		if ok, err := handleDryRun(cmd, f, &dryrun.Preview{
-			Operation:   "REJECT",
-			Resource:    "TimeOffRequest",
-			Description: "Reject time off request",
-			Details: map[string]string{
-				"ID":      args[0],
-				"Comment": timeOffRejectCommentFlag,
-			},
-		}); ok {
+		if ok, err := handleDryRun(cmd, f, timeOffDecisionPreview(params)); ok {
 			return err
 		}
 
@@ -316,6 +395,9 @@ var timeOffValidateCmd = &cobra.Command{
 		if timeOffValidateEndDateFlag == "" {
 			return failValidation(cmd, f, "--end-date flag is required")
 		}
+		if err := validateDateRange(timeOffValidateStartDateFlag, timeOffValidateEndDateFlag); err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
 
 		client, err := getClient()
 		if err != nil {
@@ -371,7 +453,7 @@ var timeOffEntitlementsCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		entitlements, err := client.GetEntitlements(cmd.Context(), args[0])
+		entitlements, err := client.GetEntitlements(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "get entitlements")
 		}
@@ -412,7 +494,7 @@ var timeOffScheduleCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		schedule, err := client.GetWorkSchedule(cmd.Context(), args[0])
+		schedule, err := client.GetWorkSchedule(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "get work schedule")
 		}
@@ -434,6 +516,7 @@ var timeOffScheduleCmd = &cobra.Command{
 }
 
 func init() {
+	enableWatch(timeOffListCmd)
 	timeOffListCmd.Flags().StringVar(&timeOffProfileFlag, "profile", "", "HRIS profile ID")
 	timeOffListCmd.Flags().StringSliceVar(&timeOffStatusFlag, "status", nil, "Filter by status")
 	timeOffListCmd.Flags().IntVar(&timeOffLimitFlag, "limit", 100, "Maximum results")
@@ -445,12 +528,15 @@ func init() {
 	timeOffCreateCmd.Flags().StringVar(&timeOffCreateStartFlag, "start", "", "Start date YYYY-MM-DD (required)")
 	timeOffCreateCmd.Flags().StringVar(&timeOffCreateEndFlag, "end", "", "End date YYYY-MM-DD (required)")
 	timeOffCreateCmd.Flags().StringVar(&timeOffCreateReasonFlag, "reason", "", "Reason for time off")
+	timeOffCreateCmd.Flags().StringVar(&timeOffCreateAttachFlag, "attach", "", "Supporting document to upload after creating the request (PDF, JPG, or PNG; max 10 MB)")
 
 	// Approve command flags
 	timeOffApproveCmd.Flags().StringVar(&timeOffApproveCommentFlag, "comment", "", "Optional approval comment")
+	timeOffApproveCmd.Flags().BoolVar(&timeOffApproveNoNotifyFlag, "no-notify", false, "Don't email the worker about the approval")
 
 	// Reject command flags
 	timeOffRejectCmd.Flags().StringVar(&timeOffRejectCommentFlag, "comment", "", "Rejection reason (required)")
+	timeOffRejectCmd.Flags().BoolVar(&timeOffRejectNoNotifyFlag, "no-notify", false, "Don't email the worker about the rejection")
 
 	// Validate command flags
 	timeOffValidateCmd.Flags().StringVar(&timeOffValidateProfileFlag, "profile-id", "", "HRIS profile ID (required)")
@@ -458,7 +544,16 @@ func init() {
 	timeOffValidateCmd.Flags().StringVar(&timeOffValidateStartDateFlag, "start-date", "", "Start date YYYY-MM-DD (required)")
 	timeOffValidateCmd.Flags().StringVar(&timeOffValidateEndDateFlag, "end-date", "", "End date YYYY-MM-DD (required)")
 
+	timeOffEntitlementsBulkCmd.Flags().StringVar(&timeOffBulkGroupFlag, "group-id", "", "Group ID whose members to report on (required)")
+	timeOffEntitlementsBulkCmd.Flags().StringVar(&timeOffBulkTypeFlag, "type", "", "Only show entitlements of this type (e.g. vacation)")
+	timeOffEntitlementsBulkCmd.Flags().IntVar(&timeOffBulkConcurrencyFlag, "concurrency", 4, fmt.Sprintf("Members to fetch in parallel (max %d)", maxBulkConcurrency))
+
+	timeOffBalancesCmd.Flags().StringVar(&timeOffBulkManagerFlag, "manager-id", "", "Manager profile ID whose direct reports to report on (required)")
+	timeOffBalancesCmd.Flags().StringVar(&timeOffBulkTypeFlag, "type", "", "Only show entitlements of this type (e.g. vacation)")
+	timeOffBalancesCmd.Flags().IntVar(&timeOffBulkConcurrencyFlag, "concurrency", 4, fmt.Sprintf("Reports to fetch in parallel (max %d)", maxBulkConcurrency))
+
 	timeOffCmd.AddCommand(timeOffListCmd)
+	timeOffCmd.AddCommand(timeOffGetCmd)
 	timeOffCmd.AddCommand(timeOffPoliciesCmd)
 	timeOffCmd.AddCommand(timeOffCreateCmd)
 	timeOffCmd.AddCommand(timeOffCancelCmd)
@@ -466,5 +561,7 @@ func init() {
 	timeOffCmd.AddCommand(timeOffRejectCmd)
 	timeOffCmd.AddCommand(timeOffValidateCmd)
 	timeOffCmd.AddCommand(timeOffEntitlementsCmd)
+	timeOffCmd.AddCommand(timeOffEntitlementsBulkCmd)
+	timeOffCmd.AddCommand(timeOffBalancesCmd)
 	timeOffCmd.AddCommand(timeOffScheduleCmd)
 }
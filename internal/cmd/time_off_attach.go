@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+// maxTimeOffAttachmentSize caps --attach uploads (10 MB).
+const maxTimeOffAttachmentSize = 10 << 20
+
+// timeOffAttachmentTypes maps allowed --attach extensions to their MIME type.
+var timeOffAttachmentTypes = map[string]string{
+	".pdf":  "application/pdf",
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".png":  "image/png",
+}
+
+// timeOffAttachment is a validated file ready for upload.
+type timeOffAttachment struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// timeOffCreateResult is the output of time-off create --attach.
+type timeOffCreateResult struct {
+	Request         *api.TimeOffRequest    `json:"request"`
+	Attachment      *api.TimeOffAttachment `json:"attachment,omitempty"`
+	Attached        bool                   `json:"attached"`
+	AttachmentError string                 `json:"attachment_error,omitempty"`
+}
+
+// readTimeOffAttachment checks the file type and size before anything is
+// sent, so a bad --attach fails without creating the request.
+func readTimeOffAttachment(path string) (*timeOffAttachment, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	contentType, ok := timeOffAttachmentTypes[ext]
+	if !ok {
+		exts := make([]string, 0, len(timeOffAttachmentTypes))
+		for e := range timeOffAttachmentTypes {
+			exts = append(exts, e)
+		}
+		sort.Strings(exts)
+		return nil, fmt.Errorf("unsupported attachment type %q (allowed: %s)", ext, strings.Join(exts, ", "))
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read attachment: %w", err)
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("attachment %q is a directory", path)
+	}
+	if info.Size() == 0 {
+		return nil, fmt.Errorf("attachment %q is empty", path)
+	}
+	if info.Size() > maxTimeOffAttachmentSize {
+		return nil, fmt.Errorf("attachment %q is %s; the limit is %s", path, formatBytes(info.Size()), formatBytes(maxTimeOffAttachmentSize))
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read attachment: %w", err)
+	}
+	return &timeOffAttachment{
+		Name:        filepath.Base(path),
+		ContentType: contentType,
+		Data:        data,
+	}, nil
+}
+
+// createTimeOffWithAttachment creates the request, then uploads the attachment
+// to it. An upload failure does not undo the request; it is reported in the
+// result so the caller can retry the upload.
+func createTimeOffWithAttachment(ctx context.Context, client *api.Client, params api.CreateTimeOffParams, attachment *timeOffAttachment) (*timeOffCreateResult, error) {
+	req, err := client.CreateTimeOffRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &timeOffCreateResult{Request: req}
+	uploaded, err := client.UploadTimeOffAttachment(api.WithIdempotencyPart(ctx, "attach"), req.ID, attachment.Name, attachment.ContentType, attachment.Data)
+	if err != nil {
+		result.AttachmentError = err.Error()
+		return result, nil
+	}
+	result.Attachment = uploaded
+	result.Attached = true
+	return result, nil
+}
+
+// outputTimeOffCreate prints the result of time-off create --attach. A failed
+// upload leaves the request in place, so the result is still printed, but
+// with "ok": false and a non-zero exit so scripts notice the missing file.
+func outputTimeOffCreate(ctx context.Context, f *outfmt.Formatter, result *timeOffCreateResult, name string) error {
+	var failure error
+	if !result.Attached {
+		failure = fmt.Errorf("time off request %s created but attachment upload failed: %s", result.Request.ID, result.AttachmentError)
+	}
+	return outputBatchResults(ctx, f, func() {
+		f.PrintSuccess("Created time off request: %s", result.Request.ID)
+		if result.Attached {
+			f.PrintSuccess("Attached %s", name)
+			return
+		}
+		f.PrintWarning("Request created but attachment upload failed: %s", result.AttachmentError)
+	}, result, failure)
+}
+
+func formatBytes(n int64) string {
+	const mb = 1 << 20
+	if n >= mb {
+		return fmt.Sprintf("%.1f MB", float64(n)/mb)
+	}
+	return fmt.Sprintf("%d KB", (n+1023)/1024)
+}
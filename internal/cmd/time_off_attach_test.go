@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+func writeAttachment(t *testing.T, name string, size int) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, make([]byte, size), 0o600))
+	return path
+}
+
+func TestReadTimeOffAttachment(t *testing.T) {
+	a, err := readTimeOffAttachment(writeAttachment(t, "Certificate.PDF", 128))
+	require.NoError(t, err)
+	assert.Equal(t, "Certificate.PDF", a.Name)
+	assert.Equal(t, "application/pdf", a.ContentType)
+	assert.Len(t, a.Data, 128)
+}
+
+func TestReadTimeOffAttachment_RejectsBadFiles(t *testing.T) {
+	_, err := readTimeOffAttachment(writeAttachment(t, "note.exe", 10))
+	assert.ErrorContains(t, err, `unsupported attachment type ".exe"`)
+
+	_, err = readTimeOffAttachment(writeAttachment(t, "empty.png", 0))
+	assert.ErrorContains(t, err, "is empty")
+
+	_, err = readTimeOffAttachment(writeAttachment(t, "huge.jpg", maxTimeOffAttachmentSize+1))
+	assert.ErrorContains(t, err, "the limit is 10.0 MB")
+
+	_, err = readTimeOffAttachment(filepath.Join(t.TempDir(), "missing.pdf"))
+	assert.ErrorContains(t, err, "cannot read attachment")
+}
+
+func TestCreateTimeOffWithAttachment_UploadsAfterCreate(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+
+	var calls []string
+	server.Handle("POST", "/rest/v2/time-off", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "create")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"to-1","status":"requested"}}`))
+	})
+	server.Handle("POST", "/rest/v2/time-off/to-1/attachments", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "attach")
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		body, _ := io.ReadAll(file)
+		assert.Equal(t, "cert.pdf", header.Filename)
+		assert.Equal(t, "application/pdf", header.Header.Get("Content-Type"))
+		assert.Equal(t, "%PDF", string(body))
+		_, _ = w.Write([]byte(`{"data":{"id":"att-1","file_name":"cert.pdf"}}`))
+	})
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	attachment := &timeOffAttachment{Name: "cert.pdf", ContentType: "application/pdf", Data: []byte("%PDF")}
+
+	result, err := createTimeOffWithAttachment(context.Background(), client, api.CreateTimeOffParams{HRISProfileID: "p-1"}, attachment)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"create", "attach"}, calls)
+	assert.True(t, result.Attached)
+	assert.Equal(t, "att-1", result.Attachment.ID)
+	assert.Equal(t, "to-1", result.Request.ID)
+}
+
+func TestCreateTimeOffWithAttachment_ReportsUploadFailure(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+
+	server.HandleJSON("POST", "/rest/v2/time-off", http.StatusCreated, map[string]any{"data": map[string]any{"id": "to-1"}})
+	server.HandleError("POST", "/rest/v2/time-off/to-1/attachments", http.StatusBadRequest, "file rejected")
+
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	client.SetRetryConfig(0, 0, 0)
+	attachment := &timeOffAttachment{Name: "cert.png", ContentType: "image/png", Data: []byte("png")}
+
+	result, err := createTimeOffWithAttachment(context.Background(), client, api.CreateTimeOffParams{}, attachment)
+	require.NoError(t, err)
+	assert.False(t, result.Attached)
+	assert.Equal(t, "to-1", result.Request.ID)
+	assert.Contains(t, result.AttachmentError, "file rejected")
+}
+
+func TestOutputTimeOffCreate_UploadFailureExitsNonZero(t *testing.T) {
+	resetAgentErrorEmitted()
+	defer resetAgentErrorEmitted()
+
+	var out, errOut bytes.Buffer
+	f := outfmt.New(&out, &errOut, outfmt.FormatJSON, "never")
+	f.SetAgentMode(true)
+	ctx := outfmt.WithAgent(context.Background(), true)
+
+	result := &timeOffCreateResult{Request: &api.TimeOffRequest{ID: "to-1"}, AttachmentError: "file rejected"}
+	err := outputTimeOffCreate(ctx, f, result, "cert.png")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "to-1")
+	assert.NotEqual(t, 0, ExitCode(err))
+	assert.True(t, AgentErrorEmitted(), "main must not print a second error object")
+
+	var payload struct {
+		OK     bool `json:"ok"`
+		Result struct {
+			Data timeOffCreateResult `json:"data"`
+		} `json:"result"`
+	}
+	require.NoError(t, json.NewDecoder(&out).Decode(&payload))
+	assert.False(t, payload.OK)
+	assert.Equal(t, "to-1", payload.Result.Data.Request.ID)
+	assert.Equal(t, "file rejected", payload.Result.Data.AttachmentError)
+}
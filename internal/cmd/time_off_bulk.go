@@ -0,0 +1,291 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/batch"
+)
+
+var (
+	timeOffBulkGroupFlag       string
+	timeOffBulkManagerFlag     string
+	timeOffBulkTypeFlag        string
+	timeOffBulkConcurrencyFlag int
+)
+
+// memberEntitlements is one group member's entry in entitlements-bulk output.
+// A member whose fetch failed keeps its row with Error set.
+type memberEntitlements struct {
+	ProfileID    string            `json:"profile_id"`
+	Name         string            `json:"name"`
+	Email        string            `json:"email,omitempty"`
+	Entitlements []api.Entitlement `json:"entitlements"`
+	OK           bool              `json:"ok"`
+	Error        string            `json:"error,omitempty"`
+}
+
+var timeOffEntitlementsBulkCmd = &cobra.Command{
+	Use:   "entitlements-bulk",
+	Short: "Show entitlements for every member of a group",
+	Long: `Fetch time off entitlements for every member of a group and show the
+balances as a worker by type matrix. A member whose entitlements cannot be
+fetched is reported on its own row; the others are still shown.`,
+	Example: `  deel time-off entitlements-bulk --group-id grp-123
+  deel time-off entitlements-bulk --group-id grp-123 --type vacation --json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+		if timeOffBulkGroupFlag == "" {
+			return failValidation(cmd, f, "--group-id flag is required")
+		}
+		if timeOffBulkConcurrencyFlag < 1 || timeOffBulkConcurrencyFlag > maxBulkConcurrency {
+			return failValidation(cmd, f, fmt.Sprintf("--concurrency must be between 1 and %d", maxBulkConcurrency))
+		}
+
+		client, err := getClient()
+		if err != nil {
+			return HandleError(f, err, "initializing client")
+		}
+
+		members, err := client.ListGroupMembers(cmd.Context(), timeOffBulkGroupFlag)
+		if err != nil {
+			return HandleError(f, err, "list group members")
+		}
+
+		results := fetchGroupEntitlements(cmd.Context(), client, members, timeOffBulkTypeFlag, timeOffBulkConcurrencyFlag)
+
+		failed := 0
+		for _, r := range results {
+			if !r.OK {
+				failed++
+			}
+		}
+		var failure error
+		if failed > 0 {
+			failure = fmt.Errorf("entitlements for %d of %d members could not be fetched", failed, len(results))
+		}
+
+		return outputBatchResults(cmd.Context(), f, func() {
+			if len(results) == 0 {
+				f.PrintText("No members found in group: " + timeOffBulkGroupFlag)
+				return
+			}
+			headers, rows := entitlementMatrix(results)
+			table := f.NewTable(headers...)
+			for _, row := range rows {
+				table.AddRow(row...)
+			}
+			table.Render()
+		}, results, failure)
+	},
+}
+
+var timeOffBalancesCmd = &cobra.Command{
+	Use:   "balances",
+	Short: "Show time off balances for a manager's direct reports",
+	Long: `Resolve a manager's direct reports from their worker relations and show
+each report's entitlements in one table. A report whose entitlements cannot be
+fetched is listed with the error; the others are still shown.`,
+	Example: `  deel time-off balances --manager-id prof-123
+  deel time-off balances --manager-id prof-123 --type vacation --json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+		if timeOffBulkManagerFlag == "" {
+			return failValidation(cmd, f, "--manager-id flag is required")
+		}
+		if timeOffBulkConcurrencyFlag < 1 || timeOffBulkConcurrencyFlag > maxBulkConcurrency {
+			return failValidation(cmd, f, fmt.Sprintf("--concurrency must be between 1 and %d", maxBulkConcurrency))
+		}
+
+		client, err := getClient()
+		if err != nil {
+			return HandleError(f, err, "initializing client")
+		}
+
+		relations, err := client.ListWorkerRelations(cmd.Context(), timeOffBulkManagerFlag)
+		if err != nil {
+			return HandleError(f, err, "list worker relations")
+		}
+
+		reports := directReports(relations, timeOffBulkManagerFlag, time.Now())
+		results := fetchGroupEntitlements(cmd.Context(), client, reports, timeOffBulkTypeFlag, timeOffBulkConcurrencyFlag)
+
+		failed := 0
+		for _, r := range results {
+			if !r.OK {
+				failed++
+			}
+		}
+		var failure error
+		if failed > 0 {
+			failure = fmt.Errorf("entitlements for %d of %d reports could not be fetched", failed, len(results))
+		}
+
+		return outputBatchResults(cmd.Context(), f, func() {
+			if len(results) == 0 {
+				f.PrintText("No direct reports found for manager: " + timeOffBulkManagerFlag)
+				return
+			}
+			table := f.NewTable("WORKER", "TYPE", "TOTAL", "USED", "BALANCE", "ERROR")
+			for _, row := range balanceRows(results) {
+				table.AddRow(row...)
+			}
+			table.Render()
+		}, results, failure)
+	},
+}
+
+// directReports returns the profiles that report directly to managerID, in
+// relation order and without duplicates. Relations that ended before now are
+// skipped.
+func directReports(relations []api.WorkerRelation, managerID string, now time.Time) []api.GroupMember {
+	today := now.Format(dateFormat)
+	seen := map[string]bool{}
+	var reports []api.GroupMember
+	for _, rel := range relations {
+		if rel.ManagerID != managerID || rel.ProfileID == "" || rel.ProfileID == managerID {
+			continue
+		}
+		if rel.RelationType != "" && rel.RelationType != "direct_report" {
+			continue
+		}
+		if rel.EndDate != "" && rel.EndDate < today {
+			continue
+		}
+		if seen[rel.ProfileID] {
+			continue
+		}
+		seen[rel.ProfileID] = true
+		reports = append(reports, api.GroupMember{ID: rel.ID, HRISProfileID: rel.ProfileID})
+	}
+	return reports
+}
+
+// balanceRows lists one row per worker and entitlement type. A worker whose
+// fetch failed gets a single row carrying the error.
+func balanceRows(results []memberEntitlements) [][]string {
+	var rows [][]string
+	for _, r := range results {
+		worker := r.Name
+		if worker == "" {
+			worker = r.ProfileID
+		}
+		if r.Error != "" {
+			rows = append(rows, []string{worker, "-", "-", "-", "-", r.Error})
+			continue
+		}
+		if len(r.Entitlements) == 0 {
+			rows = append(rows, []string{worker, "-", "-", "-", "-", ""})
+			continue
+		}
+		for _, ent := range r.Entitlements {
+			rows = append(rows, []string{
+				worker,
+				ent.Type,
+				fmt.Sprintf("%.1f", ent.TotalDays),
+				fmt.Sprintf("%.1f", ent.UsedDays),
+				fmt.Sprintf("%.1f", ent.Balance),
+				"",
+			})
+		}
+	}
+	return rows
+}
+
+// fetchGroupEntitlements fetches entitlements for each member with at most
+// concurrency requests in flight. Results keep member order. When entType is
+// set only entitlements of that type are kept.
+func fetchGroupEntitlements(ctx context.Context, client *api.Client, members []api.GroupMember, entType string, concurrency int) []memberEntitlements {
+	out := make([]memberEntitlements, len(members))
+	for i, m := range members {
+		out[i] = memberEntitlements{ProfileID: m.HRISProfileID, Name: m.Name, Email: m.Email}
+	}
+
+	runs := batch.Run(ctx, len(members), concurrency, func(ctx context.Context, i int) (any, error) {
+		if out[i].ProfileID == "" {
+			return nil, fmt.Errorf("member %s has no HRIS profile", members[i].ID)
+		}
+		return client.GetEntitlements(ctx, out[i].ProfileID)
+	})
+
+	for _, r := range runs {
+		if r.Error != nil {
+			out[r.Index].Error = r.Error.Error()
+			continue
+		}
+		ents := make([]api.Entitlement, 0)
+		for _, ent := range r.Data.([]api.Entitlement) {
+			if entType == "" || strings.EqualFold(ent.Type, entType) {
+				ents = append(ents, ent)
+			}
+		}
+		out[r.Index].Entitlements = ents
+		out[r.Index].OK = true
+	}
+	return out
+}
+
+// entitlementTypes returns the sorted union of entitlement types in results.
+func entitlementTypes(results []memberEntitlements) []string {
+	seen := map[string]bool{}
+	var types []string
+	for _, r := range results {
+		for _, ent := range r.Entitlements {
+			if !seen[ent.Type] {
+				seen[ent.Type] = true
+				types = append(types, ent.Type)
+			}
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// entitlementMatrix builds the worker by type balance rows. A type the member
+// has no entitlement for shows "-"; a failed member's error goes in the
+// ERROR column.
+func entitlementMatrix(results []memberEntitlements) ([]string, [][]string) {
+	types := entitlementTypes(results)
+	headers := append([]string{"WORKER"}, upperAll(types)...)
+	headers = append(headers, "ERROR")
+
+	rows := make([][]string, 0, len(results))
+	for _, r := range results {
+		name := r.Name
+		if name == "" {
+			name = r.ProfileID
+		}
+		row := []string{name}
+		balances := map[string]float64{}
+		has := map[string]bool{}
+		for _, ent := range r.Entitlements {
+			balances[ent.Type] += ent.Balance
+			has[ent.Type] = true
+		}
+		for _, typ := range types {
+			cell := "-"
+			if has[typ] {
+				cell = fmt.Sprintf("%.1f", balances[typ])
+			}
+			row = append(row, cell)
+		}
+		rows = append(rows, append(row, r.Error))
+	}
+	return headers, rows
+}
+
+func upperAll(values []string) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = strings.ToUpper(v)
+	}
+	return out
+}
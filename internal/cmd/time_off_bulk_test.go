@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+)
+
+func entitlementsClient(server *testutil.MockServer) *api.Client {
+	client := api.NewClient("test-token")
+	client.SetBaseURL(server.URL())
+	client.SetRetryConfig(0, 0, 0)
+	return client
+}
+
+func TestFetchGroupEntitlements_TwoMemberMatrix(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.HandleJSON("GET", "/rest/v2/profiles/p1/entitlements", http.StatusOK, map[string]any{
+		"data": []map[string]any{
+			{"id": "e1", "type": "vacation", "balance": 12.5},
+			{"id": "e2", "type": "sick", "balance": 5},
+		},
+	})
+	server.HandleJSON("GET", "/rest/v2/profiles/p2/entitlements", http.StatusOK, map[string]any{
+		"data": []map[string]any{
+			{"id": "e3", "type": "vacation", "balance": 3},
+		},
+	})
+
+	members := []api.GroupMember{
+		{ID: "w1", HRISProfileID: "p1", Name: "Ann Lee"},
+		{ID: "w2", HRISProfileID: "p2", Name: "Bo Kim"},
+	}
+	results := fetchGroupEntitlements(context.Background(), entitlementsClient(server), members, "", 2)
+	require.Len(t, results, 2)
+	assert.Empty(t, results[0].Error)
+	assert.Empty(t, results[1].Error)
+
+	headers, rows := entitlementMatrix(results)
+	assert.Equal(t, []string{"WORKER", "SICK", "VACATION", "ERROR"}, headers)
+	assert.Equal(t, [][]string{
+		{"Ann Lee", "5.0", "12.5", ""},
+		{"Bo Kim", "-", "3.0", ""},
+	}, rows)
+}
+
+func TestFetchGroupEntitlements_TypeFilter(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.HandleJSON("GET", "/rest/v2/profiles/p1/entitlements", http.StatusOK, map[string]any{
+		"data": []map[string]any{
+			{"id": "e1", "type": "vacation", "balance": 12.5},
+			{"id": "e2", "type": "sick", "balance": 5},
+		},
+	})
+
+	results := fetchGroupEntitlements(context.Background(), entitlementsClient(server),
+		[]api.GroupMember{{ID: "w1", HRISProfileID: "p1", Name: "Ann Lee"}}, "Vacation", 1)
+	require.Len(t, results[0].Entitlements, 1)
+	assert.Equal(t, "vacation", results[0].Entitlements[0].Type)
+}
+
+func TestFetchGroupEntitlements_AnnotatesMemberFailure(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.HandleJSON("GET", "/rest/v2/profiles/p1/entitlements", http.StatusOK, map[string]any{
+		"data": []map[string]any{{"id": "e1", "type": "vacation", "balance": 10}},
+	})
+	server.HandleError("GET", "/rest/v2/profiles/p2/entitlements", http.StatusForbidden, "no access to profile")
+
+	members := []api.GroupMember{
+		{ID: "w1", HRISProfileID: "p1", Name: "Ann Lee"},
+		{ID: "w2", HRISProfileID: "p2", Name: "Bo Kim"},
+		{ID: "w3", Name: "No Profile"},
+	}
+	results := fetchGroupEntitlements(context.Background(), entitlementsClient(server), members, "", 2)
+	require.Len(t, results, 3)
+	assert.Empty(t, results[0].Error)
+	assert.Len(t, results[0].Entitlements, 1)
+	assert.Contains(t, results[1].Error, "no access to profile")
+	assert.Contains(t, results[2].Error, "no HRIS profile")
+
+	_, rows := entitlementMatrix(results)
+	assert.Equal(t, []string{"Ann Lee", "10.0", ""}, rows[0])
+	assert.Equal(t, "-", rows[1][1])
+	assert.Contains(t, rows[1][2], "no access to profile")
+}
+
+func TestFetchGroupEntitlements_BoundsConcurrency(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+
+	var inFlight, peak atomic.Int32
+	members := make([]api.GroupMember, 8)
+	for i := range members {
+		id := fmt.Sprintf("p%d", i)
+		members[i] = api.GroupMember{ID: id, HRISProfileID: id}
+		server.Handle("GET", "/rest/v2/profiles/"+id+"/entitlements", func(w http.ResponseWriter, r *http.Request) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		})
+	}
+
+	results := fetchGroupEntitlements(context.Background(), entitlementsClient(server), members, "", 3)
+	require.Len(t, results, 8)
+	for _, r := range results {
+		assert.Empty(t, r.Error)
+	}
+	assert.LessOrEqual(t, peak.Load(), int32(3))
+	assert.Greater(t, peak.Load(), int32(1))
+}
+
+func TestDirectReports(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	relations := []api.WorkerRelation{
+		{ID: "r1", ProfileID: "p1", ManagerID: "mgr", RelationType: "direct_report"},
+		{ID: "r2", ProfileID: "p2", ManagerID: "mgr", RelationType: "dotted_line"},
+		{ID: "r3", ProfileID: "mgr", ManagerID: "boss", RelationType: "direct_report"},
+		{ID: "r4", ProfileID: "p3", ManagerID: "mgr", RelationType: "direct_report", EndDate: "2026-01-31"},
+		{ID: "r5", ProfileID: "p4", ManagerID: "mgr", RelationType: "direct_report", EndDate: "2026-03-01"},
+		{ID: "r6", ProfileID: "p1", ManagerID: "mgr", RelationType: "direct_report"},
+	}
+
+	reports := directReports(relations, "mgr", now)
+	assert.Equal(t, []api.GroupMember{
+		{ID: "r1", HRISProfileID: "p1"},
+		{ID: "r5", HRISProfileID: "p4"},
+	}, reports)
+}
+
+func TestBalanceRows(t *testing.T) {
+	server := testutil.NewMockServer()
+	defer server.Close()
+	server.HandleJSON("GET", "/rest/v2/profiles/p1/entitlements", http.StatusOK, map[string]any{
+		"data": []map[string]any{
+			{"id": "e1", "type": "vacation", "total_days": 25, "used_days": 10, "balance": 15},
+			{"id": "e2", "type": "sick", "total_days": 10, "used_days": 1.5, "balance": 8.5},
+		},
+	})
+	server.HandleError("GET", "/rest/v2/profiles/p2/entitlements", http.StatusNotFound, "profile not found")
+
+	reports := []api.GroupMember{{ID: "r1", HRISProfileID: "p1"}, {ID: "r2", HRISProfileID: "p2"}}
+	results := fetchGroupEntitlements(context.Background(), entitlementsClient(server), reports, "", 2)
+
+	rows := balanceRows(results)
+	require.Len(t, rows, 3)
+	assert.Equal(t, []string{"p1", "vacation", "25.0", "10.0", "15.0", ""}, rows[0])
+	assert.Equal(t, []string{"p1", "sick", "10.0", "1.5", "8.5", ""}, rows[1])
+	assert.Equal(t, "p2", rows[2][0])
+	assert.NotEmpty(t, rows[2][5])
+}
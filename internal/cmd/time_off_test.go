@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/api/testutil"
+)
+
+func TestTimeOffDecision_SendsNotify(t *testing.T) {
+	tests := []struct {
+		name     string
+		action   string
+		noNotify bool
+		want     bool
+	}{
+		{name: "approve notifies by default", action: "approve", want: true},
+		{name: "approve with --no-notify", action: "approve", noNotify: true, want: false},
+		{name: "reject notifies by default", action: "reject", want: true},
+		{name: "reject with --no-notify", action: "reject", noNotify: true, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := testutil.NewMockServer()
+			defer server.Close()
+			var body map[string]any
+			server.Handle(http.MethodPost, "/rest/v2/time-off-requests/approve-reject", func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data":{"request_id":"req-1","status":"approved"}}`))
+			})
+
+			client := api.NewClient("test-token")
+			client.SetBaseURL(server.URL())
+			_, err := client.ApproveRejectTimeOff(context.Background(), timeOffDecisionParams("req-1", tt.action, "ok", tt.noNotify))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.action, body["action"])
+			assert.Equal(t, tt.want, body["notify"])
+		})
+	}
+}
+
+func TestTimeOffDecisionPreview_ShowsNotify(t *testing.T) {
+	preview := timeOffDecisionPreview(timeOffDecisionParams("req-1", "approve", "", false))
+	assert.Equal(t, "APPROVE", preview.Operation)
+	assert.Equal(t, "yes", preview.Details["Notify Worker"])
+
+	preview = timeOffDecisionPreview(timeOffDecisionParams("req-1", "reject", "stale", true))
+	assert.Equal(t, "REJECT", preview.Operation)
+	assert.Equal(t, "Reject time off request", preview.Description)
+	assert.Equal(t, "no", preview.Details["Notify Worker"])
+	assert.Equal(t, "stale", preview.Details["Comment"])
+}
@@ -42,7 +42,7 @@ var timesheetsListCmd = &cobra.Command{
 			return err
 		}
 
-		timesheets, page, hasMore, err := collectCursorItems(cmd.Context(), timesheetsListAllFlag, timesheetsListCursorFlag, timesheetsListLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.Timesheet], error) {
+		timesheets, page, hasMore, err := collectCursorItems(api.WithServerFields(cmd.Context()), timesheetsListAllFlag, timesheetsListCursorFlag, timesheetsListLimitFlag, func(ctx context.Context, cursor string, limit int) (CursorListResult[api.Timesheet], error) {
 			params := api.TimesheetsListParams{
 				ContractID: timesheetsListContractIDFlag,
 				Status:     timesheetsListStatusFlag,
@@ -87,7 +87,7 @@ var timesheetsGetCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		timesheet, err := client.GetTimesheet(cmd.Context(), args[0])
+		timesheet, err := client.GetTimesheet(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "get timesheet")
 		}
@@ -375,7 +375,7 @@ var presetsListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		presets, err := client.ListHourlyPresets(cmd.Context())
+		presets, err := client.ListHourlyPresets(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "list hourly presets")
 		}
@@ -619,6 +619,7 @@ var presetsDeleteCmd = &cobra.Command{
 }
 
 func init() {
+	enableWatch(timesheetsListCmd)
 	// List command flags
 	timesheetsListCmd.Flags().StringVar(&timesheetsListContractIDFlag, "contract-id", "", "Filter by contract ID")
 	timesheetsListCmd.Flags().StringVar(&timesheetsListStatusFlag, "status", "", "Filter by status (e.g., pending, approved, rejected)")
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/climerrors"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+	"github.com/salmonumbrella/deel-cli/internal/secrets"
+)
+
+// Account metadata keys describing the stored token. The Deel API has no token
+// introspection endpoint, so expiry and scopes are whatever was recorded with
+// 'deel config accounts set-metadata'.
+const (
+	tokenMetaExpiresAt = "expires_at"
+	tokenMetaScopes    = "scopes"
+)
+
+// tokenExpiryWarnDays is how close to expiry a token must be to warn.
+const tokenExpiryWarnDays = 7
+
+// tokenInfo is what is known about the active token.
+type tokenInfo struct {
+	Account       string     `json:"account,omitempty"`
+	Profile       string     `json:"profile,omitempty"`
+	Source        string     `json:"source"`
+	Valid         bool       `json:"valid"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	DaysRemaining *int       `json:"days_remaining"`
+	Scopes        []string   `json:"scopes,omitempty"`
+}
+
+var tokensInfoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "Show what is known about the active token",
+	Long: `Check the active token (DEEL_TOKEN or the selected account) against the API
+and show its expiry and scopes.
+
+The Deel API does not expose token metadata, so expiry and scopes come from the
+account's metadata. Record them when you create the token:
+
+  deel config accounts set-metadata prod expires_at=2026-12-31 scopes=contracts:read,people:read
+
+A warning is printed when the token expires within 7 days. When the API
+rejects the token the info is still printed, and the command exits with the
+auth exit code (3).`,
+	Example: `  deel tokens info
+  deel tokens info --account prod --json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+
+		creds, err := activeCredentials()
+		if err != nil {
+			return HandleError(f, err, "load credentials")
+		}
+		info, err := newTokenInfo(creds, time.Now())
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
+		client, err := configureClient(api.NewClient(creds.Token), clientCacheAccount(creds))
+		if err != nil {
+			return HandleError(f, err, "initializing client")
+		}
+		_, err = client.Get(cmd.Context(), "/rest/v2/contracts?limit=1")
+		var (
+			apiErr   *api.APIError
+			rejected error
+		)
+		switch {
+		case err == nil:
+			info.Valid = true
+		case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized:
+			info.Valid = false
+			rejected = climerrors.Wrap(err, "check token")
+		default:
+			return HandleError(f, err, "check token")
+		}
+
+		warnTokenExpiry(f, info.ExpiresAt, info.DaysRemaining)
+		return outputTokenInfo(cmd.Context(), f, info, rejected)
+	},
+}
+
+// outputTokenInfo prints info. rejected is the API's refusal of the token,
+// or nil; when set the info is still printed in full, and the command then
+// fails with it so scripts can detect a revoked token by the exit code.
+func outputTokenInfo(ctx context.Context, f *outfmt.Formatter, info tokenInfo, rejected error) error {
+	return outputBatchResults(ctx, f, func() {
+		if info.Account != "" {
+			f.PrintText("Account:   " + info.Account)
+		}
+		if info.Profile != "" {
+			f.PrintText("Profile:   " + info.Profile)
+		}
+		f.PrintText("Source:    " + info.Source)
+		if info.Valid {
+			f.PrintText("Valid:     yes")
+		} else {
+			f.PrintText("Valid:     no (the API rejected the token)")
+		}
+		if info.CreatedAt != nil {
+			f.PrintText("Stored:    " + info.CreatedAt.Format(time.RFC3339))
+		}
+		if info.ExpiresAt != nil {
+			f.PrintText(fmt.Sprintf("Expires:   %s (%d days)", info.ExpiresAt.Format("2006-01-02"), *info.DaysRemaining))
+		} else {
+			f.PrintText("Expires:   unknown (set account metadata " + tokenMetaExpiresAt + ")")
+		}
+		if len(info.Scopes) > 0 {
+			f.PrintText("Scopes:    " + strings.Join(info.Scopes, ", "))
+		}
+	}, info, rejected)
+}
+
+// newTokenInfo describes creds from what is stored locally. Valid is left for
+// the caller to set after checking the token against the API.
+func newTokenInfo(creds secrets.Credentials, now time.Time) (tokenInfo, error) {
+	info := tokenInfo{Account: creds.Name, Profile: creds.Profile, Source: "account"}
+	if creds.Name == "" {
+		info.Source = "DEEL_TOKEN"
+	}
+	if !creds.CreatedAt.IsZero() {
+		created := creds.CreatedAt
+		info.CreatedAt = &created
+	}
+	expiresAt, days, err := tokenExpiry(creds.Metadata, now)
+	if err != nil {
+		return info, err
+	}
+	info.ExpiresAt, info.DaysRemaining = expiresAt, days
+	for _, s := range strings.Split(creds.Metadata[tokenMetaScopes], ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			info.Scopes = append(info.Scopes, s)
+		}
+	}
+	return info, nil
+}
+
+// tokenExpiry parses the expires_at account metadata (RFC 3339 or
+// YYYY-MM-DD) and returns it with the whole days left from now. Both are nil
+// when no expiry is recorded.
+func tokenExpiry(metadata map[string]string, now time.Time) (*time.Time, *int, error) {
+	raw := strings.TrimSpace(metadata[tokenMetaExpiresAt])
+	if raw == "" {
+		return nil, nil, nil
+	}
+	expiresAt, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		if expiresAt, err = time.Parse("2006-01-02", raw); err != nil {
+			return nil, nil, fmt.Errorf("invalid %s metadata %q: use YYYY-MM-DD or RFC 3339", tokenMetaExpiresAt, raw)
+		}
+	}
+	days := int(math.Floor(expiresAt.Sub(now).Hours() / 24))
+	return &expiresAt, &days, nil
+}
+
+// warnTokenExpiry prints a warning when the token has expired or expires
+// within tokenExpiryWarnDays.
+func warnTokenExpiry(f *outfmt.Formatter, expiresAt *time.Time, days *int) {
+	switch {
+	case expiresAt == nil:
+	case *days < 0:
+		f.PrintWarning("Token expired on %s; create a new one and run: deel auth add", expiresAt.Format("2006-01-02"))
+	case *days < tokenExpiryWarnDays:
+		f.PrintWarning("Token expires on %s (%d days left)", expiresAt.Format("2006-01-02"), *days)
+	}
+}
+
+func init() {
+	tokensCmd.AddCommand(tokensInfoCmd)
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/climerrors"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+	"github.com/salmonumbrella/deel-cli/internal/secrets"
+)
+
+func TestTokenExpiry(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		raw  string
+		days int
+	}{
+		{"2026-10-20", 2},
+		{"2026-10-24T12:00:00Z", 7},
+		{"2026-10-17T06:00:00Z", -1},
+	}
+	for _, tt := range tests {
+		expiresAt, days, err := tokenExpiry(map[string]string{"expires_at": tt.raw}, now)
+		require.NoError(t, err, tt.raw)
+		require.NotNil(t, expiresAt)
+		assert.Equal(t, tt.days, *days, tt.raw)
+	}
+
+	expiresAt, days, err := tokenExpiry(nil, now)
+	require.NoError(t, err)
+	assert.Nil(t, expiresAt)
+	assert.Nil(t, days)
+
+	_, _, err = tokenExpiry(map[string]string{"expires_at": "next week"}, now)
+	assert.ErrorContains(t, err, "invalid expires_at metadata")
+}
+
+func TestNewTokenInfo_JSON(t *testing.T) {
+	now := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	info, err := newTokenInfo(secrets.Credentials{
+		Name:     "prod",
+		Metadata: map[string]string{"expires_at": "2026-10-20", "scopes": "contracts:read, people:read"},
+	}, now)
+	require.NoError(t, err)
+
+	b, err := json.Marshal(info)
+	require.NoError(t, err)
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, "2026-10-20T00:00:00Z", got["expires_at"])
+	assert.Equal(t, float64(3), got["days_remaining"])
+	assert.Equal(t, []any{"contracts:read", "people:read"}, got["scopes"])
+
+	info, err = newTokenInfo(secrets.Credentials{Token: "t"}, now)
+	require.NoError(t, err)
+	assert.Equal(t, "DEEL_TOKEN", info.Source)
+	b, err = json.Marshal(info)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"expires_at":null,"days_remaining":null`)
+}
+
+func TestWarnTokenExpiry(t *testing.T) {
+	at := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		days int
+		want string
+	}{
+		{3, "Token expires on 2026-10-20 (3 days left)"},
+		{-2, "Token expired on 2026-10-20"},
+		{30, ""},
+	} {
+		var out, errOut bytes.Buffer
+		f := outfmt.New(&out, &errOut, outfmt.FormatText, "never")
+		warnTokenExpiry(f, &at, &tt.days)
+		if tt.want == "" {
+			assert.Empty(t, errOut.String())
+		} else {
+			assert.Contains(t, errOut.String(), tt.want)
+		}
+		assert.Empty(t, out.String())
+	}
+}
+
+func TestOutputTokenInfo_RejectedTokenExitsWithAuthError(t *testing.T) {
+	resetAgentErrorEmitted()
+	defer resetAgentErrorEmitted()
+
+	var out, errOut bytes.Buffer
+	f := outfmt.New(&out, &errOut, outfmt.FormatJSON, "never")
+	f.SetAgentMode(true)
+	ctx := outfmt.WithAgent(context.Background(), true)
+
+	rejected := climerrors.Wrap(&api.APIError{StatusCode: http.StatusUnauthorized, Message: "invalid token"}, "check token")
+	err := outputTokenInfo(ctx, f, tokenInfo{Account: "prod", Source: "account"}, rejected)
+	require.Error(t, err)
+	assert.Equal(t, exitAuth, ExitCode(err))
+	assert.True(t, AgentErrorEmitted(), "main must not print a second error object")
+
+	var payload struct {
+		OK     bool `json:"ok"`
+		Result struct {
+			Data tokenInfo `json:"data"`
+		} `json:"result"`
+	}
+	require.NoError(t, json.NewDecoder(&out).Decode(&payload))
+	assert.False(t, payload.OK)
+	assert.Equal(t, "prod", payload.Result.Data.Account)
+	assert.False(t, payload.Result.Data.Valid)
+}
@@ -2,9 +2,13 @@ package cmd
 
 import (
 	"fmt"
+	"math"
+	"net/mail"
+	"os"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // dateFormat is the expected date format (ISO 8601 date)
@@ -40,6 +44,23 @@ func validateCurrency(currency string) error {
 	return nil
 }
 
+// validateEmail validates that a string is a bare email address such as
+// name@example.com, without a display name or angle brackets.
+func validateEmail(email string) error {
+	if email == "" {
+		return fmt.Errorf("email cannot be empty")
+	}
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return fmt.Errorf("invalid email %q (expected name@example.com)", email)
+	}
+	_, domain, _ := strings.Cut(email, "@")
+	if !strings.Contains(domain, ".") || strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") {
+		return fmt.Errorf("invalid email %q (expected name@example.com)", email)
+	}
+	return nil
+}
+
 // validateAmount validates that a string is a valid positive monetary amount.
 func validateAmount(amount string) error {
 	if amount == "" {
@@ -55,7 +76,25 @@ func validateAmount(amount string) error {
 	return nil
 }
 
-// validateDateRange validates that start date is not after end date.
+// parsePositiveAmount parses flag, the raw value of the flag called name
+// (e.g. "--salary"), as an amount greater than zero. NaN and infinities are
+// rejected too, since strconv.ParseFloat accepts them.
+func parsePositiveAmount(flag, name string) (float64, error) {
+	val, err := strconv.ParseFloat(strings.TrimSpace(flag), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value %q (must be a number)", name, flag)
+	}
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		return 0, fmt.Errorf("invalid %s value %q (must be a finite number)", name, flag)
+	}
+	if val <= 0 {
+		return 0, fmt.Errorf("%s must be greater than zero (got %s)", name, flag)
+	}
+	return val, nil
+}
+
+// validateDateRange validates that both dates are YYYY-MM-DD and that the end
+// date is not before the start date. A single-day range is allowed.
 func validateDateRange(startDate, endDate string) error {
 	if err := validateDate(startDate); err != nil {
 		return fmt.Errorf("invalid start date: %w", err)
@@ -67,8 +106,21 @@ func validateDateRange(startDate, endDate string) error {
 	start, _ := time.Parse(dateFormat, startDate)
 	end, _ := time.Parse(dateFormat, endDate)
 
-	if start.After(end) {
-		return fmt.Errorf("start date %s cannot be after end date %s", startDate, endDate)
+	if end.Before(start) {
+		return fmt.Errorf("end date %s is before start date %s", endDate, startDate)
+	}
+	return nil
+}
+
+// validateEffectiveDate validates a YYYY-MM-DD --effective-date and rejects
+// dates before today (in now's location).
+func validateEffectiveDate(date string, now time.Time) error {
+	if err := validateDate(date); err != nil {
+		return err
+	}
+	today := now.Format(dateFormat)
+	if date < today {
+		return fmt.Errorf("effective date %s is in the past (must be %s or later)", date, today)
 	}
 	return nil
 }
@@ -81,3 +133,32 @@ func convertDateToRFC3339(date string) (string, error) {
 	t, _ := time.Parse(dateFormat, date)
 	return t.Format(time.RFC3339), nil
 }
+
+// validateTextLength validates that text is between minLen and maxLen characters.
+// A maxLen of 0 disables the upper bound.
+func validateTextLength(flag, text string, minLen, maxLen int) error {
+	n := utf8.RuneCountInString(text)
+	if n < minLen {
+		return fmt.Errorf("%s must be at least %d characters (got %d)", flag, minLen, n)
+	}
+	if maxLen > 0 && n > maxLen {
+		return fmt.Errorf("%s must be at most %d characters (got %d)", flag, maxLen, n)
+	}
+	return nil
+}
+
+// resolveTextFlag returns the trimmed value of an inline text flag or its file
+// counterpart. Setting both is an error.
+func resolveTextFlag(inline, path, inlineFlag, fileFlag string) (string, error) {
+	if inline != "" && path != "" {
+		return "", fmt.Errorf("cannot use %s and %s together", inlineFlag, fileFlag)
+	}
+	if path == "" {
+		return strings.TrimSpace(inline), nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", fileFlag, err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
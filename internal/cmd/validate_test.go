@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -168,6 +171,34 @@ func TestValidateCurrency(t *testing.T) {
 	}
 }
 
+func TestValidateEmail(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expectError bool
+	}{
+		{name: "valid", input: "ada@example.com"},
+		{name: "valid with plus and subdomain", input: "ada+ats@mail.example.co.uk"},
+		{name: "empty string", input: "", expectError: true},
+		{name: "missing at", input: "ada.example.com", expectError: true},
+		{name: "missing domain dot", input: "ada@localhost", expectError: true},
+		{name: "trailing dot", input: "ada@example.", expectError: true},
+		{name: "display name", input: "Ada <ada@example.com>", expectError: true},
+		{name: "spaces", input: " ada@example.com", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateEmail(tt.input)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateAmount(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -307,6 +338,23 @@ func TestValidateDateRange(t *testing.T) {
 	}
 }
 
+func TestValidateDateRange_Messages(t *testing.T) {
+	// A single-day request starts and ends on the same date.
+	assert.NoError(t, validateDateRange("2024-02-01", "2024-02-01"))
+
+	err := validateDateRange("2024-02-01", "2024-01-31")
+	require.Error(t, err)
+	assert.Equal(t, "end date 2024-01-31 is before start date 2024-02-01", err.Error())
+
+	err = validateDateRange("2024-02-30", "2024-03-01")
+	require.Error(t, err)
+	assert.Equal(t, `invalid start date: invalid date format "2024-02-30" (expected YYYY-MM-DD)`, err.Error())
+
+	err = validateDateRange("2024-02-01", "02/03/2024")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid end date")
+}
+
 func TestConvertDateToRFC3339(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -367,3 +415,103 @@ func TestConvertDateToRFC3339(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveTextFlag_FromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reason.txt")
+	require.NoError(t, os.WriteFile(path, []byte("  Project has come to an end\n\n"), 0o600))
+
+	got, err := resolveTextFlag("", path, "--reason", "--reason-file")
+	require.NoError(t, err)
+	assert.Equal(t, "Project has come to an end", got)
+}
+
+func TestResolveTextFlag_BothSet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reason.txt")
+	require.NoError(t, os.WriteFile(path, []byte("from file"), 0o600))
+
+	_, err := resolveTextFlag("inline", path, "--reason", "--reason-file")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot use --reason and --reason-file together")
+}
+
+func TestResolveTextFlag_MissingFile(t *testing.T) {
+	_, err := resolveTextFlag("", filepath.Join(t.TempDir(), "missing.txt"), "--reason", "--reason-file")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--reason-file")
+}
+
+func TestValidateTextLength(t *testing.T) {
+	err := validateTextLength("--reason-detail", "too short", eorTerminationDetailMinLen, eorTerminationDetailMaxLen)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at least 100 characters")
+
+	assert.NoError(t, validateTextLength("--reason-detail", strings.Repeat("a", 100), eorTerminationDetailMinLen, eorTerminationDetailMaxLen))
+
+	err = validateTextLength("--notes", strings.Repeat("a", 5001), contractTerminationNotesMinLen, contractTerminationNotesMaxLen)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at most 5000 characters")
+
+	// Multi-byte characters count as one.
+	assert.NoError(t, validateTextLength("--notes", strings.Repeat("é", 3), 3, 3))
+}
+
+func TestContractTerminationNotes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	long := strings.Repeat("The engagement ended with the project. ", 3)
+	require.NoError(t, os.WriteFile(path, []byte("\n"+long+"\n"), 0o600))
+
+	got, err := contractTerminationNotes("", path)
+	require.NoError(t, err)
+	assert.Equal(t, strings.TrimSpace(long), got, "--reason-file fills the notes")
+
+	got, err = contractTerminationNotes("too short", "")
+	require.NoError(t, err)
+	assert.Equal(t, "too short", got, "--notes is sent as given")
+
+	short := filepath.Join(t.TempDir(), "short.txt")
+	require.NoError(t, os.WriteFile(short, []byte("too short"), 0o600))
+	_, err = contractTerminationNotes("", short)
+	assert.ErrorContains(t, err, "--reason-file must be at least 100 characters")
+
+	_, err = contractTerminationNotes("inline", path)
+	assert.ErrorContains(t, err, "cannot use --notes and --reason-file together")
+
+	got, err = contractTerminationNotes("", "")
+	require.NoError(t, err)
+	assert.Empty(t, got, "notes are optional")
+}
+
+func TestParsePositiveAmount(t *testing.T) {
+	val, err := parsePositiveAmount("85000.50", "--salary")
+	require.NoError(t, err)
+	assert.Equal(t, 85000.50, val)
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "zero", input: "0", want: "--salary must be greater than zero"},
+		{name: "negative", input: "-100", want: "--salary must be greater than zero"},
+		{name: "NaN", input: "NaN", want: "must be a finite number"},
+		{name: "infinity", input: "+Inf", want: "must be a finite number"},
+		{name: "not a number", input: "abc", want: "invalid --salary value"},
+		{name: "empty", input: "", want: "invalid --salary value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parsePositiveAmount(tt.input, "--salary")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestValidateEffectiveDate(t *testing.T) {
+	now := time.Date(2026, 5, 10, 15, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, validateEffectiveDate("2026-05-10", now))
+	assert.NoError(t, validateEffectiveDate("2027-01-01", now))
+	assert.EqualError(t, validateEffectiveDate("2026-05-09", now), "effective date 2026-05-09 is in the past (must be 2026-05-10 or later)")
+	assert.Error(t, validateEffectiveDate("05/20/2026", now))
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// minWatchInterval keeps --watch from hammering the API.
+const minWatchInterval = time.Second
+
+// clearScreen moves the cursor home and clears the terminal.
+const clearScreen = "\033[H\033[2J"
+
+// enableWatch adds --watch to a command. When set, the command's RunE is
+// re-run every interval with a timestamp header on top, clearing the screen
+// first when stdout is a terminal, until Ctrl+C. Commands opt in from their init.
+func enableWatch(cmd *cobra.Command) {
+	var interval time.Duration
+	cmd.Flags().DurationVar(&interval, "watch", 0, "Re-run every interval and redraw the output (e.g. 10s; Ctrl+C to stop)")
+
+	run := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if !cmd.Flags().Changed("watch") {
+			return run(cmd, args)
+		}
+		if err := validateWatch(interval); err != nil {
+			return failValidation(cmd, getFormatter(), err.Error())
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		cmd.SetContext(ctx)
+
+		header := "Every " + interval.String() + ": " + cmd.CommandPath()
+		redraw := term.IsTerminal(int(os.Stdout.Fd()))
+		return watchLoop(ctx, os.Stdout, redraw, interval, header, time.Now, func() error {
+			return run(cmd, args)
+		})
+	}
+}
+
+// validateWatch rejects --watch where redrawing a terminal makes no sense.
+func validateWatch(interval time.Duration) error {
+	switch {
+	case interval < minWatchInterval:
+		return fmt.Errorf("--watch must be at least %s", minWatchInterval)
+	case agentFlag:
+		return fmt.Errorf("cannot use --watch with --agent (output is for pipes, not a terminal)")
+	case jsonlFlag:
+		return fmt.Errorf("cannot use --watch with --jsonl (output is for pipes, not a terminal)")
+	case outputFileFlag != "":
+		return fmt.Errorf("cannot use --watch with --output-file")
+	}
+	return nil
+}
+
+// watchLoop prints header with the refresh time and calls refresh, every
+// interval until ctx is done. out is cleared first when redraw is set (a
+// terminal); otherwise each refresh is appended, so redirected output carries
+// no escape codes. A refresh error ends the loop unless it was caused by the
+// cancellation itself.
+func watchLoop(ctx context.Context, out io.Writer, redraw bool, interval time.Duration, header string, now func() time.Time, refresh func() error) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	prefix := ""
+	if redraw {
+		prefix = clearScreen
+	}
+	for {
+		_, _ = fmt.Fprintf(out, "%s%s    %s\n\n", prefix, header, now().Format("2006-01-02 15:04:05"))
+		if err := refresh(); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchLoop_RedrawsUntilCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var out bytes.Buffer
+	now := func() time.Time { return time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC) }
+	calls := 0
+	err := watchLoop(ctx, &out, true, time.Millisecond, "Every 1ms: deel people list", now, func() error {
+		calls++
+		out.WriteString("table\n")
+		if calls == 3 {
+			cancel()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, 3, strings.Count(out.String(), clearScreen+"Every 1ms: deel people list    2026-03-01 09:30:00\n\ntable\n"))
+}
+
+func TestWatchLoop_NoClearWhenNotTerminal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var out bytes.Buffer
+	calls := 0
+	err := watchLoop(ctx, &out, false, time.Millisecond, "h", time.Now, func() error {
+		if calls++; calls == 2 {
+			cancel()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(out.String(), "h    "))
+	assert.NotContains(t, out.String(), "\033")
+}
+
+func TestWatchLoop_StopsOnRefreshError(t *testing.T) {
+	var out bytes.Buffer
+	boom := errors.New("boom")
+	err := watchLoop(context.Background(), &out, true, time.Millisecond, "h", time.Now, func() error { return boom })
+	assert.ErrorIs(t, err, boom)
+}
+
+func TestValidateWatch(t *testing.T) {
+	defer func() { agentFlag, jsonlFlag = false, false }()
+
+	assert.NoError(t, validateWatch(10*time.Second))
+	assert.EqualError(t, validateWatch(500*time.Millisecond), "--watch must be at least 1s")
+
+	agentFlag = true
+	assert.ErrorContains(t, validateWatch(10*time.Second), "--agent")
+	agentFlag = false
+
+	jsonlFlag = true
+	assert.ErrorContains(t, validateWatch(10*time.Second), "--jsonl")
+}
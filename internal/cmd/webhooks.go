@@ -2,8 +2,12 @@ package cmd
 
 import (
 	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // SHA-1 HMAC is offered only for legacy webhook receivers
 	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
+	"fmt"
+	"hash"
 	"os"
 	"strings"
 
@@ -24,6 +28,7 @@ var (
 	webhooksEventsFlag      []string
 	webhooksDescriptionFlag string
 	webhooksLimitFlag       int
+	webhooksShowSecretFlag  bool
 )
 
 var webhooksListCmd = &cobra.Command{
@@ -36,7 +41,7 @@ var webhooksListCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		webhooks, err := client.ListWebhooks(cmd.Context())
+		webhooks, err := client.ListWebhooks(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "list webhooks")
 		}
@@ -67,6 +72,7 @@ var webhooksListCmd = &cobra.Command{
 var webhooksGetCmd = &cobra.Command{
 	Use:   "get <webhook-id>",
 	Short: "Get webhook details",
+	Long:  "Show a webhook subscription. The signing secret is redacted (sk_****abcd) unless --show-secret is set.",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
@@ -75,10 +81,13 @@ var webhooksGetCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		webhook, err := client.GetWebhook(cmd.Context(), args[0])
+		webhook, err := client.GetWebhook(api.WithServerFields(cmd.Context()), args[0])
 		if err != nil {
 			return HandleError(f, err, "get webhook")
 		}
+		if !webhooksShowSecretFlag {
+			webhook = redactWebhook(webhook)
+		}
 
 		return f.OutputFiltered(cmd.Context(), func() {
 			f.PrintText("ID:          " + webhook.ID)
@@ -99,7 +108,7 @@ var webhooksGetCmd = &cobra.Command{
 var webhooksCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new webhook",
-	Long:  "Create a new webhook subscription. Requires --url and --events flags.",
+	Long:  "Create a new webhook subscription. Requires --url and --events flags. The returned signing secret is redacted unless --show-secret is set.",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 
@@ -136,6 +145,9 @@ var webhooksCreateCmd = &cobra.Command{
 		if err != nil {
 			return HandleError(f, err, "create webhook")
 		}
+		if !webhooksShowSecretFlag {
+			webhook = redactWebhook(webhook)
+		}
 
 		return f.OutputFiltered(cmd.Context(), func() {
 			f.PrintSuccess("Webhook created successfully")
@@ -343,7 +355,7 @@ var webhooksEventTypesCmd = &cobra.Command{
 			return HandleError(f, err, "initializing client")
 		}
 
-		eventTypes, err := client.ListWebhookEventTypes(cmd.Context())
+		eventTypes, err := client.ListWebhookEventTypes(api.WithServerFields(cmd.Context()))
 		if err != nil {
 			return HandleError(f, err, "list webhook event types")
 		}
@@ -369,12 +381,25 @@ var (
 	webhooksVerifyPayloadFlag       string
 	webhooksVerifyPayloadFileFlag   string
 	webhooksVerifySignedPayloadFlag string
+	webhooksVerifySchemeFlag        string
+	webhooksVerifyTimestampFlag     string
 )
 
 var webhooksVerifyCmd = &cobra.Command{
 	Use:   "verify",
 	Short: "Verify a webhook signature",
-	Long:  "Verify a webhook signature using HMAC-SHA256. Provide --secret and --signature, plus --payload/--payload-file or --signed-payload.",
+	Long: `Verify a webhook signature using HMAC-SHA256. Provide --secret and --signature, plus --payload/--payload-file or --signed-payload.
+
+--scheme controls which string is signed:
+  simple       the payload itself (default); sha256=, v1=, and comma lists are accepted
+  stripe       "<t>.<payload>", with t and one or more v1 values read from a t=...,v1=... header
+  timestamped  "<timestamp>.<payload>", with the timestamp from --timestamp
+
+The 'webhooks sign' header formats raw, prefixed, and v1 are accepted as
+aliases of simple, so the same --scheme works for both commands.`,
+	Example: `  deel webhooks verify --secret whsec_123 --signature sha256=ab12... --payload-file event.json
+  deel webhooks verify --secret whsec_123 --scheme stripe --signature "t=1717000000,v1=ab12..." --payload-file event.json
+  deel webhooks verify --secret whsec_123 --scheme timestamped --timestamp 1717000000 --signature ab12... --payload-file event.json`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		f := getFormatter()
 
@@ -388,40 +413,108 @@ var webhooksVerifyCmd = &cobra.Command{
 		var payload string
 		if webhooksVerifySignedPayloadFlag != "" {
 			payload = webhooksVerifySignedPayloadFlag
-		} else if webhooksVerifyPayloadFileFlag != "" {
-			data, err := os.ReadFile(webhooksVerifyPayloadFileFlag)
+		} else if webhooksVerifyPayloadFileFlag != "" || webhooksVerifyPayloadFlag != "" {
+			p, err := readWebhookPayload(webhooksVerifyPayloadFlag, webhooksVerifyPayloadFileFlag)
 			if err != nil {
 				return HandleError(f, err, "read payload file")
 			}
-			payload = string(data)
-		} else if webhooksVerifyPayloadFlag != "" {
-			payload = webhooksVerifyPayloadFlag
+			payload = p
 		} else {
 			return failValidation(cmd, f, "provide --payload, --payload-file, or --signed-payload")
 		}
 
-		provided := extractSignatureValue(webhooksVerifySignatureFlag)
-		computed := computeHMACSHA256(webhooksVerifySecretFlag, payload)
+		scheme := verifySchemeName(webhooksVerifySchemeFlag)
+		if webhooksVerifySignedPayloadFlag != "" && scheme != "simple" {
+			return failValidation(cmd, f, "--signed-payload is already the signed string; use --payload with --scheme "+scheme)
+		}
+		if webhooksVerifyTimestampFlag != "" && scheme == "simple" {
+			return failValidation(cmd, f, "--timestamp requires --scheme stripe or timestamped")
+		}
 
-		match := hmac.Equal([]byte(strings.ToLower(provided)), []byte(computed))
+		check, err := verifyWebhookSignature(scheme, webhooksVerifySecretFlag, webhooksVerifySignatureFlag, webhooksVerifyTimestampFlag, payload)
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
 		result := map[string]any{
-			"match":              match,
-			"computed_signature": computed,
-			"provided_signature": provided,
+			"match":              check.Match,
+			"computed_signature": check.Computed,
+			"provided_signature": check.Provided,
+		}
+		if scheme != "simple" {
+			result["scheme"] = scheme
+			result["timestamp"] = check.Timestamp
 		}
 
 		return f.OutputFiltered(cmd.Context(), func() {
-			if match {
+			if check.Match {
 				f.PrintSuccess("Signature is valid")
 			} else {
 				f.PrintError("Signature does not match")
 			}
-			f.PrintText("Computed: " + computed)
-			f.PrintText("Provided: " + provided)
+			f.PrintText("Computed: " + check.Computed)
+			f.PrintText("Provided: " + check.Provided)
 		}, result)
 	},
 }
 
+// Flags for sign command
+var (
+	webhooksSignSecretFlag      string
+	webhooksSignPayloadFlag     string
+	webhooksSignPayloadFileFlag string
+	webhooksSignSchemeFlag      string
+	webhooksSignAlgorithmFlag   string
+)
+
+var webhooksSignCmd = &cobra.Command{
+	Use:   "sign",
+	Short: "Compute a webhook signature",
+	Long: `Compute the HMAC signature for a payload, complementing 'webhooks verify'.
+
+Schemes control the header value format:
+  raw       <hex>
+  prefixed  <algorithm>=<hex> (e.g. sha256=...)
+  v1        v1=<hex>`,
+	Example: "  deel webhooks sign --secret whsec_123 --payload-file event.json\n  deel webhooks sign --secret whsec_123 --payload '{}' --scheme prefixed --json",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+
+		if webhooksSignSecretFlag == "" {
+			return failValidation(cmd, f, "--secret is required")
+		}
+		if webhooksSignPayloadFlag == "" && webhooksSignPayloadFileFlag == "" {
+			return failValidation(cmd, f, "provide --payload or --payload-file")
+		}
+		if webhooksSignPayloadFlag != "" && webhooksSignPayloadFileFlag != "" {
+			return failValidation(cmd, f, "cannot use --payload and --payload-file together")
+		}
+
+		payload, err := readWebhookPayload(webhooksSignPayloadFlag, webhooksSignPayloadFileFlag)
+		if err != nil {
+			return HandleError(f, err, "read payload file")
+		}
+
+		signature, err := computeHMACSignature(webhooksSignAlgorithmFlag, webhooksSignSecretFlag, payload)
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+		header, err := formatSignatureHeader(webhooksSignSchemeFlag, webhooksSignAlgorithmFlag, signature)
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
+		return f.OutputFiltered(cmd.Context(), func() {
+			f.PrintText("Signature: " + signature)
+			f.PrintText("Header:    " + header)
+		}, map[string]any{
+			"algorithm": strings.ToLower(webhooksSignAlgorithmFlag),
+			"scheme":    strings.ToLower(webhooksSignSchemeFlag),
+			"signature": signature,
+			"header":    header,
+		})
+	},
+}
+
 func init() {
 	// List command flags
 	webhooksListCmd.Flags().IntVar(&webhooksLimitFlag, "limit", 100, "Maximum results")
@@ -430,6 +523,10 @@ func init() {
 	webhooksCreateCmd.Flags().StringVar(&webhooksURLFlag, "url", "", "Webhook URL (required)")
 	webhooksCreateCmd.Flags().StringSliceVar(&webhooksEventsFlag, "events", []string{}, "Event types to subscribe to (required, can be specified multiple times)")
 	webhooksCreateCmd.Flags().StringVar(&webhooksDescriptionFlag, "description", "", "Webhook description (optional)")
+	webhooksCreateCmd.Flags().BoolVar(&webhooksShowSecretFlag, "show-secret", false, "Print the signing secret in full instead of redacting it")
+
+	// Get command flags
+	webhooksGetCmd.Flags().BoolVar(&webhooksShowSecretFlag, "show-secret", false, "Print the signing secret in full instead of redacting it")
 
 	// Update command flags
 	webhooksUpdateCmd.Flags().StringVar(&webhooksURLFlag, "url", "", "Webhook URL")
@@ -446,12 +543,70 @@ func init() {
 	webhooksCmd.AddCommand(webhooksDeleteCmd)
 	webhooksCmd.AddCommand(webhooksEventTypesCmd)
 	webhooksCmd.AddCommand(webhooksVerifyCmd)
+	webhooksCmd.AddCommand(webhooksSignCmd)
+	webhooksCmd.AddCommand(webhooksReplayFileCmd)
+	webhooksCmd.AddCommand(webhooksTestCmd)
+	webhooksCmd.AddCommand(webhooksListenCmd)
 
 	webhooksVerifyCmd.Flags().StringVar(&webhooksVerifySecretFlag, "secret", "", "Webhook secret (required)")
 	webhooksVerifyCmd.Flags().StringVar(&webhooksVerifySignatureFlag, "signature", "", "Signature header or value (required)")
 	webhooksVerifyCmd.Flags().StringVar(&webhooksVerifyPayloadFlag, "payload", "", "Raw payload string")
 	webhooksVerifyCmd.Flags().StringVar(&webhooksVerifyPayloadFileFlag, "payload-file", "", "Path to payload file")
 	webhooksVerifyCmd.Flags().StringVar(&webhooksVerifySignedPayloadFlag, "signed-payload", "", "Exact payload string to sign")
+	webhooksVerifyCmd.Flags().StringVar(&webhooksVerifySchemeFlag, "scheme", "simple", "How the signed string is built: simple, stripe, or timestamped (raw, prefixed, and v1 mean simple)")
+	webhooksVerifyCmd.Flags().StringVar(&webhooksVerifyTimestampFlag, "timestamp", "", "Timestamp prepended to the payload (timestamped scheme; overrides t= for stripe)")
+
+	webhooksSignCmd.Flags().StringVar(&webhooksSignSecretFlag, "secret", "", "Webhook secret (required)")
+	webhooksSignCmd.Flags().StringVar(&webhooksSignPayloadFlag, "payload", "", "Raw payload string")
+	webhooksSignCmd.Flags().StringVar(&webhooksSignPayloadFileFlag, "payload-file", "", "Path to payload file")
+	webhooksSignCmd.Flags().StringVar(&webhooksSignSchemeFlag, "scheme", "raw", "Header format: raw, prefixed, or v1")
+	webhooksSignCmd.Flags().StringVar(&webhooksSignAlgorithmFlag, "algorithm", "sha256", "HMAC algorithm: sha256, sha512, or sha1")
+
+	webhooksReplayFileCmd.Flags().StringVar(&webhooksReplayURLFlag, "url", "", "Handler URL to POST events to (required)")
+	webhooksReplayFileCmd.Flags().StringVar(&webhooksReplaySecretFlag, "secret", "", "Webhook secret used to sign each event (required)")
+	webhooksReplayFileCmd.Flags().Float64Var(&webhooksReplaySpeedFlag, "speed", 0, "Replay original event timing N times faster (0 sends without delay)")
+	webhooksReplayFileCmd.Flags().StringVar(&webhooksReplaySchemeFlag, "scheme", "raw", "Signature header format: raw, prefixed, or v1")
+
+	webhooksTestCmd.Flags().StringVar(&webhooksTestSecretFlag, "secret", "", "Signing secret (defaults to the webhook's own secret)")
+	webhooksTestCmd.Flags().StringVar(&webhooksTestEventFlag, "event", "", "Event type to send (defaults to the webhook's first event)")
+
+	webhooksListenCmd.Flags().IntVar(&webhooksListenPortFlag, "port", 9000, "Local port to listen on")
+	webhooksListenCmd.Flags().StringVar(&webhooksListenSecretFlag, "secret", "", "Webhook secret used to verify signatures (required)")
+}
+
+// redactWebhook returns a copy of w with its secret masked by redactSecret.
+func redactWebhook(w *api.Webhook) *api.Webhook {
+	redacted := *w
+	redacted.Secret = redactSecret(w.Secret)
+	return &redacted
+}
+
+// redactSecret masks a secret for display, keeping any "sk_"-style prefix and
+// the last four characters (sk_****abcd). Short secrets are masked entirely.
+func redactSecret(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	prefix, rest := "", secret
+	if i := strings.LastIndex(secret, "_"); i >= 0 && i < len(secret)-1 {
+		prefix, rest = secret[:i+1], secret[i+1:]
+	}
+	if len(rest) < 8 {
+		return prefix + "****"
+	}
+	return prefix + "****" + rest[len(rest)-4:]
+}
+
+// readWebhookPayload returns the inline payload, or the contents of path when set.
+func readWebhookPayload(payload, path string) (string, error) {
+	if path == "" {
+		return payload, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
 }
 
 func computeHMACSHA256(secret, payload string) string {
@@ -460,6 +615,130 @@ func computeHMACSHA256(secret, payload string) string {
 	return hex.EncodeToString(mac.Sum(nil))
 }
 
+// computeHMACSignature computes a hex-encoded HMAC of payload using the named algorithm.
+func computeHMACSignature(algorithm, secret, payload string) (string, error) {
+	var h func() hash.Hash
+	switch strings.ToLower(algorithm) {
+	case "", "sha256":
+		return computeHMACSHA256(secret, payload), nil
+	case "sha512":
+		h = sha512.New
+	case "sha1":
+		h = sha1.New
+	default:
+		return "", fmt.Errorf("invalid --algorithm %q (must be sha256, sha512, or sha1)", algorithm)
+	}
+	mac := hmac.New(h, []byte(secret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// formatSignatureHeader renders a signature as a header value in the given scheme.
+func formatSignatureHeader(scheme, algorithm, signature string) (string, error) {
+	switch strings.ToLower(scheme) {
+	case "", "raw":
+		return signature, nil
+	case "prefixed":
+		if algorithm == "" {
+			algorithm = "sha256"
+		}
+		return strings.ToLower(algorithm) + "=" + signature, nil
+	case "v1":
+		return "v1=" + signature, nil
+	default:
+		return "", fmt.Errorf("invalid --scheme %q (must be raw, prefixed, or v1)", scheme)
+	}
+}
+
+// verifySchemeName lowercases a verify --scheme and maps the sign header
+// formats (raw, prefixed, v1), which all sign the bare payload, to simple.
+func verifySchemeName(scheme string) string {
+	switch scheme = strings.ToLower(scheme); scheme {
+	case "raw", "prefixed", "v1":
+		return "simple"
+	default:
+		return scheme
+	}
+}
+
+// webhookSignatureCheck is the outcome of verifyWebhookSignature.
+type webhookSignatureCheck struct {
+	Match     bool
+	Computed  string
+	Provided  string
+	Timestamp string
+}
+
+// verifyWebhookSignature checks signature against payload under scheme
+// (simple, stripe, or timestamped). For stripe the timestamp and candidate
+// signatures come from a t=...,v1=... header, and timestamp, when set,
+// overrides t=; timestamped requires timestamp.
+func verifyWebhookSignature(scheme, secret, signature, timestamp, payload string) (webhookSignatureCheck, error) {
+	var candidates []string
+	switch scheme {
+	case "", "simple":
+		computed := computeHMACSHA256(secret, payload)
+		provided := extractSignatureValue(signature)
+		return webhookSignatureCheck{
+			Match:    hmac.Equal([]byte(strings.ToLower(provided)), []byte(computed)),
+			Computed: computed,
+			Provided: provided,
+		}, nil
+	case "stripe":
+		t, sigs := parseStripeSignature(signature)
+		if timestamp == "" {
+			timestamp = t
+		}
+		if timestamp == "" {
+			return webhookSignatureCheck{}, fmt.Errorf("stripe signature has no t= value; pass --timestamp")
+		}
+		if len(sigs) == 0 {
+			return webhookSignatureCheck{}, fmt.Errorf("stripe signature has no v1= value")
+		}
+		candidates = sigs
+	case "timestamped":
+		if timestamp == "" {
+			return webhookSignatureCheck{}, fmt.Errorf("--timestamp is required with --scheme timestamped")
+		}
+		candidates = []string{extractSignatureValue(signature)}
+	default:
+		return webhookSignatureCheck{}, fmt.Errorf("invalid --scheme %q (must be simple, stripe, or timestamped; raw, prefixed, and v1 mean simple)", scheme)
+	}
+
+	check := webhookSignatureCheck{
+		Computed:  computeHMACSHA256(secret, timestamp+"."+payload),
+		Provided:  candidates[0],
+		Timestamp: timestamp,
+	}
+	for _, sig := range candidates {
+		if hmac.Equal([]byte(strings.ToLower(sig)), []byte(check.Computed)) {
+			check.Match = true
+			check.Provided = sig
+			break
+		}
+	}
+	return check, nil
+}
+
+// parseStripeSignature splits a "t=<timestamp>,v1=<sig>[,v1=<sig>]" header.
+func parseStripeSignature(header string) (string, []string) {
+	var timestamp string
+	var sigs []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = strings.TrimSpace(value)
+		case "v1":
+			sigs = append(sigs, strings.TrimSpace(value))
+		}
+	}
+	return timestamp, sigs
+}
+
 func extractSignatureValue(signature string) string {
 	sig := strings.TrimSpace(signature)
 	if strings.Contains(sig, ",") {
@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// maxWebhookListenBodySize caps the body accepted by the local listener.
+const maxWebhookListenBodySize = 1 << 20 // 1MB
+
+var (
+	webhooksListenPortFlag   int
+	webhooksListenSecretFlag string
+)
+
+// webhookListenEvent describes one request received by the local listener.
+type webhookListenEvent struct {
+	ReceivedAt string          `json:"received_at"`
+	Path       string          `json:"path"`
+	EventType  string          `json:"event_type,omitempty"`
+	Verified   bool            `json:"verified"`
+	Signature  string          `json:"signature,omitempty"`
+	Computed   string          `json:"computed_signature"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+	Body       string          `json:"body,omitempty"` // set instead of Payload when the body is not JSON
+}
+
+var webhooksListenCmd = &cobra.Command{
+	Use:   "listen",
+	Short: "Run a local server that receives and verifies webhook events",
+	Long: `Start an HTTP server on 127.0.0.1:--port that accepts webhook POSTs on any
+path. Each request's ` + webhookSignatureHeader + ` header is checked against an
+HMAC-SHA256 of the body using --secret, and the event type and payload are
+printed as they arrive. Verified requests get 200; mismatches get 401.
+
+Point a tunnel or 'deel webhooks replay-file' at the listener. Press Ctrl+C to stop.`,
+	Example: `  deel webhooks listen --port 9000 --secret whsec_123
+  deel webhooks listen --secret whsec_123 --json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+
+		if webhooksListenSecretFlag == "" {
+			return failValidation(cmd, f, "--secret is required")
+		}
+		if webhooksListenPortFlag < 1 || webhooksListenPortFlag > 65535 {
+			return failValidation(cmd, f, "--port must be between 1 and 65535")
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", webhooksListenPortFlag))
+		if err != nil {
+			return HandleError(f, err, "start listener")
+		}
+
+		// Requests can arrive concurrently; keep each event's output together.
+		var mu sync.Mutex
+		handler := newWebhookListenHandler(webhooksListenSecretFlag, time.Now, func(event webhookListenEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			_ = f.OutputFiltered(ctx, func() {
+				label := event.EventType
+				if label == "" {
+					label = "(no event type)"
+				}
+				if event.Verified {
+					f.PrintSuccess("✓ %s %s  signature verified", event.ReceivedAt, label)
+				} else {
+					f.PrintError("✗ %s %s  signature mismatch", event.ReceivedAt, label)
+				}
+				f.PrintText(formatWebhookListenPayload(event))
+			}, event)
+		})
+
+		f.PrintWarning("Listening for webhooks on http://%s (Ctrl+C to stop)", listener.Addr())
+		return serveWebhookListener(ctx, listener, handler)
+	},
+}
+
+// serveWebhookListener serves handler on listener until ctx is done, then
+// shuts the server down.
+func serveWebhookListener(ctx context.Context, listener net.Listener, handler http.Handler) error {
+	server := &http.Server{
+		Handler:      handler,
+		ReadTimeout:  30 * time.Second,
+		WriteTimeout: 30 * time.Second,
+	}
+
+	serverErr := make(chan error, 1)
+	go func() {
+		if err := server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+			serverErr <- err
+		}
+	}()
+
+	select {
+	case err := <-serverErr:
+		return fmt.Errorf("server failed: %w", err)
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+		return nil
+	}
+}
+
+// newWebhookListenHandler returns a handler that verifies each POST body
+// against secret, passes the result to report, and answers 200 when the
+// signature matches and 401 when it does not.
+func newWebhookListenHandler(secret string, now func() time.Time, report func(webhookListenEvent)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookListenBodySize))
+		if err != nil {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		check, _ := verifyWebhookSignature("simple", secret, r.Header.Get(webhookSignatureHeader), "", string(body))
+		event := webhookListenEvent{
+			ReceivedAt: now().UTC().Format(time.RFC3339),
+			Path:       r.URL.Path,
+			Verified:   check.Match,
+			Signature:  check.Provided,
+			Computed:   check.Computed,
+		}
+		var fields map[string]json.RawMessage
+		if json.Valid(body) {
+			event.Payload = json.RawMessage(body)
+			if json.Unmarshal(body, &fields) == nil {
+				event.EventType = rawString(fields["event_type"])
+				if event.EventType == "" {
+					event.EventType = rawString(fields["type"])
+				}
+			}
+		} else {
+			event.Body = string(body)
+		}
+		report(event)
+
+		if !event.Verified {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// formatWebhookListenPayload indents a JSON payload for the terminal, falling
+// back to the raw body.
+func formatWebhookListenPayload(event webhookListenEvent) string {
+	if len(event.Payload) == 0 {
+		return event.Body
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, event.Payload, "  ", "  "); err != nil {
+		return string(event.Payload)
+	}
+	return "  " + buf.String()
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookListenHandler_VerifiesSignature(t *testing.T) {
+	const secret = "whsec_test"
+	const body = `{"event_type":"contract.created","data":{"id":"c1"}}`
+	now := func() time.Time { return time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC) }
+
+	var events []webhookListenEvent
+	handler := newWebhookListenHandler(secret, now, func(e webhookListenEvent) { events = append(events, e) })
+
+	req := httptest.NewRequest(http.MethodPost, "/hooks", strings.NewReader(body))
+	req.Header.Set(webhookSignatureHeader, "sha256="+computeHMACSHA256(secret, body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/hooks", strings.NewReader(body))
+	req.Header.Set(webhookSignatureHeader, computeHMACSHA256("wrong", body))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	require.Len(t, events, 2)
+	assert.True(t, events[0].Verified)
+	assert.Equal(t, "contract.created", events[0].EventType)
+	assert.Equal(t, "/hooks", events[0].Path)
+	assert.Equal(t, "2026-01-05T10:00:00Z", events[0].ReceivedAt)
+	assert.JSONEq(t, body, string(events[0].Payload))
+	assert.False(t, events[1].Verified)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Len(t, events, 2)
+}
+
+func TestWebhookListenHandler_NonJSONBody(t *testing.T) {
+	var got webhookListenEvent
+	handler := newWebhookListenHandler("s", time.Now, func(e webhookListenEvent) { got = e })
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("plain text"))
+	req.Header.Set(webhookSignatureHeader, computeHMACSHA256("s", "plain text"))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.True(t, got.Verified)
+	assert.Empty(t, got.Payload)
+	assert.Equal(t, "plain text", got.Body)
+	assert.Equal(t, "plain text", formatWebhookListenPayload(got))
+}
+
+func TestServeWebhookListener_StopsOnCancel(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- serveWebhookListener(ctx, listener, http.NotFoundHandler())
+	}()
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("listener did not shut down")
+	}
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+func TestPingWebhook_SignedDelivery(t *testing.T) {
+	const secret = "whsec_test"
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !hmac.Equal([]byte(r.Header.Get(webhookSignatureHeader)), []byte(computeHMACSHA256(secret, string(body)))) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.Unmarshal(body, &received)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	webhook := &api.Webhook{ID: "wh-1", URL: server.URL, Events: []string{"contract.created", "contract.signed"}}
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	result := pingWebhook(context.Background(), server.Client(), webhook, secret, "", now)
+	assert.True(t, result.OK, result.Error)
+	assert.Equal(t, http.StatusOK, result.Status)
+	assert.Equal(t, "contract.created", result.EventType)
+	assert.GreaterOrEqual(t, result.LatencyMS, int64(0))
+	assert.Len(t, result.Signature, 64)
+	assert.Equal(t, "wh-1", received["webhook_id"])
+	assert.Equal(t, true, received["test"])
+	assert.Equal(t, "2026-05-01T12:00:00Z", received["timestamp"])
+
+	// A wrong secret fails the receiver's check and is reported, not hidden.
+	result = pingWebhook(context.Background(), server.Client(), webhook, "other", "contract.signed", now)
+	assert.False(t, result.OK)
+	assert.Equal(t, http.StatusUnauthorized, result.Status)
+	assert.Equal(t, "contract.signed", result.EventType)
+	assert.Contains(t, result.Error, "401")
+}
+
+func TestOutputWebhookTest_FailedDeliveryReportsNotOK(t *testing.T) {
+	resetAgentErrorEmitted()
+	defer resetAgentErrorEmitted()
+
+	var out, errOut bytes.Buffer
+	f := outfmt.New(&out, &errOut, outfmt.FormatJSON, "never")
+	f.SetAgentMode(true)
+	ctx := outfmt.WithAgent(context.Background(), true)
+
+	err := outputWebhookTest(ctx, f, webhookTestResult{WebhookID: "wh-1", Status: http.StatusUnauthorized, Error: "receiver returned 401"})
+	require.Error(t, err)
+	assert.NotEqual(t, 0, ExitCode(err))
+	assert.True(t, AgentErrorEmitted(), "main must not print a second error object")
+
+	var payload struct {
+		OK     bool `json:"ok"`
+		Result struct {
+			Data webhookTestResult `json:"data"`
+		} `json:"result"`
+	}
+	require.NoError(t, json.NewDecoder(&out).Decode(&payload))
+	assert.False(t, payload.OK)
+	assert.Equal(t, "wh-1", payload.Result.Data.WebhookID)
+	assert.Equal(t, http.StatusUnauthorized, payload.Result.Data.Status)
+}
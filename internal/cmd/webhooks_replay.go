@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/salmonumbrella/deel-cli/internal/outfmt"
+)
+
+// webhookSignatureHeader carries the HMAC of a replayed event body.
+const webhookSignatureHeader = "X-Deel-Signature"
+
+var (
+	webhooksReplayURLFlag    string
+	webhooksReplaySecretFlag string
+	webhooksReplaySpeedFlag  float64
+	webhooksReplaySchemeFlag string
+)
+
+// capturedWebhookEvent is one line of a captured event log.
+type capturedWebhookEvent struct {
+	Line int
+	Type string
+	Body []byte
+	At   time.Time // zero when the line carries no usable timestamp
+}
+
+// webhookReplayResult reports the delivery of one captured event.
+type webhookReplayResult struct {
+	Line   int    `json:"line"`
+	Type   string `json:"type,omitempty"`
+	OK     bool   `json:"ok"`
+	Status int    `json:"status,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+var webhooksReplayFileCmd = &cobra.Command{
+	Use:   "replay-file <events.ndjson>",
+	Short: "Re-send captured webhook events to a local handler",
+	Long: `Re-sign and POST each event in a newline-delimited JSON file to --url,
+in file order. Each line is either the event body itself or a capture record
+of the form {"received_at": "...", "payload": {...}}, in which case only the
+payload is sent. Bodies are signed with --secret in the ` + webhookSignatureHeader + ` header.
+
+By default events are sent back to back. With --speed N the original gaps
+between events (from received_at, or the event's timestamp/created_at) are
+replayed N times faster; --speed 1 keeps real time.`,
+	Example: `  deel webhooks replay-file events.ndjson --url http://localhost:3000/webhooks --secret whsec_123
+  deel webhooks replay-file events.ndjson --url http://localhost:3000/webhooks --secret whsec_123 --speed 10`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		f := getFormatter()
+
+		if webhooksReplayURLFlag == "" {
+			return failValidation(cmd, f, "--url is required")
+		}
+		if u, err := url.Parse(webhooksReplayURLFlag); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return failValidation(cmd, f, "--url must be an http or https URL")
+		}
+		if webhooksReplaySecretFlag == "" {
+			return failValidation(cmd, f, "--secret is required")
+		}
+		if webhooksReplaySpeedFlag < 0 {
+			return failValidation(cmd, f, "--speed must be 0 (no delay) or greater")
+		}
+		if _, err := formatSignatureHeader(webhooksReplaySchemeFlag, "sha256", ""); err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
+		file, err := os.Open(args[0])
+		if err != nil {
+			return HandleError(f, err, "read event log")
+		}
+		defer func() { _ = file.Close() }()
+
+		events, err := readCapturedWebhookEvents(file)
+		if err != nil {
+			return failValidation(cmd, f, err.Error())
+		}
+
+		results := replayWebhookEvents(cmd.Context(), &http.Client{Timeout: 30 * time.Second}, events,
+			webhooksReplayURLFlag, webhooksReplaySecretFlag, webhooksReplaySchemeFlag, webhooksReplaySpeedFlag, sleepContext)
+
+		failed := 0
+		for _, r := range results {
+			if !r.OK {
+				failed++
+			}
+		}
+		var failure error
+		if failed > 0 {
+			failure = fmt.Errorf("%d of %d events were not accepted", failed, len(events))
+		}
+
+		return outputBatchResults(cmd.Context(), f, func() {
+			if len(results) == 0 {
+				f.PrintText("No events found in " + args[0])
+				return
+			}
+			table := f.NewTable("LINE", "TYPE", "STATUS", "ERROR")
+			for _, r := range results {
+				status := "-"
+				if r.Status != 0 {
+					status = fmt.Sprintf("%d", r.Status)
+				}
+				table.AddRow(fmt.Sprintf("%d", r.Line), r.Type, status, r.Error)
+			}
+			table.Render()
+		}, results, failure)
+	},
+}
+
+// readCapturedWebhookEvents parses a newline-delimited event log. Blank lines
+// are skipped; any other line must be a JSON object.
+func readCapturedWebhookEvents(r io.Reader) ([]capturedWebhookEvent, error) {
+	var events []capturedWebhookEvent
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, fmt.Errorf("line %d: not a JSON object: %w", line, err)
+		}
+
+		event := capturedWebhookEvent{Line: line, Body: append([]byte(nil), raw...)}
+		body := fields
+		if payload, ok := fields["payload"]; ok {
+			event.Body = append([]byte(nil), payload...)
+			event.At = rawTimestamp(fields["received_at"])
+			body = nil
+			_ = json.Unmarshal(payload, &body)
+		}
+		if event.At.IsZero() {
+			event.At = rawTimestamp(body["timestamp"])
+		}
+		if event.At.IsZero() {
+			event.At = rawTimestamp(body["created_at"])
+		}
+		event.Type = rawString(body["event_type"])
+		if event.Type == "" {
+			event.Type = rawString(body["type"])
+		}
+		events = append(events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// replayWebhookEvents POSTs each event to target in order, signing the body
+// with secret. When speed is positive the gap between consecutive timestamped
+// events is divided by speed and waited out with sleep. A failed delivery is
+// recorded and the replay continues; cancelling ctx stops it, and the events
+// not yet sent are recorded as failed with the context's error.
+func replayWebhookEvents(ctx context.Context, client *http.Client, events []capturedWebhookEvent, target, secret, scheme string, speed float64, sleep func(context.Context, time.Duration) error) []webhookReplayResult {
+	results := make([]webhookReplayResult, 0, len(events))
+	var prev time.Time
+	for _, event := range events {
+		if ctx.Err() != nil {
+			break
+		}
+		if speed > 0 && !prev.IsZero() && !event.At.IsZero() {
+			if gap := event.At.Sub(prev); gap > 0 {
+				if err := sleep(ctx, time.Duration(float64(gap)/speed)); err != nil {
+					break
+				}
+			}
+		}
+		if !event.At.IsZero() {
+			prev = event.At
+		}
+
+		result := webhookReplayResult{Line: event.Line, Type: event.Type}
+		status, err := deliverWebhookEvent(ctx, client, target, secret, scheme, event.Body)
+		result.Status = status
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.OK = true
+		}
+		results = append(results, result)
+	}
+	for _, event := range events[len(results):] {
+		err := ctx.Err()
+		if err == nil {
+			err = context.Canceled
+		}
+		results = append(results, webhookReplayResult{Line: event.Line, Type: event.Type, Error: "not sent: " + err.Error()})
+	}
+	return results
+}
+
+func deliverWebhookEvent(ctx context.Context, client *http.Client, target, secret, scheme string, body []byte) (int, error) {
+	header, err := formatSignatureHeader(scheme, "sha256", computeHMACSHA256(secret, string(body)))
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookSignatureHeader, header)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("handler returned %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func rawString(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return ""
+	}
+	return s
+}
+
+// rawTimestamp reads a timestamp the way --normalize-timestamps does: an
+// RFC 3339 or date string, or Unix epoch seconds or milliseconds. It returns
+// the zero time when raw holds none of these.
+func rawTimestamp(raw json.RawMessage) time.Time {
+	if len(raw) == 0 {
+		return time.Time{}
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return time.Time{}
+	}
+	t, ok := outfmt.ParseTimestamp(v)
+	if !ok {
+		return time.Time{}
+	}
+	return t
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const replayLog = `{"received_at":"2026-01-05T10:00:00Z","payload":{"event_type":"contract.created","data":{"id":"c1"}}}
+
+{"event_type":"contract.signed","timestamp":"2026-01-05T10:00:10Z","data":{"id":"c1"}}
+`
+
+func TestReadCapturedWebhookEvents(t *testing.T) {
+	events, err := readCapturedWebhookEvents(strings.NewReader(replayLog))
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, 1, events[0].Line)
+	assert.Equal(t, "contract.created", events[0].Type)
+	assert.JSONEq(t, `{"event_type":"contract.created","data":{"id":"c1"}}`, string(events[0].Body))
+	assert.Equal(t, 3, events[1].Line)
+	assert.Equal(t, "contract.signed", events[1].Type)
+	assert.Equal(t, 10*time.Second, events[1].At.Sub(events[0].At))
+
+	_, err = readCapturedWebhookEvents(strings.NewReader("{}\nnot json\n"))
+	assert.ErrorContains(t, err, "line 2")
+}
+
+func TestReplayWebhookEvents_SignsAndScalesDelay(t *testing.T) {
+	const secret = "whsec_test"
+	var mu sync.Mutex
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		computed := computeHMACSHA256(secret, string(body))
+		if !hmac.Equal([]byte(extractSignatureValue(r.Header.Get(webhookSignatureHeader))), []byte(computed)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mu.Lock()
+		bodies = append(bodies, string(body))
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	events, err := readCapturedWebhookEvents(strings.NewReader(replayLog))
+	require.NoError(t, err)
+
+	var delays []time.Duration
+	sleep := func(_ context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+
+	results := replayWebhookEvents(context.Background(), server.Client(), events, server.URL, secret, "v1", 5, sleep)
+	require.Len(t, results, 2)
+	for _, r := range results {
+		assert.Equal(t, http.StatusNoContent, r.Status)
+		assert.Empty(t, r.Error)
+	}
+	require.Len(t, bodies, 2)
+	assert.Equal(t, string(events[0].Body), bodies[0])
+	assert.Equal(t, string(events[1].Body), bodies[1])
+	assert.Equal(t, []time.Duration{2 * time.Second}, delays)
+
+	// Without --speed events are sent back to back.
+	delays = nil
+	replayWebhookEvents(context.Background(), server.Client(), events, server.URL, secret, "raw", 0, sleep)
+	assert.Empty(t, delays)
+}
+
+func TestReplayWebhookEvents_RecordsRejection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	events := []capturedWebhookEvent{{Line: 1, Body: []byte(`{}`)}, {Line: 2, Body: []byte(`{}`)}}
+	results := replayWebhookEvents(context.Background(), server.Client(), events, server.URL, "s", "raw", 0, sleepContext)
+
+	require.Len(t, results, 2)
+	assert.Equal(t, http.StatusUnauthorized, results[0].Status)
+	assert.Contains(t, results[0].Error, "401")
+	assert.Equal(t, 2, results[1].Line)
+}
+
+func TestReplayWebhookEvents_CancelFailsUnsentEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	events := []capturedWebhookEvent{{Line: 1, Body: []byte(`{}`)}, {Line: 2, Body: []byte(`{}`)}, {Line: 3, Body: []byte(`{}`)}}
+	results := replayWebhookEvents(ctx, server.Client(), events, server.URL, "s", "raw", 0, sleepContext)
+
+	require.Len(t, results, 3)
+	for _, r := range results[1:] {
+		assert.False(t, r.OK)
+		assert.Contains(t, r.Error, context.Canceled.Error())
+	}
+	assert.Equal(t, 3, results[2].Line)
+}
+
+func TestRawTimestamp(t *testing.T) {
+	want := time.Date(2026, 1, 5, 10, 0, 0, 500000000, time.UTC)
+	for _, raw := range []string{`"2026-01-05T10:00:00.5Z"`, `1767607200.5`, `1767607200500`} {
+		assert.True(t, want.Equal(rawTimestamp(json.RawMessage(raw))), raw)
+	}
+	assert.True(t, rawTimestamp(json.RawMessage(`"soon"`)).IsZero())
+	assert.True(t, rawTimestamp(nil).IsZero())
+}
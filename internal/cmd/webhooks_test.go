@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"crypto/hmac"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/salmonumbrella/deel-cli/internal/api"
+)
+
+func TestComputeHMACSignature_MatchesVerify(t *testing.T) {
+	secret := "whsec_test"
+	payload := `{"event":"contract.created"}`
+
+	signature, err := computeHMACSignature("sha256", secret, payload)
+	require.NoError(t, err)
+
+	// verify compares the extracted header value against computeHMACSHA256.
+	computed := computeHMACSHA256(secret, payload)
+	assert.True(t, hmac.Equal([]byte(extractSignatureValue(signature)), []byte(computed)))
+}
+
+func TestFormatSignatureHeader_RoundTrip(t *testing.T) {
+	signature := computeHMACSHA256("whsec_test", "payload")
+
+	for _, scheme := range []string{"raw", "prefixed", "v1"} {
+		t.Run(scheme, func(t *testing.T) {
+			header, err := formatSignatureHeader(scheme, "sha256", signature)
+			require.NoError(t, err)
+			assert.Equal(t, signature, extractSignatureValue(header))
+		})
+	}
+
+	header, err := formatSignatureHeader("prefixed", "sha256", signature)
+	require.NoError(t, err)
+	assert.Equal(t, "sha256="+signature, header)
+}
+
+func TestComputeHMACSignature_Algorithms(t *testing.T) {
+	sha512Sig, err := computeHMACSignature("sha512", "s", "p")
+	require.NoError(t, err)
+	assert.Len(t, sha512Sig, 128)
+
+	sha1Sig, err := computeHMACSignature("SHA1", "s", "p")
+	require.NoError(t, err)
+	assert.Len(t, sha1Sig, 40)
+
+	_, err = computeHMACSignature("md5", "s", "p")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--algorithm")
+
+	_, err = formatSignatureHeader("base64", "sha256", sha1Sig)
+	require.Error(t, err)
+}
+
+func TestVerifyWebhookSignature_Schemes(t *testing.T) {
+	const secret, payload, ts = "whsec_test", `{"event":"contract.created"}`, "1717000000"
+	plain := computeHMACSHA256(secret, payload)
+	stamped := computeHMACSHA256(secret, ts+"."+payload)
+
+	// The default scheme keeps the existing behavior.
+	check, err := verifyWebhookSignature("simple", secret, "sha256="+plain, "", payload)
+	require.NoError(t, err)
+	assert.True(t, check.Match)
+
+	check, err = verifyWebhookSignature("timestamped", secret, stamped, ts, payload)
+	require.NoError(t, err)
+	assert.True(t, check.Match)
+	assert.Equal(t, ts, check.Timestamp)
+
+	check, err = verifyWebhookSignature("timestamped", secret, plain, ts, payload)
+	require.NoError(t, err)
+	assert.False(t, check.Match)
+
+	_, err = verifyWebhookSignature("timestamped", secret, stamped, "", payload)
+	assert.ErrorContains(t, err, "--timestamp")
+
+	// Stripe headers may carry several v1 values during secret rotation.
+	check, err = verifyWebhookSignature("stripe", secret, "t="+ts+",v1=deadbeef,v1="+stamped, "", payload)
+	require.NoError(t, err)
+	assert.True(t, check.Match)
+	assert.Equal(t, stamped, check.Provided)
+
+	check, err = verifyWebhookSignature("stripe", secret, "t=1,v1="+stamped, "", payload)
+	require.NoError(t, err)
+	assert.False(t, check.Match)
+
+	_, err = verifyWebhookSignature("stripe", secret, "v1="+stamped, "", payload)
+	assert.ErrorContains(t, err, "t=")
+
+	_, err = verifyWebhookSignature("hmac", secret, plain, "", payload)
+	assert.ErrorContains(t, err, "--scheme")
+}
+
+func TestVerifySchemeName_AcceptsSignFormats(t *testing.T) {
+	const secret, payload = "whsec_test", `{"event":"contract.created"}`
+	for _, scheme := range []string{"raw", "prefixed", "V1"} {
+		assert.Equal(t, "simple", verifySchemeName(scheme))
+
+		header, err := formatSignatureHeader(scheme, "sha256", computeHMACSHA256(secret, payload))
+		require.NoError(t, err)
+		check, err := verifyWebhookSignature(verifySchemeName(scheme), secret, header, "", payload)
+		require.NoError(t, err)
+		assert.True(t, check.Match, "a %s header from 'webhooks sign' verifies", scheme)
+	}
+	assert.Equal(t, "stripe", verifySchemeName("Stripe"))
+}
+
+func TestRedactSecret(t *testing.T) {
+	assert.Equal(t, "sk_****cdef", redactSecret("sk_1234567890abcdef"))
+	assert.Equal(t, "whsec_****wxyz", redactSecret("whsec_abcdefghwxyz"))
+	assert.Equal(t, "****5678", redactSecret("secret12345678"))
+	assert.Equal(t, "sk_****", redactSecret("sk_short"))
+	assert.Equal(t, "****", redactSecret("tiny"))
+	assert.Equal(t, "", redactSecret(""))
+}
+
+func TestRedactWebhook_LeavesOriginalIntact(t *testing.T) {
+	original := &api.Webhook{ID: "wh-1", Secret: "sk_1234567890abcdef"}
+	redacted := redactWebhook(original)
+	assert.Equal(t, "sk_****cdef", redacted.Secret)
+	assert.Equal(t, "wh-1", redacted.ID)
+	assert.Equal(t, "sk_1234567890abcdef", original.Secret)
+}
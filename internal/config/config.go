@@ -22,6 +22,12 @@ const (
 	// EnvIdempotencyKey is the environment variable for idempotency key header
 	EnvIdempotencyKey = "DEEL_IDEMPOTENCY_KEY"
 
+	// EnvProxy sets the proxy URL for API requests (http, https, or socks5).
+	EnvProxy = "DEEL_PROXY"
+
+	// EnvBaseURL overrides the Deel API base URL (e.g. the sandbox environment).
+	EnvBaseURL = "DEEL_BASE_URL"
+
 	// EnvAgent enables agent-optimized behavior (JSON output, compact formatting, etc.).
 	EnvAgent = "DEEL_AGENT"
 
@@ -31,4 +37,17 @@ const (
 	// EnvOpenClawCredentialsDir is an OpenClaw-compatible shared credentials root.
 	// When set, Deel keyring data is stored under <value>/deel-cli/keyring.
 	EnvOpenClawCredentialsDir = "CW_CREDENTIALS_DIR"
+
+	// EnvProfile selects a named token of the account (see --profile).
+	EnvProfile = "DEEL_PROFILE"
+
+	// EnvStore selects the credential store backend: auto, keychain, or file.
+	EnvStore = "DEEL_STORE"
+
+	// EnvStorePassphrase unlocks the encrypted file credential store.
+	EnvStorePassphrase = "DEEL_STORE_PASSPHRASE"
+
+	// EnvExportPassphrase encrypts 'auth export' blobs and decrypts them on
+	// 'auth import' without prompting.
+	EnvExportPassphrase = "DEEL_EXPORT_PASSPHRASE"
 )
@@ -0,0 +1,174 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnvConfigFile overrides the config file location.
+const EnvConfigFile = "DEEL_CONFIG"
+
+// Setting keys accepted in the config file.
+const (
+	KeyAccount = "account"
+	KeyColor   = "color"
+	KeyOutput  = "output"
+	KeyRetries = "retries"
+	KeyTimeout = "timeout"
+)
+
+// Keys lists every setting the config file accepts, in display order.
+var Keys = []string{KeyAccount, KeyColor, KeyOutput, KeyRetries, KeyTimeout}
+
+// Settings holds config file values by key. Flags and DEEL_* environment
+// variables take precedence over every value here.
+type Settings map[string]string
+
+// FilePath returns the config file location: $DEEL_CONFIG when set, otherwise
+// $XDG_CONFIG_HOME/deel/config.yaml, falling back to ~/.config/deel/config.yaml.
+func FilePath() (string, error) {
+	if p := os.Getenv(EnvConfigFile); p != "" {
+		return p, nil
+	}
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "deel", "config.yaml"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("resolve config path: %w", err)
+	}
+	return filepath.Join(home, ".config", "deel", "config.yaml"), nil
+}
+
+// LoadSettings reads the config file at path. A missing file yields empty
+// settings. Unknown keys and invalid values are errors so typos surface.
+func LoadSettings(path string) (Settings, error) {
+	settings, problems, err := ReadSettings(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("config file %s: %w", path, problems[0])
+	}
+	return settings, nil
+}
+
+// ReadSettings reads the config file at path like LoadSettings, but skips
+// entries with an unknown key or an invalid value instead of failing. The
+// skipped entries are returned as problems, ordered by key, so a broken file
+// can still be listed and repaired.
+func ReadSettings(path string) (Settings, []error, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Settings{}, nil, nil
+		}
+		return nil, nil, fmt.Errorf("read config file: %w", err)
+	}
+
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, nil, fmt.Errorf("parse config file %s: %w", path, err)
+	}
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	settings := make(Settings, len(raw))
+	var problems []error
+	for _, key := range keys {
+		value := ""
+		if v := raw[key]; v != nil {
+			value = fmt.Sprint(v)
+		}
+		if err := ValidateSetting(key, value); err != nil {
+			problems = append(problems, err)
+			continue
+		}
+		settings[key] = value
+	}
+	return settings, problems, nil
+}
+
+// SaveSettings writes settings to path, creating its directory. Empty values
+// are dropped.
+func SaveSettings(path string, settings Settings) error {
+	out := make(map[string]string, len(settings))
+	for k, v := range settings {
+		if v != "" {
+			out[k] = v
+		}
+	}
+	data, err := yaml.Marshal(out)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("create config directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write config file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("write config file: %w", err)
+	}
+	return nil
+}
+
+// ValidateKey reports whether key is a known setting.
+func ValidateKey(key string) error {
+	for _, k := range Keys {
+		if k == key {
+			return nil
+		}
+	}
+	sorted := append([]string(nil), Keys...)
+	sort.Strings(sorted)
+	return fmt.Errorf("unknown config key %q (valid keys: %s)", key, strings.Join(sorted, ", "))
+}
+
+// ValidateSetting checks key and, when non-empty, its value.
+func ValidateSetting(key, value string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+	if value == "" {
+		return nil
+	}
+	switch key {
+	case KeyOutput:
+		switch value {
+		case "text", "json", "yaml", "id0":
+		default:
+			return fmt.Errorf("invalid output %q (must be 'text', 'json', 'yaml', or 'id0')", value)
+		}
+	case KeyColor:
+		switch value {
+		case "auto", "always", "never":
+		default:
+			return fmt.Errorf("invalid color %q (must be 'auto', 'always', or 'never')", value)
+		}
+	case KeyTimeout:
+		d, err := time.ParseDuration(value)
+		if err != nil || d <= 0 {
+			return fmt.Errorf("invalid timeout %q (expected a positive duration like 30s)", value)
+		}
+	case KeyRetries:
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid retries %q (expected a non-negative integer)", value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilePath(t *testing.T) {
+	t.Setenv(EnvConfigFile, "")
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("HOME", "/home/ada")
+	path, err := FilePath()
+	require.NoError(t, err)
+	assert.Equal(t, "/home/ada/.config/deel/config.yaml", path)
+
+	t.Setenv("XDG_CONFIG_HOME", "/xdg")
+	path, err = FilePath()
+	require.NoError(t, err)
+	assert.Equal(t, "/xdg/deel/config.yaml", path)
+
+	t.Setenv(EnvConfigFile, "/tmp/deel.yaml")
+	path, err = FilePath()
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/deel.yaml", path)
+}
+
+func TestSettings_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deel", "config.yaml")
+
+	settings, err := LoadSettings(path)
+	require.NoError(t, err)
+	assert.Empty(t, settings)
+
+	settings[KeyOutput] = "json"
+	settings[KeyRetries] = "5"
+	settings[KeyAccount] = ""
+	require.NoError(t, SaveSettings(path, settings))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	loaded, err := LoadSettings(path)
+	require.NoError(t, err)
+	assert.Equal(t, Settings{KeyOutput: "json", KeyRetries: "5"}, loaded)
+}
+
+func TestLoadSettings_TypedYAMLValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("retries: 2\ntimeout: 45s\ncolor: never\n"), 0o600))
+
+	settings, err := LoadSettings(path)
+	require.NoError(t, err)
+	assert.Equal(t, Settings{KeyRetries: "2", KeyTimeout: "45s", KeyColor: "never"}, settings)
+}
+
+func TestLoadSettings_RejectsUnknownKeyAndBadValue(t *testing.T) {
+	dir := t.TempDir()
+
+	unknown := filepath.Join(dir, "unknown.yaml")
+	require.NoError(t, os.WriteFile(unknown, []byte("outptu: json\n"), 0o600))
+	_, err := LoadSettings(unknown)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown config key "outptu" (valid keys: account, color, output, retries, timeout)`)
+
+	bad := filepath.Join(dir, "bad.yaml")
+	require.NoError(t, os.WriteFile(bad, []byte("timeout: soon\n"), 0o600))
+	_, err = LoadSettings(bad)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid timeout "soon"`)
+}
+
+func TestValidateSetting(t *testing.T) {
+	assert.NoError(t, ValidateSetting(KeyOutput, "yaml"))
+	assert.NoError(t, ValidateSetting(KeyAccount, ""))
+	assert.Error(t, ValidateSetting(KeyOutput, "xml"))
+	assert.Error(t, ValidateSetting(KeyColor, "sometimes"))
+	assert.Error(t, ValidateSetting(KeyRetries, "-1"))
+	assert.Error(t, ValidateSetting("token", "abc"))
+}
+
+func TestReadSettings_SkipsInvalidEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("timeout: soon\noutptu: json\nretries: 2\n"), 0o600))
+
+	settings, problems, err := ReadSettings(path)
+	require.NoError(t, err)
+	assert.Equal(t, Settings{KeyRetries: "2"}, settings)
+	require.Len(t, problems, 2)
+	assert.Contains(t, problems[0].Error(), `unknown config key "outptu"`)
+	assert.Contains(t, problems[1].Error(), `invalid timeout "soon"`)
+}
@@ -65,3 +65,89 @@ func toJSONCompatible(data interface{}) (interface{}, error) {
 
 	return result, nil
 }
+
+// wholeInputFuncs are builtins that only make sense on the full result array.
+// Streaming mode runs the filter once per item, so a filter that starts with
+// one of these would silently compute per-item values instead.
+var wholeInputFuncs = map[string]bool{
+	"length": true, "group_by": true, "sort": true, "sort_by": true,
+	"unique": true, "unique_by": true, "min": true, "max": true,
+	"min_by": true, "max_by": true, "add": true, "map": true,
+	"reverse": true, "first": true, "last": true, "flatten": true,
+	"transpose": true,
+}
+
+// Filter is a compiled JQ expression that can be run against many inputs.
+type Filter struct {
+	code *gojq.Code
+}
+
+// CompileStream compiles a JQ expression for streaming mode, where it is run
+// against each item separately. Expressions that start with a whole-array
+// builtin such as length or group_by are rejected with an error suggesting
+// non-streaming output.
+func CompileStream(expression string) (*Filter, error) {
+	query, err := gojq.Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter expression: %w", err)
+	}
+	if name := leadingFunc(query); wholeInputFuncs[name] {
+		return nil, fmt.Errorf("filter %q uses %s, which needs the whole result, but --jsonl applies the filter to each item separately; use --json instead of --jsonl to filter the full output", expression, name)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter expression: %w", err)
+	}
+	return &Filter{code: code}, nil
+}
+
+// Each runs the filter against data and calls emit once per result, so a
+// filter can produce zero, one, or many outputs for one input.
+func (f *Filter) Each(data interface{}, emit func(interface{}) error) error {
+	jsonData, err := toJSONCompatible(data)
+	if err != nil {
+		return fmt.Errorf("failed to prepare data for filter: %w", err)
+	}
+	iter := f.code.Run(jsonData)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			return nil
+		}
+		if err, ok := v.(error); ok {
+			return fmt.Errorf("filter error: %w", err)
+		}
+		if err := emit(v); err != nil {
+			return err
+		}
+	}
+}
+
+// leadingFunc returns the name of the builtin the query first applies to its
+// input, looking through leading identity steps ("." and ". | ..."), or "".
+func leadingFunc(q *gojq.Query) string {
+	for q != nil {
+		if q.Op == gojq.OpPipe {
+			if name := leadingFunc(q.Left); name != "" {
+				return name
+			}
+			if q.Left.Term == nil || q.Left.Term.Type != gojq.TermTypeIdentity || len(q.Left.Term.SuffixList) > 0 {
+				return ""
+			}
+			q = q.Right
+			continue
+		}
+		if q.Op != 0 || q.Term == nil {
+			return ""
+		}
+		switch q.Term.Type {
+		case gojq.TermTypeFunc:
+			return q.Term.Func.Name
+		case gojq.TermTypeQuery:
+			q = q.Term.Query
+		default:
+			return ""
+		}
+	}
+	return ""
+}
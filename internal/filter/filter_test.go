@@ -36,3 +36,35 @@ func TestApply_InvalidExpression(t *testing.T) {
 	_, err := Apply(data, ".[invalid")
 	require.Error(t, err)
 }
+
+func TestCompileStream_PerItem(t *testing.T) {
+	f, err := CompileStream(`.name, (.tags[] | ascii_upcase)`)
+	require.NoError(t, err)
+
+	var got []interface{}
+	for _, item := range []interface{}{
+		map[string]interface{}{"name": "a", "tags": []interface{}{"x"}},
+		map[string]interface{}{"name": "b", "tags": []interface{}{}},
+	} {
+		require.NoError(t, f.Each(item, func(v interface{}) error {
+			got = append(got, v)
+			return nil
+		}))
+	}
+	assert.Equal(t, []interface{}{"a", "X", "b"}, got)
+}
+
+func TestCompileStream_AllowsPerItemBuiltins(t *testing.T) {
+	for _, expr := range []string{".name | length", ".tags | sort", "select(.n > 1)", "{id, n: (.tags | length)}"} {
+		_, err := CompileStream(expr)
+		assert.NoError(t, err, expr)
+	}
+}
+
+func TestCompileStream_RejectsWholeArrayFilters(t *testing.T) {
+	for _, expr := range []string{"length", ". | length", "group_by(.team)", "(sort_by(.n))[0]", "map(.id)"} {
+		_, err := CompileStream(expr)
+		require.Error(t, err, expr)
+		assert.Contains(t, err.Error(), "use --json instead of --jsonl", expr)
+	}
+}
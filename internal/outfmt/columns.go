@@ -0,0 +1,151 @@
+package outfmt
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// normalizeColumn folds a column or key name so "Worker Name", "worker-name",
+// and "WORKER_NAME" all match each other.
+func normalizeColumn(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+}
+
+// columnIndex returns the index of the header matching column.
+func columnIndex(headers []string, column string) (int, error) {
+	want := normalizeColumn(column)
+	for i, h := range headers {
+		if normalizeColumn(h) == want {
+			return i, nil
+		}
+	}
+	valid := make([]string, len(headers))
+	for i, h := range headers {
+		valid[i] = normalizeColumn(h)
+	}
+	return 0, unknownColumnError(column, valid)
+}
+
+// selectColumns returns the header indexes matching the requested columns, in
+// the order the user listed them.
+func selectColumns(headers, columns []string) ([]int, error) {
+	indexes := make([]int, 0, len(columns))
+	for _, c := range columns {
+		i, err := columnIndex(headers, c)
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, i)
+	}
+	return indexes, nil
+}
+
+func unknownColumnError(column string, valid []string) error {
+	return fmt.Errorf("unknown column %q (valid columns: %s)", column, strings.Join(valid, ", "))
+}
+
+func sortedKeys(keys map[string]string) []string {
+	out := make([]string, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// transformItems converts data to generic JSON and applies fn to its payload.
+// Envelopes ({"data": ...} / {"items": ...}) are transformed in place so page
+// metadata survives.
+func transformItems(data any, fn func(any) (any, error)) (any, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var generic any
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return nil, err
+	}
+
+	if m, ok := generic.(map[string]any); ok {
+		for _, key := range []string{"data", "items"} {
+			if inner, ok := m[key]; ok {
+				out, err := fn(inner)
+				if err != nil {
+					return nil, err
+				}
+				m[key] = out
+				return m, nil
+			}
+		}
+	}
+	return fn(generic)
+}
+
+// projectColumns keeps only the requested keys in each JSON object of data.
+func projectColumns(data any, columns []string) (any, error) {
+	return transformItems(data, func(v any) (any, error) {
+		return projectValue(v, columns, unknownColumnError)
+	})
+}
+
+// projectValue keeps the keys matching columns in v. unknown builds the
+// error for a name no object has, so each flag reports it in its own terms.
+func projectValue(v any, columns []string, unknown func(name string, valid []string) error) (any, error) {
+	var objects []map[string]any
+	switch val := v.(type) {
+	case map[string]any:
+		objects = []map[string]any{val}
+	case []any:
+		for _, item := range val {
+			if obj, ok := item.(map[string]any); ok {
+				objects = append(objects, obj)
+			}
+		}
+	default:
+		return v, nil
+	}
+	if len(objects) == 0 {
+		return v, nil
+	}
+
+	keys := make(map[string]string)
+	for _, obj := range objects {
+		for k := range obj {
+			keys[normalizeColumn(k)] = k
+		}
+	}
+	selected := make([]string, 0, len(columns))
+	for _, c := range columns {
+		key, ok := keys[normalizeColumn(c)]
+		if !ok {
+			return nil, unknown(c, sortedKeys(keys))
+		}
+		selected = append(selected, key)
+	}
+
+	project := func(obj map[string]any) map[string]any {
+		out := make(map[string]any, len(selected))
+		for _, k := range selected {
+			if value, ok := obj[k]; ok {
+				out[k] = value
+			}
+		}
+		return out
+	}
+	if obj, ok := v.(map[string]any); ok {
+		return project(obj), nil
+	}
+	items := v.([]any)
+	out := make([]any, len(items))
+	for i, item := range items {
+		if obj, ok := item.(map[string]any); ok {
+			out[i] = project(obj)
+		} else {
+			out[i] = item
+		}
+	}
+	return out, nil
+}
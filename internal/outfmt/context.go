@@ -13,6 +13,11 @@ const (
 	agentKey    contextKey = "agent_mode"
 	prettyKey   contextKey = "pretty_json"
 	jsonlKey    contextKey = "jsonl"
+	versionKey  contextKey = "envelope_version"
+	opKey       contextKey = "operation"
+	partialKey  contextKey = "partial_failure"
+	idemKey     contextKey = "idempotency_key"
+	idemPartKey contextKey = "idempotency_parts"
 )
 
 // WithFormat returns a context with the output format set.
@@ -110,3 +115,76 @@ func JSONL(ctx context.Context) bool {
 	}
 	return false
 }
+
+// WithEnvelopeVersion controls whether JSON envelopes include an envelope_version field.
+func WithEnvelopeVersion(ctx context.Context, enabled bool) context.Context {
+	return context.WithValue(ctx, versionKey, enabled)
+}
+
+// EnvelopeVersionEnabled returns true if JSON envelopes should include envelope_version.
+func EnvelopeVersionEnabled(ctx context.Context) bool {
+	if v, ok := ctx.Value(versionKey).(bool); ok {
+		return v
+	}
+	return false
+}
+
+// WithOperation sets the logical operation (e.g. "contracts.create") reported
+// in JSON success envelopes.
+func WithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, opKey, operation)
+}
+
+// Operation returns the logical operation set by WithOperation, or "".
+func Operation(ctx context.Context) string {
+	if v, ok := ctx.Value(opKey).(string); ok {
+		return v
+	}
+	return ""
+}
+
+// WithPartialFailure marks output as the per-item results of a batch in which
+// some items failed. The agent-mode envelope then reports "ok": false while
+// still carrying the full results.
+func WithPartialFailure(ctx context.Context, failed bool) context.Context {
+	return context.WithValue(ctx, partialKey, failed)
+}
+
+// PartialFailure returns true if WithPartialFailure marked the output as failed.
+func PartialFailure(ctx context.Context) bool {
+	if v, ok := ctx.Value(partialKey).(bool); ok {
+		return v
+	}
+	return false
+}
+
+// WithIdempotencyKey reports the idempotency key sent with write requests in
+// JSON success envelopes, as meta.idempotency_key. key is called when output
+// is written and returns "" when no write request carried a key.
+func WithIdempotencyKey(ctx context.Context, key func() string) context.Context {
+	return context.WithValue(ctx, idemKey, key)
+}
+
+// WithIdempotencyParts reports the per-part keys (bulk rows, chained steps)
+// derived from the idempotency key in JSON success envelopes, as
+// meta.idempotency_keys keyed by part. parts is called when output is
+// written and returns nil when no part was sent.
+func WithIdempotencyParts(ctx context.Context, parts func() map[string]string) context.Context {
+	return context.WithValue(ctx, idemPartKey, parts)
+}
+
+// IdempotencyParts returns the keys reported through WithIdempotencyParts.
+func IdempotencyParts(ctx context.Context) map[string]string {
+	if parts, ok := ctx.Value(idemPartKey).(func() map[string]string); ok && parts != nil {
+		return parts()
+	}
+	return nil
+}
+
+// IdempotencyKey returns the key reported through WithIdempotencyKey, or "".
+func IdempotencyKey(ctx context.Context) string {
+	if key, ok := ctx.Value(idemKey).(func() string); ok && key != nil {
+		return key()
+	}
+	return ""
+}
@@ -0,0 +1,210 @@
+package outfmt
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// JSON output omits optional fields that are unset: typed structs tag them
+// `omitempty`, so a key is either present with a value or absent. With
+// --include-empty, includeEmptyFields rebuilds the data so every struct field
+// is present, unset ones as null (pointers, slices, maps) or their zero value
+// (strings, numbers, booleans).
+
+var (
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// errUnreadableField reports a field reflection cannot read, such as one
+// promoted from an unexported embedded struct.
+var errUnreadableField = errors.New("unreadable field")
+
+// includeEmptyFields returns data with every struct field present, ignoring
+// omitempty. Structs become maps keyed by their JSON names; types with their
+// own MarshalJSON keep its output, with any omitted fields added back.
+// Data holding fields reflection cannot read is returned unchanged.
+func includeEmptyFields(data any) (any, error) {
+	out, err := walkIncludeEmpty(reflect.ValueOf(data))
+	if errors.Is(err, errUnreadableField) {
+		return data, nil
+	}
+	return out, err
+}
+
+func walkIncludeEmpty(v reflect.Value) (any, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+	if !v.CanInterface() {
+		return nil, errUnreadableField
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return walkIncludeEmpty(v.Elem())
+	}
+
+	if hasCustomMarshaler(v.Type()) {
+		if v.Kind() != reflect.Struct {
+			return v.Interface(), nil
+		}
+		return mergeCustomMarshaled(v)
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		return structFields(v)
+	case reflect.Map:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if v.Type().Key().Kind() != reflect.String {
+			return v.Interface(), nil
+		}
+		out := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			child, err := walkIncludeEmpty(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			out[iter.Key().String()] = child
+		}
+		return out, nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Interface(), nil
+		}
+		fallthrough
+	case reflect.Array:
+		out := make([]any, v.Len())
+		for i := range out {
+			child, err := walkIncludeEmpty(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			out[i] = child
+		}
+		return out, nil
+	default:
+		return v.Interface(), nil
+	}
+}
+
+// hasCustomMarshaler reports whether t (or *t) controls its own JSON encoding.
+func hasCustomMarshaler(t reflect.Type) bool {
+	for _, m := range []reflect.Type{jsonMarshalerType, textMarshalerType} {
+		if t.Implements(m) || reflect.PointerTo(t).Implements(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeCustomMarshaled encodes v with its own MarshalJSON and adds back the
+// struct fields it omitted. Nested objects and arrays are taken from the
+// struct so their own unset fields are included too. Values that do not
+// encode as a JSON object (e.g. time.Time) are returned unchanged.
+func mergeCustomMarshaled(v reflect.Value) (any, error) {
+	ptr := reflect.New(v.Type())
+	ptr.Elem().Set(v)
+	b, err := json.Marshal(ptr.Interface())
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(bytes.TrimSpace(b), []byte("{")) {
+		return v.Interface(), nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var out map[string]any
+	if err := dec.Decode(&out); err != nil {
+		return nil, err
+	}
+
+	fields, err := structFields(v)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range fields {
+		existing, ok := out[name]
+		if !ok {
+			out[name] = value
+			continue
+		}
+		switch existing.(type) {
+		case map[string]any, []any:
+			out[name] = value
+		}
+	}
+	return out, nil
+}
+
+// structFields maps each JSON field of struct v to its walked value, following
+// encoding/json's naming rules: `json:"-"` and unexported fields are skipped,
+// and fields of untagged embedded structs are promoted unless a shallower
+// field has the same name.
+func structFields(v reflect.Value) (map[string]any, error) {
+	out := map[string]any{}
+	var embedded []reflect.Value
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		fv := v.Field(i)
+		if sf.Anonymous && name == "" {
+			ft := sf.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, fv)
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		child, err := walkIncludeEmpty(fv)
+		if err != nil {
+			return nil, err
+		}
+		out[name] = child
+	}
+
+	for _, fv := range embedded {
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		promoted, err := structFields(fv)
+		if err != nil {
+			return nil, err
+		}
+		for name, child := range promoted {
+			if _, ok := out[name]; !ok {
+				out[name] = child
+			}
+		}
+	}
+	return out, nil
+}
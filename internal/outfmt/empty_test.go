@@ -0,0 +1,83 @@
+package outfmt
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type emptyTestBase struct {
+	ID string `json:"id"`
+}
+
+type emptyTestItem struct {
+	emptyTestBase
+	Name     string            `json:"name"`
+	EndDate  string            `json:"end_date,omitempty"`
+	Amount   float64           `json:"amount,omitempty"`
+	Tags     []string          `json:"tags,omitempty"`
+	Manager  *emptyTestBase    `json:"manager,omitempty"`
+	Extra    map[string]string `json:"extra,omitempty"`
+	Internal string            `json:"-"`
+}
+
+type emptyTestMarshaler struct {
+	Amount float64 `json:"amount"`
+	Note   string  `json:"note,omitempty"`
+}
+
+func (m emptyTestMarshaler) MarshalJSON() ([]byte, error) {
+	type alias emptyTestMarshaler
+	return json.Marshal(struct {
+		alias
+		Display string `json:"display"`
+	}{alias(m), "custom"})
+}
+
+func renderEmptyTest(t *testing.T, includeEmpty bool, data any) map[string]any {
+	t.Helper()
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "never")
+	f.SetRaw(true)
+	f.SetIncludeEmpty(includeEmpty)
+	require.NoError(t, f.Output(func() {}, data))
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	return got
+}
+
+func TestIncludeEmpty_UnsetOptionalFieldOmittedByDefault(t *testing.T) {
+	got := renderEmptyTest(t, false, emptyTestItem{emptyTestBase: emptyTestBase{ID: "1"}, Name: "a"})
+	assert.Equal(t, map[string]any{"id": "1", "name": "a"}, got)
+}
+
+func TestIncludeEmpty_UnsetOptionalFieldPresentAsNullOrZero(t *testing.T) {
+	got := renderEmptyTest(t, true, &emptyTestItem{emptyTestBase: emptyTestBase{ID: "1"}, Name: "a", Internal: "secret"})
+	assert.Equal(t, map[string]any{
+		"id":       "1",
+		"name":     "a",
+		"end_date": "",
+		"amount":   float64(0),
+		"tags":     nil,
+		"manager":  nil,
+		"extra":    nil,
+	}, got)
+}
+
+func TestIncludeEmpty_NestedAndCustomMarshaler(t *testing.T) {
+	got := renderEmptyTest(t, true, map[string]any{
+		"items": []emptyTestItem{{Manager: &emptyTestBase{}}},
+		"money": emptyTestMarshaler{Amount: 5},
+	})
+
+	items := got["items"].([]any)
+	require.Len(t, items, 1)
+	item := items[0].(map[string]any)
+	assert.Contains(t, item, "end_date")
+	assert.Equal(t, map[string]any{"id": ""}, item["manager"])
+
+	assert.Equal(t, map[string]any{"amount": float64(5), "note": "", "display": "custom"}, got["money"])
+}
@@ -0,0 +1,102 @@
+package outfmt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// EnumCase is the casing --normalize-enums applies to enum fields.
+type EnumCase string
+
+const (
+	// EnumCaseNone leaves enum values as the API returned them.
+	EnumCaseNone  EnumCase = ""
+	EnumCaseLower EnumCase = "lower"
+	EnumCaseUpper EnumCase = "upper"
+)
+
+// ParseEnumCase converts a --normalize-enums value. An empty value disables
+// normalization.
+func ParseEnumCase(value string) (EnumCase, error) {
+	switch c := EnumCase(strings.ToLower(strings.TrimSpace(value))); c {
+	case EnumCaseNone, EnumCaseLower, EnumCaseUpper:
+		return c, nil
+	}
+	return EnumCaseNone, fmt.Errorf("invalid --normalize-enums %q (must be 'lower' or 'upper')", value)
+}
+
+// apply recases s.
+func (c EnumCase) apply(s string) string {
+	switch c {
+	case EnumCaseLower:
+		return strings.ToLower(s)
+	case EnumCaseUpper:
+		return strings.ToUpper(s)
+	}
+	return s
+}
+
+// enumKeys are the field names (normalized as for --columns) whose values are
+// enums across Deel endpoints.
+var enumKeys = map[string]bool{
+	"status":          true,
+	"type":            true,
+	"state":           true,
+	"severance":       true,
+	"kind":            true,
+	"payment_cycle":   true,
+	"cycle_end_type":  true,
+	"seniority_level": true,
+	"employment_type": true,
+}
+
+// isEnumKey reports whether a JSON key or table header names an enum field:
+// one of enumKeys, or anything ending in _status or _type (statusType and
+// contractType in camelCase count too).
+func isEnumKey(key string) bool {
+	if strings.HasSuffix(key, "Status") || strings.HasSuffix(key, "Type") {
+		return true
+	}
+	k := normalizeColumn(key)
+	return enumKeys[k] || strings.HasSuffix(k, "_status") || strings.HasSuffix(k, "_type")
+}
+
+// normalizeEnums recases the string values of enum fields in data. Other
+// fields, including free-text strings, are left untouched.
+func normalizeEnums(data any, c EnumCase) (any, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var generic any
+	if err := dec.Decode(&generic); err != nil {
+		return nil, err
+	}
+
+	var walk func(v any) any
+	walk = func(v any) any {
+		switch val := v.(type) {
+		case map[string]any:
+			for k, child := range val {
+				if s, ok := child.(string); ok && isEnumKey(k) {
+					val[k] = c.apply(s)
+					continue
+				}
+				val[k] = walk(child)
+			}
+			return val
+		case []any:
+			for i, item := range val {
+				val[i] = walk(item)
+			}
+			return val
+		default:
+			return v
+		}
+	}
+	return walk(generic), nil
+}
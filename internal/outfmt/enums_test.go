@@ -0,0 +1,68 @@
+package outfmt
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func enumTestData() []any {
+	return []any{
+		map[string]any{"id": "c1", "status": "Active", "type": "PAYG_TASKS", "title": "Senior Dev", "worker": map[string]any{"name": "Ada Lovelace", "contract_status": "IN_PROGRESS"}},
+		map[string]any{"id": "c2", "status": "ACTIVE", "paymentType": "Monthly", "title": "QA", "amount": 100},
+	}
+}
+
+func TestNormalizeEnums_Lower(t *testing.T) {
+	var out bytes.Buffer
+	f := New(&out, &out, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+	f.SetNormalizeEnums(EnumCaseLower)
+
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, enumTestData()))
+	assert.JSONEq(t, `{"data":[
+		{"id":"c1","status":"active","type":"payg_tasks","title":"Senior Dev","worker":{"name":"Ada Lovelace","contract_status":"in_progress"}},
+		{"id":"c2","status":"active","paymentType":"monthly","title":"QA","amount":100}
+	]}`, out.String())
+}
+
+func TestNormalizeEnums_Upper(t *testing.T) {
+	var out bytes.Buffer
+	f := New(&out, &out, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+	f.SetNormalizeEnums(EnumCaseUpper)
+
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, map[string]any{"id": "c1", "status": "active", "name": "Ada"}))
+	assert.JSONEq(t, `{"data":{"id":"c1","status":"ACTIVE","name":"Ada"}}`, out.String())
+}
+
+func TestNormalizeEnums_TextTable(t *testing.T) {
+	render := func(c EnumCase) string {
+		var out bytes.Buffer
+		f := New(&out, &out, FormatText, "never")
+		f.SetNormalizeEnums(c)
+		require.NoError(t, f.OutputFiltered(context.Background(), func() {
+			table := f.NewTable("ID", "NAME", "STATUS")
+			table.AddRow("c1", "Ada Lovelace", "Active")
+			table.Render()
+		}, nil))
+		return out.String()
+	}
+
+	assert.Contains(t, render(EnumCaseNone), "Ada Lovelace  Active")
+	assert.Contains(t, render(EnumCaseUpper), "Ada Lovelace  ACTIVE")
+	assert.Contains(t, render(EnumCaseLower), "Ada Lovelace  active")
+}
+
+func TestParseEnumCase(t *testing.T) {
+	for in, want := range map[string]EnumCase{"": EnumCaseNone, "lower": EnumCaseLower, "UPPER": EnumCaseUpper} {
+		got, err := ParseEnumCase(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	_, err := ParseEnumCase("title")
+	assert.EqualError(t, err, `invalid --normalize-enums "title" (must be 'lower' or 'upper')`)
+}
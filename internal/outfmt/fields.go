@@ -0,0 +1,31 @@
+package outfmt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SetFields projects structured output to the given top-level keys of each
+// item (see projectFields). Text output is unaffected.
+func (f *Formatter) SetFields(fields []string) {
+	f.fields = nil
+	for _, name := range fields {
+		if name = strings.TrimSpace(name); name != "" {
+			f.fields = append(f.fields, name)
+		}
+	}
+}
+
+// projectFields keeps only the named top-level keys of each object in the
+// data/items payload, or of data itself when it is a single object. It shares
+// projectValue with --columns, so names match keys the same way; a name that
+// no item has fails with the valid keys, reported against --fields.
+func projectFields(data any, fields []string) (any, error) {
+	return transformItems(data, func(v any) (any, error) {
+		return projectValue(v, fields, unknownFieldError)
+	})
+}
+
+func unknownFieldError(field string, valid []string) error {
+	return fmt.Errorf("unknown field %q in --fields (valid fields: %s)", field, strings.Join(valid, ", "))
+}
@@ -0,0 +1,64 @@
+package outfmt
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFields_ProjectsEachItem(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+	f.SetFields([]string{"id", "status"})
+
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, selectTestData()))
+	assert.JSONEq(t, `{"data":[{"id":"c1","status":"active"},{"id":"c2","status":"draft"}],"page":{"next":"abc"}}`, buf.String())
+}
+
+func TestFields_SingleObject(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+	f.SetFields([]string{"worker-name"})
+
+	data := map[string]any{"data": map[string]any{"id": "c1", "worker_name": "Alice"}}
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, data))
+	assert.JSONEq(t, `{"data":{"worker_name":"Alice"}}`, buf.String())
+}
+
+func TestFields_UnknownField(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "never")
+	f.SetFields([]string{"id", "worker_name"})
+
+	err := f.OutputFiltered(context.Background(), func() {}, selectTestData())
+	require.Error(t, err)
+	assert.Equal(t, `unknown field "worker_name" in --fields (valid fields: id, status, worker)`, err.Error())
+	assert.Empty(t, buf.String())
+
+	// --columns lists the same valid keys, in its own terms.
+	f = New(&buf, &buf, FormatJSON, "never")
+	f.SetColumns([]string{"id", "worker_name"})
+	colErr := f.OutputFiltered(context.Background(), func() {}, selectTestData())
+	require.Error(t, colErr)
+	assert.Equal(t, `unknown column "worker_name" (valid columns: id, status, worker)`, colErr.Error())
+}
+
+func TestFields_EmptyListAndText(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+	f.SetFields([]string{"id"})
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, map[string]any{"data": []any{}}))
+	assert.JSONEq(t, `{"data":[]}`, buf.String())
+
+	buf.Reset()
+	f = New(&buf, &buf, FormatText, "never")
+	f.SetFields([]string{"missing"})
+	require.NoError(t, f.OutputFiltered(context.Background(), func() { f.PrintText("table") }, selectTestData()))
+	assert.Equal(t, "table\n", buf.String())
+}
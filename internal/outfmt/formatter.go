@@ -7,9 +7,11 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/muesli/termenv"
+	"gopkg.in/yaml.v3"
 
 	"github.com/salmonumbrella/deel-cli/internal/dryrun"
 	"github.com/salmonumbrella/deel-cli/internal/filter"
@@ -23,8 +25,41 @@ const (
 	FormatText Format = "text"
 	// FormatJSON renders JSON output.
 	FormatJSON Format = "json"
+	// FormatYAML renders the same payload as JSON, serialized as YAML.
+	FormatYAML Format = "yaml"
+	// FormatID0 prints only resource IDs, each followed by a NUL byte, for
+	// piping into xargs -0 (--print0).
+	FormatID0 Format = "id0"
 )
 
+// EnvelopeVersion identifies the shape of JSON success envelopes
+// ({"data": ..., "page": ...} and the agent-mode {"ok": ..., "result": ...}).
+// Bump it whenever envelope keys are added, removed, or renamed so tooling
+// that opts in via --envelope-version can branch on the structure.
+// Version 2 added the operation key; version 3 added meta; version 4 added
+// meta.idempotency_keys.
+const EnvelopeVersion = 4
+
+// DefaultJSONIndent is the pretty JSON indentation when --json-indent is unset.
+const DefaultJSONIndent = "  "
+
+// maxJSONIndent bounds the space count accepted by --json-indent.
+const maxJSONIndent = 8
+
+// ParseJSONIndent converts a --json-indent value, either a space count from
+// 1 to 8 or "tab", into the indentation string for pretty JSON.
+func ParseJSONIndent(value string) (string, error) {
+	v := strings.TrimSpace(value)
+	if strings.EqualFold(v, "tab") {
+		return "\t", nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 1 || n > maxJSONIndent {
+		return "", fmt.Errorf("invalid --json-indent %q (must be 1-%d or 'tab')", value, maxJSONIndent)
+	}
+	return strings.Repeat(" ", n), nil
+}
+
 // Formatter handles output formatting
 type Formatter struct {
 	out       io.Writer
@@ -37,6 +72,31 @@ type Formatter struct {
 	raw       bool
 	agent     bool
 	pretty    bool
+	// indent is the per-level indentation for pretty JSON (--json-indent).
+	indent  string
+	columns []string
+	selects []string
+	fields  []string
+	// normalizeTS rewrites timestamp fields as RFC 3339 UTC
+	// (--normalize-timestamps).
+	normalizeTS bool
+	sortBy      string
+	sortDesc    bool
+	// sortKeys are computed --sort-by keys registered by the command (see
+	// SetSortKey).
+	sortKeys map[string]SortKeyFunc
+	// enumCase recases enum fields in structured output and enum columns in
+	// tables (--normalize-enums).
+	enumCase EnumCase
+	// includeEmpty keeps unset optional fields in structured output as null
+	// or zero values (--include-empty).
+	includeEmpty bool
+	// outputHash prints the canonical hash of structured output to stderr
+	// (--output-hash).
+	outputHash bool
+	// renderErr records a table rendering failure (e.g. an unknown --columns
+	// name) so Output can surface it after the text callback returns.
+	renderErr error
 }
 
 // New creates a new Formatter
@@ -47,6 +107,7 @@ func New(out, errOut io.Writer, format Format, colorMode string) *Formatter {
 		format:    format,
 		colorMode: colorMode,
 		pretty:    true,
+		indent:    DefaultJSONIndent,
 	}
 	f.profile = f.detectColorProfile()
 	return f
@@ -67,6 +128,11 @@ func (f *Formatter) SetPrettyJSON(enabled bool) {
 	f.pretty = enabled
 }
 
+// SetJSONIndent sets the per-level indentation used for pretty JSON output.
+func (f *Formatter) SetJSONIndent(indent string) {
+	f.indent = indent
+}
+
 // SetQuery sets an optional JQ-style query for JSON output.
 func (f *Formatter) SetQuery(query string) {
 	f.query = strings.TrimSpace(query)
@@ -82,6 +148,56 @@ func (f *Formatter) SetRaw(enabled bool) {
 	f.raw = enabled
 }
 
+// SetColumns limits table columns and JSON object keys to the given names.
+// Matching is case-insensitive; spaces, dashes, and underscores are equivalent.
+func (f *Formatter) SetColumns(columns []string) {
+	f.columns = nil
+	for _, c := range columns {
+		if c = strings.TrimSpace(c); c != "" {
+			f.columns = append(f.columns, c)
+		}
+	}
+}
+
+// SetSelect prunes structured output to the given dotted key paths (see
+// selectPaths). Unlike SetColumns it never affects text tables.
+func (f *Formatter) SetSelect(paths []string) {
+	f.selects = nil
+	for _, p := range paths {
+		if p = strings.TrimSpace(p); p != "" {
+			f.selects = append(f.selects, p)
+		}
+	}
+}
+
+// SetIncludeEmpty controls whether structured output keeps unset optional
+// fields, as null or zero values, instead of omitting them (see
+// includeEmptyFields). Text output is unaffected.
+func (f *Formatter) SetIncludeEmpty(enabled bool) {
+	f.includeEmpty = enabled
+}
+
+// SetNormalizeTimestamps controls whether structured output rewrites
+// timestamp fields as RFC 3339 UTC (see normalizeTimestamps). Text output is
+// unaffected.
+func (f *Formatter) SetNormalizeTimestamps(enabled bool) {
+	f.normalizeTS = enabled
+}
+
+// SetNormalizeEnums recases enum fields (status, type, ...) in structured
+// output and enum columns in tables (see normalizeEnums). EnumCaseNone keeps
+// the API's casing.
+func (f *Formatter) SetNormalizeEnums(c EnumCase) {
+	f.enumCase = c
+}
+
+// SetSort orders table rows (and JSON list items) by the named column before
+// output. Column names match the same way as SetColumns.
+func (f *Formatter) SetSort(column string, desc bool) {
+	f.sortBy = strings.TrimSpace(column)
+	f.sortDesc = desc
+}
+
 func (f *Formatter) detectColorProfile() termenv.Profile {
 	switch f.colorMode {
 	case "never":
@@ -102,20 +218,80 @@ func (f *Formatter) IsJSON() bool {
 	return f.format == FormatJSON
 }
 
+// IsYAML returns true if output format is YAML
+func (f *Formatter) IsYAML() bool {
+	return f.format == FormatYAML
+}
+
+// isStructured reports whether stdout carries machine-readable output (JSON or YAML).
+func (f *Formatter) isStructured() bool {
+	return f.IsJSON() || f.IsYAML()
+}
+
+// IsID0 returns true if only NUL-delimited IDs are written to stdout.
+func (f *Formatter) IsID0() bool {
+	return f.format == FormatID0
+}
+
+// stdoutReserved reports whether stdout carries machine-readable output only,
+// so messages must go to stderr.
+func (f *Formatter) stdoutReserved() bool {
+	return f.isStructured() || f.IsID0()
+}
+
 // PrintJSON outputs data as JSON
 func (f *Formatter) PrintJSON(data any) error {
 	enc := json.NewEncoder(f.out)
 	if f.pretty {
-		enc.SetIndent("", "  ")
+		enc.SetIndent("", f.indent)
 	}
 	return enc.Encode(data)
 }
 
+// PrintYAML outputs data as YAML. Data is round-tripped through JSON first so
+// field names and omitempty behavior match the JSON output exactly.
+func (f *Formatter) PrintYAML(data any) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	var generic any
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return err
+	}
+	enc := yaml.NewEncoder(f.out)
+	enc.SetIndent(2)
+	if err := enc.Encode(generic); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// printStructured outputs data in the configured machine-readable format,
+// followed by its hash on stderr with --output-hash.
+func (f *Formatter) printStructured(data any) error {
+	var err error
+	if f.IsYAML() {
+		err = f.PrintYAML(data)
+	} else {
+		err = f.PrintJSON(data)
+	}
+	if err != nil || !f.outputHash {
+		return err
+	}
+	sum, err := CanonicalHash(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(f.errOut, "output-hash sha256:%s\n", sum)
+	return err
+}
+
 // PrintText outputs plain text
 func (f *Formatter) PrintText(text string) {
-	// In JSON mode, keep stdout clean for machine parsing.
+	// In JSON/YAML/ID0 mode, keep stdout clean for machine parsing.
 	out := f.out
-	if f.IsJSON() {
+	if f.stdoutReserved() {
 		out = f.errOut
 	}
 	if _, err := fmt.Fprintln(out, text); err != nil {
@@ -140,9 +316,9 @@ func (f *Formatter) PrintSuccess(format string, args ...any) {
 	if f.profile != termenv.Ascii {
 		msg = termenv.String(msg).Foreground(f.profile.Color("2")).String()
 	}
-	// In JSON mode, keep stdout clean for machine parsing.
+	// In JSON/YAML/ID0 mode, keep stdout clean for machine parsing.
 	out := f.out
-	if f.IsJSON() {
+	if f.stdoutReserved() {
 		out = f.errOut
 	}
 	if _, err := fmt.Fprintln(out, msg); err != nil {
@@ -163,12 +339,15 @@ func (f *Formatter) PrintWarning(format string, args ...any) {
 
 // PrintDryRun outputs a dry-run preview in the configured format.
 func (f *Formatter) PrintDryRun(preview *dryrun.Preview) error {
-	if f.IsJSON() {
-		return f.PrintJSON(map[string]any{
+	if f.isStructured() {
+		return f.printStructured(map[string]any{
 			"dry_run": true,
 			"preview": preview,
 		})
 	}
+	if f.IsID0() {
+		return preview.Write(f.errOut)
+	}
 	return preview.Write(f.out)
 }
 
@@ -177,7 +356,10 @@ type Table struct {
 	formatter *Formatter
 	headers   []string
 	rows      [][]string
-	widths    []int
+	// items holds the item behind each row added with AddItemRow, so
+	// computed sort keys can be applied to rows; nil for plain rows.
+	items  []any
+	widths []int
 }
 
 // NewTable creates a new table
@@ -193,6 +375,13 @@ func (f *Formatter) NewTable(headers ...string) *Table {
 	}
 }
 
+// AddItemRow adds a row rendered from item. Unlike AddRow, the row can then
+// be ordered by a computed sort key (see Formatter.SetSortKey).
+func (t *Table) AddItemRow(item any, values ...string) {
+	t.AddRow(values...)
+	t.items[len(t.items)-1] = item
+}
+
 // AddRow adds a row to the table
 func (t *Table) AddRow(values ...string) {
 	// Pad with empty strings if needed
@@ -210,6 +399,7 @@ func (t *Table) AddRow(values ...string) {
 		}
 	}
 	t.rows = append(t.rows, values)
+	t.items = append(t.items, nil)
 }
 
 // Render outputs the table
@@ -218,8 +408,61 @@ func (t *Table) Render() {
 		return
 	}
 
+	headers, rows, widths := t.headers, t.rows, t.widths
+	if c := t.formatter.enumCase; c != EnumCaseNone {
+		recased := make([][]string, len(rows))
+		for i, row := range rows {
+			recased[i] = make([]string, len(row))
+			for j, v := range row {
+				if isEnumKey(headers[j]) {
+					v = c.apply(v)
+				}
+				recased[i][j] = v
+			}
+		}
+		rows = recased
+	}
+	if t.formatter.sortBy != "" {
+		var values []any
+		col, err := columnIndex(t.headers, t.formatter.sortBy)
+		if err == nil {
+			values = make([]any, len(rows))
+			for i, row := range rows {
+				values[i] = row[col]
+			}
+		} else {
+			computed, ok, cerr := computedSortValues(t.formatter.sortKeys, t.formatter.sortBy, t.items)
+			if cerr != nil {
+				err = cerr
+			}
+			if !ok || cerr != nil {
+				t.formatter.renderErr = err
+				return
+			}
+			values = computed
+		}
+		sorted := make([][]string, len(rows))
+		for i, idx := range sortOrder(values, t.formatter.sortDesc) {
+			sorted[i] = rows[idx]
+		}
+		rows = sorted
+	}
+	if len(t.formatter.columns) > 0 {
+		indexes, err := selectColumns(t.headers, t.formatter.columns)
+		if err != nil {
+			t.formatter.renderErr = err
+			return
+		}
+		headers, widths = pick(t.headers, indexes), pickInts(t.widths, indexes)
+		projected := make([][]string, len(rows))
+		for i, row := range rows {
+			projected[i] = pick(row, indexes)
+		}
+		rows = projected
+	}
+
 	// Print header
-	headerLine := t.formatRow(t.headers)
+	headerLine := formatRow(headers, widths)
 	if t.formatter.profile != termenv.Ascii {
 		headerLine = termenv.String(headerLine).Bold().String()
 	}
@@ -228,21 +471,37 @@ func (t *Table) Render() {
 	}
 
 	// Print rows
-	for _, row := range t.rows {
-		if _, err := fmt.Fprintln(t.formatter.out, t.formatRow(row)); err != nil {
+	for _, row := range rows {
+		if _, err := fmt.Fprintln(t.formatter.out, formatRow(row, widths)); err != nil {
 			return
 		}
 	}
 }
 
-func (t *Table) formatRow(values []string) string {
+func formatRow(values []string, widths []int) string {
 	parts := make([]string, len(values))
 	for i, v := range values {
-		parts[i] = padRight(v, t.widths[i])
+		parts[i] = padRight(v, widths[i])
 	}
 	return strings.Join(parts, "  ")
 }
 
+func pick(values []string, indexes []int) []string {
+	out := make([]string, len(indexes))
+	for i, idx := range indexes {
+		out[i] = values[idx]
+	}
+	return out
+}
+
+func pickInts(values []int, indexes []int) []int {
+	out := make([]int, len(indexes))
+	for i, idx := range indexes {
+		out[i] = values[idx]
+	}
+	return out
+}
+
 func padRight(s string, width int) string {
 	if len(s) >= width {
 		return s
@@ -252,7 +511,15 @@ func padRight(s string, width int) string {
 
 // Output writes data in the configured format
 func (f *Formatter) Output(textFn func(), jsonData any) error {
-	if f.IsJSON() {
+	if f.IsID0() {
+		return f.outputIDs(jsonData)
+	}
+	if f.isStructured() {
+		shaped, err := f.shapeItems(jsonData)
+		if err != nil {
+			return err
+		}
+		jsonData = shaped
 		data := jsonData
 		queryTarget := jsonData
 		raw := f.raw
@@ -264,22 +531,92 @@ func (f *Formatter) Output(textFn func(), jsonData any) error {
 		} else if !raw {
 			data = ensureEnvelope(jsonData)
 		}
+		if len(f.selects) > 0 {
+			selected, err := selectPaths(data, f.selects)
+			if err != nil {
+				return err
+			}
+			data, queryTarget = selected, selected
+		}
 		if f.query != "" {
 			result, err := filter.Apply(queryTarget, f.query)
 			if err != nil {
 				return err
 			}
-			return f.PrintJSON(result)
+			return f.printStructured(result)
+		}
+		return f.printStructured(data)
+	}
+	return f.renderText(textFn)
+}
+
+// shapeItems applies --normalize-timestamps, --sort-by, and --columns to
+// structured output.
+func (f *Formatter) shapeItems(data any) (any, error) {
+	var err error
+	if f.includeEmpty {
+		data, err = includeEmptyFields(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if f.normalizeTS {
+		var warnings []timestampWarning
+		data, warnings, err = normalizeTimestamps(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range warnings {
+			f.PrintWarning("Could not normalize timestamp %s=%q; left unchanged", w.Path, w.Value)
+		}
+	}
+	if f.enumCase != EnumCaseNone {
+		data, err = normalizeEnums(data, f.enumCase)
+		if err != nil {
+			return nil, err
 		}
-		return f.PrintJSON(data)
 	}
+	if f.sortBy != "" {
+		data, err = transformItems(data, func(v any) (any, error) {
+			return sortItems(v, f.sortBy, f.sortDesc, f.sortKeys)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(f.columns) > 0 {
+		data, err = projectColumns(data, f.columns)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(f.fields) > 0 {
+		data, err = projectFields(data, f.fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// renderText runs textFn and reports any table rendering error it caused.
+func (f *Formatter) renderText(textFn func()) error {
+	f.renderErr = nil
 	textFn()
-	return nil
+	if f.outputHash {
+		f.PrintWarning("--output-hash only applies to JSON/YAML output; use --json")
+	}
+	err := f.renderErr
+	f.renderErr = nil
+	return err
 }
 
 // OutputFiltered writes data with optional JQ filtering from context.
 func (f *Formatter) OutputFiltered(ctx context.Context, textFn func(), jsonData any) error {
-	if f.IsJSON() {
+	if f.IsID0() {
+		return f.outputIDs(jsonData)
+	}
+	if f.isStructured() {
 		origPretty := f.pretty
 		if ctx != nil {
 			f.pretty = PrettyJSON(ctx)
@@ -299,6 +636,12 @@ func (f *Formatter) OutputFiltered(ctx context.Context, textFn func(), jsonData
 			raw = true
 		}
 
+		shaped, err := f.shapeItems(jsonData)
+		if err != nil {
+			return err
+		}
+		jsonData = shaped
+
 		// JSON Lines output: stream one JSON value per line (compact).
 		if ctx != nil && JSONL(ctx) {
 			f.pretty = false
@@ -317,17 +660,37 @@ func (f *Formatter) OutputFiltered(ctx context.Context, textFn func(), jsonData
 			}
 			if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
 				enc := json.NewEncoder(f.out)
+				// Compile once and run per item so results stream line by
+				// line instead of waiting on the whole list.
+				var stream *filter.Filter
+				if query != "" {
+					compiled, err := filter.CompileStream(query)
+					if err != nil {
+						return err
+					}
+					stream = compiled
+				}
 				for i := 0; i < v.Len(); i++ {
+					// Stop between records on cancellation so the output
+					// ends on a complete line.
+					if err := ctx.Err(); err != nil {
+						return err
+					}
 					item := v.Index(i).Interface()
-					out := item
-					if query != "" {
-						result, err := filter.Apply(item, query)
+					if len(f.selects) > 0 {
+						selected, err := selectPaths(item, f.selects)
 						if err != nil {
 							return err
 						}
-						out = result
+						item = selected
+					}
+					if stream != nil {
+						if err := stream.Each(item, enc.Encode); err != nil {
+							return err
+						}
+						continue
 					}
-					if err := enc.Encode(out); err != nil {
+					if err := enc.Encode(item); err != nil {
 						return err
 					}
 				}
@@ -346,26 +709,62 @@ func (f *Formatter) OutputFiltered(ctx context.Context, textFn func(), jsonData
 		} else if !raw {
 			data = ensureEnvelope(jsonData)
 		}
+		if len(f.selects) > 0 {
+			selected, err := selectPaths(data, f.selects)
+			if err != nil {
+				return err
+			}
+			data, queryTarget = selected, selected
+		}
 		if query != "" {
 			result, err := filter.Apply(queryTarget, query)
 			if err != nil {
 				return err
 			}
-			return f.PrintJSON(result)
+			return f.printStructured(result)
+		}
+
+		enveloped := ctx != nil && !JSONL(ctx) && !dataOnly && !raw
+		extra := map[string]any{}
+		if enveloped && EnvelopeVersionEnabled(ctx) {
+			extra["envelope_version"] = EnvelopeVersion
+		}
+		if op := Operation(ctx); enveloped && op != "" {
+			extra["operation"] = op
+		}
+		if enveloped {
+			if key := IdempotencyKey(ctx); key != "" {
+				meta := map[string]any{"idempotency_key": key}
+				if parts := IdempotencyParts(ctx); len(parts) > 0 {
+					meta["idempotency_keys"] = parts
+				}
+				extra["meta"] = meta
+			}
 		}
 
 		// Agent mode: normalize success output unless the user is requesting a raw/custom format.
 		if ctx != nil && IsAgent(ctx) && query == "" && !dataOnly && !raw {
-			return f.PrintJSON(map[string]any{
-				"ok":     true,
+			envelope := map[string]any{
+				"ok":     !PartialFailure(ctx),
 				"result": data,
-			})
+			}
+			for k, v := range extra {
+				envelope[k] = v
+			}
+			return f.printStructured(envelope)
+		}
+
+		if len(extra) > 0 {
+			envelope, err := withEnvelopeFields(data, extra)
+			if err != nil {
+				return err
+			}
+			data = envelope
 		}
 
-		return f.PrintJSON(data)
+		return f.printStructured(data)
 	}
-	textFn()
-	return nil
+	return f.renderText(textFn)
 }
 
 func extractData(data any) (any, bool) {
@@ -407,3 +806,27 @@ func ensureEnvelope(data any) any {
 	}
 	return map[string]any{"data": data}
 }
+
+// withEnvelopeFields adds top-level keys (envelope_version, operation) to a
+// data envelope. Struct envelopes (e.g. api.ListResponse) are converted to maps first.
+func withEnvelopeFields(envelope any, fields map[string]any) (map[string]any, error) {
+	var out map[string]any
+	if m, ok := envelope.(map[string]any); ok {
+		out = make(map[string]any, len(m)+len(fields))
+		for k, v := range m {
+			out[k] = v
+		}
+	} else {
+		b, err := json.Marshal(envelope)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &out); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range fields {
+		out[k] = v
+	}
+	return out, nil
+}
@@ -123,6 +123,42 @@ func TestFormatter_OutputFiltered_CompactJSON(t *testing.T) {
 	assert.Equal(t, "test", payload["name"])
 }
 
+func TestFormatter_OutputFiltered_JSONLStreamsQueryPerItem(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "auto")
+
+	ctx := WithJSONL(context.Background(), true)
+	ctx = WithQuery(ctx, `select(.active) | .id`)
+
+	data := map[string]interface{}{
+		"data": []interface{}{
+			map[string]interface{}{"id": "c1", "active": true},
+			map[string]interface{}{"id": "c2", "active": false},
+			map[string]interface{}{"id": "c3", "active": true},
+		},
+	}
+
+	require.NoError(t, f.OutputFiltered(ctx, func() {}, data))
+	assert.Equal(t, "\"c1\"\n\"c3\"\n", buf.String())
+}
+
+func TestFormatter_OutputFiltered_JSONLRejectsWholeArrayQuery(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "auto")
+
+	ctx := WithJSONL(context.Background(), true)
+	ctx = WithQuery(ctx, `group_by(.team)`)
+
+	data := map[string]interface{}{
+		"data": []interface{}{map[string]interface{}{"id": "c1", "team": "a"}},
+	}
+
+	err := f.OutputFiltered(ctx, func() {}, data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "use --json instead of --jsonl")
+	assert.Empty(t, buf.String())
+}
+
 func TestFormatter_OutputFiltered_JSONL(t *testing.T) {
 	var buf bytes.Buffer
 	f := New(&buf, &buf, FormatJSON, "auto")
@@ -248,3 +284,388 @@ func TestFormatter_PrintDryRun_Text(t *testing.T) {
 	assert.Contains(t, buf.String(), "DELETE")
 	assert.Contains(t, buf.String(), "Person")
 }
+
+func TestFormatter_OutputFiltered_EnvelopeVersion(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "auto")
+	ctx := WithOperation(WithEnvelopeVersion(context.Background(), true), "contracts.list")
+
+	type page struct {
+		Next string `json:"next"`
+	}
+	data := struct {
+		Data []string `json:"data"`
+		Page page     `json:"page"`
+	}{Data: []string{"c1"}, Page: page{Next: "cursor"}}
+	require.NoError(t, f.OutputFiltered(ctx, func() {}, data))
+
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(t, float64(EnvelopeVersion), out["envelope_version"])
+
+	// Guard: changing the envelope keys requires bumping EnvelopeVersion
+	// and updating this list.
+	keys := make([]string, 0, len(out))
+	for k := range out {
+		keys = append(keys, k)
+	}
+	assert.ElementsMatch(t, []string{"data", "page", "envelope_version", "operation"}, keys)
+	assert.Equal(t, 4, EnvelopeVersion)
+}
+
+func TestFormatter_OutputFiltered_Operation(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "auto")
+	ctx := WithOperation(context.Background(), "contracts.create")
+
+	require.NoError(t, f.OutputFiltered(ctx, func() {}, map[string]any{"id": "c1"}))
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(t, "contracts.create", out["operation"])
+	assert.Equal(t, map[string]any{"id": "c1"}, out["data"])
+
+	buf.Reset()
+	require.NoError(t, f.OutputFiltered(WithAgent(ctx, true), func() {}, map[string]any{"id": "c1"}))
+	out = nil
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(t, "contracts.create", out["operation"])
+	assert.Equal(t, true, out["ok"])
+}
+
+func TestFormatter_OutputFiltered_IdempotencyKeyMeta(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "auto")
+	sent := false
+	ctx := WithIdempotencyKey(context.Background(), func() string {
+		if !sent {
+			return ""
+		}
+		return "key-1"
+	})
+
+	require.NoError(t, f.OutputFiltered(ctx, func() {}, map[string]any{"id": "c1"}))
+	assert.NotContains(t, buf.String(), "meta", "no meta until a write carried the key")
+
+	sent = true
+	buf.Reset()
+	require.NoError(t, f.OutputFiltered(ctx, func() {}, map[string]any{"id": "c1"}))
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(t, map[string]any{"idempotency_key": "key-1"}, out["meta"])
+
+	buf.Reset()
+	require.NoError(t, f.OutputFiltered(WithAgent(ctx, true), func() {}, map[string]any{"id": "c1"}))
+	out = nil
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(t, map[string]any{"idempotency_key": "key-1"}, out["meta"])
+
+	buf.Reset()
+	ctx = WithIdempotencyParts(ctx, func() map[string]string {
+		return map[string]string{"row-1": "key-1a", "row-2": "key-1b"}
+	})
+	require.NoError(t, f.OutputFiltered(ctx, func() {}, map[string]any{"id": "c1"}))
+	out = nil
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(t, map[string]any{
+		"idempotency_key":  "key-1",
+		"idempotency_keys": map[string]any{"row-1": "key-1a", "row-2": "key-1b"},
+	}, out["meta"])
+}
+
+func TestFormatter_OutputFiltered_AgentPartialFailure(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "auto")
+	ctx := WithPartialFailure(WithAgent(context.Background(), true), true)
+
+	results := []any{map[string]any{"id": "c1", "ok": true}, map[string]any{"id": "c2", "ok": false, "error": "not found"}}
+	require.NoError(t, f.OutputFiltered(ctx, func() {}, map[string]any{"results": results}))
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(t, false, out["ok"])
+	assert.Len(t, out["result"].(map[string]any)["data"].(map[string]any)["results"], 2)
+}
+
+func TestFormatter_OutputFiltered_OperationOmittedWithoutEnvelope(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "auto")
+	ctx := WithOperation(context.Background(), "people.list")
+	data := map[string]any{"data": []string{"p1"}}
+
+	require.NoError(t, f.OutputFiltered(WithDataOnly(ctx, true), func() {}, data))
+	assert.NotContains(t, buf.String(), "operation")
+
+	buf.Reset()
+	require.NoError(t, f.OutputFiltered(WithRaw(ctx, true), func() {}, data))
+	assert.NotContains(t, buf.String(), "operation")
+
+	buf.Reset()
+	require.NoError(t, f.OutputFiltered(WithJSONL(ctx, true), func() {}, data))
+	assert.NotContains(t, buf.String(), "operation")
+}
+
+func TestFormatter_OutputFiltered_EnvelopeVersionAgent(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "auto")
+	ctx := WithAgent(WithEnvelopeVersion(context.Background(), true), true)
+
+	require.NoError(t, f.OutputFiltered(ctx, func() {}, map[string]any{"id": "c1"}))
+
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(t, float64(EnvelopeVersion), out["envelope_version"])
+	assert.Equal(t, true, out["ok"])
+}
+
+func TestFormatter_OutputFiltered_EnvelopeVersionOmittedWhenDisabled(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "auto")
+
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, map[string]any{"id": "c1"}))
+	assert.NotContains(t, buf.String(), "envelope_version")
+
+	buf.Reset()
+	ctx := WithDataOnly(WithEnvelopeVersion(context.Background(), true), true)
+	require.NoError(t, f.OutputFiltered(ctx, func() {}, map[string]any{"data": []string{"c1"}}))
+	assert.NotContains(t, buf.String(), "envelope_version")
+}
+
+func TestFormatter_OutputFiltered_YAMLEnvelope(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatYAML, "auto")
+
+	type page struct {
+		Next string `json:"next"`
+	}
+	data := struct {
+		Data []map[string]string `json:"data"`
+		Page page                `json:"page"`
+	}{Data: []map[string]string{{"id": "c1"}}, Page: page{Next: "cursor"}}
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, data))
+
+	assert.Equal(t, "data:\n  - id: c1\npage:\n  next: cursor\n", buf.String())
+}
+
+func TestFormatter_OutputFiltered_YAMLDataOnlyAndQuery(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatYAML, "auto")
+	data := map[string]any{
+		"data": []any{map[string]any{"id": "c1", "name": "Alice"}},
+		"page": map[string]any{"next": ""},
+	}
+
+	require.NoError(t, f.OutputFiltered(WithDataOnly(context.Background(), true), func() {}, data))
+	assert.Equal(t, "- id: c1\n  name: Alice\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, f.OutputFiltered(WithQuery(context.Background(), ".data[0].name"), func() {}, data))
+	assert.Equal(t, "Alice\n", buf.String())
+}
+
+func TestFormatter_PrintText_YAMLUsesStderr(t *testing.T) {
+	var out, errOut bytes.Buffer
+	f := New(&out, &errOut, FormatYAML, "never")
+	f.PrintText("hello")
+	assert.Empty(t, out.String())
+	assert.Equal(t, "hello\n", errOut.String())
+}
+
+func TestTable_Render_Columns(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatText, "never")
+	f.SetColumns([]string{"status", "job_title", "ID"})
+
+	err := f.Output(func() {
+		table := f.NewTable("ID", "NAME", "JOB TITLE", "STATUS")
+		table.AddRow("p1", "Alice", "Engineer", "active")
+		table.Render()
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "STATUS  JOB TITLE  ID\nactive  Engineer   p1\n", buf.String())
+}
+
+func TestTable_Render_UnknownColumn(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatText, "never")
+	f.SetColumns([]string{"id", "worker"})
+
+	err := f.OutputFiltered(context.Background(), func() {
+		table := f.NewTable("ID", "JOB TITLE")
+		table.AddRow("p1", "Engineer")
+		table.Render()
+	}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown column "worker"`)
+	assert.Contains(t, err.Error(), "id, job_title")
+	assert.Empty(t, buf.String())
+}
+
+func TestFormatter_OutputFiltered_ColumnsProjectJSON(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+	f.SetColumns([]string{"ID", "Worker-Name"})
+
+	data := map[string]any{
+		"data": []any{
+			map[string]any{"id": "c1", "worker_name": "Alice", "status": "active"},
+			map[string]any{"id": "c2", "status": "draft"},
+		},
+		"page": map[string]any{"next": ""},
+	}
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, data))
+	assert.JSONEq(t, `{"data":[{"id":"c1","worker_name":"Alice"},{"id":"c2"}],"page":{"next":""}}`, buf.String())
+
+	buf.Reset()
+	f.SetColumns([]string{"salary"})
+	err := f.OutputFiltered(context.Background(), func() {}, data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "valid columns: id, status, worker_name")
+}
+
+func TestTable_Render_SortBy(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatText, "never")
+	f.SetSort("days", false)
+	f.SetColumns([]string{"id"})
+
+	err := f.Output(func() {
+		table := f.NewTable("ID", "DAYS", "START")
+		table.AddRow("a", "10.0", "2026-03-01")
+		table.AddRow("b", "", "2026-01-15")
+		table.AddRow("c", "2.5", "2025-12-31")
+		table.Render()
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ID\nc \na \nb \n", buf.String())
+
+	buf.Reset()
+	f.SetSort("start", true)
+	require.NoError(t, f.Output(func() {
+		table := f.NewTable("ID", "START")
+		table.AddRow("a", "2026-03-01")
+		table.AddRow("b", "2026-01-15")
+		table.AddRow("c", "2025-12-31")
+		table.Render()
+	}, nil))
+	assert.Equal(t, "ID\na \nb \nc \n", buf.String())
+}
+
+func TestSortOrder_Kinds(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 0}, sortOrder([]any{"100", "9", "50"}, false))
+	assert.Equal(t, []int{2, 0, 1}, sortOrder([]any{"bob", "Carol", "alice"}, false))
+	assert.Equal(t, []int{1, 0, 2}, sortOrder([]any{"2026-02-01", "2026-10-01", nil}, true))
+}
+
+func TestFormatter_OutputFiltered_SortJSON(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+	f.SetSort("amount", true)
+
+	data := map[string]any{
+		"data": []any{
+			map[string]any{"id": "p1", "amount": 9.5},
+			map[string]any{"id": "p2", "amount": 120},
+			map[string]any{"id": "p3"},
+		},
+		"page": map[string]any{"next": ""},
+	}
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, data))
+	assert.JSONEq(t, `{"data":[{"id":"p2","amount":120},{"id":"p1","amount":9.5},{"id":"p3"}],"page":{"next":""}}`, buf.String())
+
+	buf.Reset()
+	f.SetSort("worker", false)
+	err := f.OutputFiltered(context.Background(), func() {}, data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "valid columns: amount, id")
+}
+
+func TestFormatter_ComputedSortKey(t *testing.T) {
+	lengthKey := func(item map[string]any) any {
+		name, _ := item["name"].(string)
+		if name == "" {
+			return nil
+		}
+		return float64(len(name))
+	}
+
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+	f.SetSortKey("name_length", lengthKey)
+	f.SetSort("name-length", true)
+
+	data := []any{
+		map[string]any{"id": "p1", "name": "Ada"},
+		map[string]any{"id": "p2"},
+		map[string]any{"id": "p3", "name": "Katherine"},
+	}
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, data))
+	assert.JSONEq(t, `{"data":[{"id":"p3","name":"Katherine"},{"id":"p1","name":"Ada"},{"id":"p2"}]}`, buf.String())
+
+	// Table rows sort by the key when added with their item.
+	type person struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	buf.Reset()
+	f = New(&buf, &buf, FormatText, "never")
+	f.SetSortKey("name_length", lengthKey)
+	f.SetSort("name_length", false)
+	require.NoError(t, f.Output(func() {
+		table := f.NewTable("ID")
+		for _, p := range []person{{"p1", "Katherine"}, {"p2", ""}, {"p3", "Ada"}} {
+			table.AddItemRow(p, p.ID)
+		}
+		table.Render()
+	}, nil))
+	assert.Equal(t, "ID\np3\np1\np2\n", buf.String())
+
+	// Unknown sort keys list the computed keys too.
+	buf.Reset()
+	f = New(&buf, &buf, FormatJSON, "never")
+	f.SetSortKey("name_length", lengthKey)
+	f.SetSort("salary", false)
+	err := f.OutputFiltered(context.Background(), func() {}, data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "valid columns: id, name, name_length")
+}
+
+func TestParseJSONIndent(t *testing.T) {
+	indent, err := ParseJSONIndent("4")
+	require.NoError(t, err)
+	assert.Equal(t, "    ", indent)
+
+	indent, err = ParseJSONIndent("tab")
+	require.NoError(t, err)
+	assert.Equal(t, "\t", indent)
+
+	for _, bad := range []string{"", "0", "9", "-2", "two"} {
+		_, err := ParseJSONIndent(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestFormatter_OutputFiltered_JSONIndent(t *testing.T) {
+	data := map[string]any{"id": "c1"}
+
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "never")
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, data))
+	assert.Equal(t, "{\n  \"data\": {\n    \"id\": \"c1\"\n  }\n}\n", buf.String())
+
+	buf.Reset()
+	f.SetJSONIndent("    ")
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, data))
+	assert.Equal(t, "{\n    \"data\": {\n        \"id\": \"c1\"\n    }\n}\n", buf.String())
+
+	buf.Reset()
+	f.SetJSONIndent("\t")
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, data))
+	assert.Equal(t, "{\n\t\"data\": {\n\t\t\"id\": \"c1\"\n\t}\n}\n", buf.String())
+
+	// Compact output ignores the indent.
+	buf.Reset()
+	require.NoError(t, f.OutputFiltered(WithPrettyJSON(context.Background(), false), func() {}, data))
+	assert.Equal(t, "{\"data\":{\"id\":\"c1\"}}\n", buf.String())
+}
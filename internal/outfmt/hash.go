@@ -0,0 +1,68 @@
+package outfmt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// volatileKeys are dropped anywhere in the output before hashing: they change
+// on every run without the result changing.
+var volatileKeys = map[string]bool{"_timing": true}
+
+// volatileMetaKeys are dropped from any "_meta" object before hashing.
+var volatileMetaKeys = map[string]bool{"timestamp": true}
+
+// CanonicalHash returns the hex SHA-256 of data as canonical JSON: object keys
+// sorted, numbers kept as written, no insignificant whitespace, and volatile
+// fields (_timing, _meta.timestamp) removed. Equal results hash equally
+// whichever format or indentation printed them.
+func CanonicalHash(data any) (string, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var generic any
+	if err := dec.Decode(&generic); err != nil {
+		return "", err
+	}
+	canonical, err := json.Marshal(stripVolatile(generic))
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// stripVolatile removes volatile fields from a decoded JSON value in place.
+func stripVolatile(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, child := range val {
+			if volatileKeys[k] {
+				delete(val, k)
+				continue
+			}
+			if meta, ok := child.(map[string]any); ok && k == "_meta" {
+				for mk := range volatileMetaKeys {
+					delete(meta, mk)
+				}
+			}
+			val[k] = stripVolatile(child)
+		}
+	case []any:
+		for i, child := range val {
+			val[i] = stripVolatile(child)
+		}
+	}
+	return v
+}
+
+// SetOutputHash controls whether structured output is followed by its
+// CanonicalHash on stderr (--output-hash).
+func (f *Formatter) SetOutputHash(enabled bool) {
+	f.outputHash = enabled
+}
@@ -0,0 +1,91 @@
+package outfmt
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalHash_StableAcrossKeyOrder(t *testing.T) {
+	a := map[string]any{"id": "c1", "worker": map[string]any{"name": "Ana", "country": "PT"}, "amount": 1500.5}
+	b := map[string]any{"amount": 1500.5, "worker": map[string]any{"country": "PT", "name": "Ana"}, "id": "c1"}
+	type row struct {
+		ID     string  `json:"id"`
+		Amount float64 `json:"amount"`
+		Worker struct {
+			Name    string `json:"name"`
+			Country string `json:"country"`
+		} `json:"worker"`
+	}
+	var c row
+	c.ID, c.Amount, c.Worker.Name, c.Worker.Country = "c1", 1500.5, "Ana", "PT"
+
+	ha, err := CanonicalHash(a)
+	require.NoError(t, err)
+	hb, err := CanonicalHash(b)
+	require.NoError(t, err)
+	hc, err := CanonicalHash(c)
+	require.NoError(t, err)
+	assert.Equal(t, ha, hb)
+	assert.Equal(t, ha, hc)
+	assert.Len(t, ha, 64)
+
+	changed, err := CanonicalHash(map[string]any{"id": "c1", "worker": map[string]any{"name": "Ana", "country": "ES"}, "amount": 1500.5})
+	require.NoError(t, err)
+	assert.NotEqual(t, ha, changed)
+}
+
+func TestCanonicalHash_IgnoresVolatileFields(t *testing.T) {
+	base := map[string]any{
+		"data":  []any{map[string]any{"id": "c1"}},
+		"_meta": map[string]any{"source": "api", "timestamp": "2026-10-17T10:00:00Z"},
+	}
+	later := map[string]any{
+		"data":    []any{map[string]any{"id": "c1", "_timing": map[string]any{"ms": 88}}},
+		"_meta":   map[string]any{"source": "api", "timestamp": "2026-10-17T11:30:00Z"},
+		"_timing": map[string]any{"total_ms": 412},
+	}
+	h1, err := CanonicalHash(base)
+	require.NoError(t, err)
+	h2, err := CanonicalHash(later)
+	require.NoError(t, err)
+	assert.Equal(t, h1, h2)
+
+	// Other _meta fields still count.
+	other, err := CanonicalHash(map[string]any{
+		"data":  []any{map[string]any{"id": "c1"}},
+		"_meta": map[string]any{"source": "cache"},
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, h1, other)
+}
+
+func TestOutputHash_SameAcrossFormats(t *testing.T) {
+	data := []any{map[string]any{"id": "c1", "status": "active"}}
+	hashFor := func(format Format, pretty bool) string {
+		var out, errOut bytes.Buffer
+		f := New(&out, &errOut, format, "never")
+		f.SetPrettyJSON(pretty)
+		f.SetOutputHash(true)
+		require.NoError(t, f.OutputFiltered(context.Background(), func() {}, data))
+		line := strings.TrimSpace(errOut.String())
+		require.True(t, strings.HasPrefix(line, "output-hash sha256:"), line)
+		return line
+	}
+	first := hashFor(FormatJSON, true)
+	assert.Equal(t, first, hashFor(FormatJSON, true))
+	assert.Equal(t, first, hashFor(FormatJSON, false))
+	assert.Equal(t, first, hashFor(FormatYAML, false))
+}
+
+func TestOutputHash_TextWarns(t *testing.T) {
+	var out, errOut bytes.Buffer
+	f := New(&out, &errOut, FormatText, "never")
+	f.SetOutputHash(true)
+	require.NoError(t, f.OutputFiltered(context.Background(), func() { f.PrintText("hi") }, map[string]any{"id": "c1"}))
+	assert.Contains(t, errOut.String(), "--output-hash only applies to JSON/YAML output")
+}
@@ -0,0 +1,116 @@
+package outfmt
+
+import (
+	"bytes"
+	"io"
+)
+
+const (
+	// lineWriterFlushBytes is how much complete-line output is buffered before
+	// it is written through.
+	lineWriterFlushBytes = 64 * 1024
+	// lineWriterSyncLines is how many lines are written between fsyncs.
+	lineWriterSyncLines = 1000
+)
+
+// LineWriter buffers output and only writes complete lines to the underlying
+// writer until Close, so a crash mid-export leaves a file of whole JSON lines
+// rather than a truncated final record. If the destination supports Sync (e.g. *os.File),
+// it is synced every lineWriterSyncLines lines and on Close.
+type LineWriter struct {
+	w          io.Writer
+	pending    []byte
+	lines      int
+	syncedAt   int
+	flushBytes int
+	syncLines  int
+	err        error
+}
+
+// NewLineWriter wraps w in a LineWriter.
+func NewLineWriter(w io.Writer) *LineWriter {
+	return &LineWriter{w: w, flushBytes: lineWriterFlushBytes, syncLines: lineWriterSyncLines}
+}
+
+// Write buffers p, writing through any complete lines once the buffer is
+// large enough or a sync is due.
+func (lw *LineWriter) Write(p []byte) (int, error) {
+	if lw.err != nil {
+		return 0, lw.err
+	}
+	lw.pending = append(lw.pending, p...)
+	lw.lines += bytes.Count(p, []byte{'\n'})
+
+	syncDue := lw.lines-lw.syncedAt >= lw.syncLines
+	if len(lw.pending) >= lw.flushBytes || syncDue {
+		if err := lw.writeComplete(); err != nil {
+			return 0, err
+		}
+	}
+	if syncDue {
+		if err := lw.sync(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush writes all complete lines and syncs the destination. A trailing
+// partial line stays buffered.
+func (lw *LineWriter) Flush() error {
+	if err := lw.writeComplete(); err != nil {
+		return err
+	}
+	return lw.sync()
+}
+
+// Close writes everything still buffered, including a final line without a
+// trailing newline (e.g. the last --print0 record), syncs, and closes the
+// destination if it is an io.Closer. Only a crash before Close can leave a
+// partial line unwritten.
+func (lw *LineWriter) Close() error {
+	err := lw.writeComplete()
+	if err == nil && len(lw.pending) > 0 {
+		if _, werr := lw.w.Write(lw.pending); werr != nil {
+			lw.err = werr
+			err = werr
+		}
+	}
+	lw.pending = nil
+	if err == nil {
+		err = lw.sync()
+	}
+	if c, ok := lw.w.(io.Closer); ok {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+func (lw *LineWriter) writeComplete() error {
+	if lw.err != nil {
+		return lw.err
+	}
+	i := bytes.LastIndexByte(lw.pending, '\n')
+	if i < 0 {
+		return nil
+	}
+	if _, err := lw.w.Write(lw.pending[:i+1]); err != nil {
+		lw.err = err
+		return err
+	}
+	lw.pending = append(lw.pending[:0], lw.pending[i+1:]...)
+	return nil
+}
+
+func (lw *LineWriter) sync() error {
+	lw.syncedAt = lw.lines
+	if s, ok := lw.w.(interface{ Sync() error }); ok {
+		if err := s.Sync(); err != nil {
+			lw.err = err
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package outfmt
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readJSONLines(t *testing.T, path string) []map[string]any {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	if len(data) > 0 {
+		require.Equal(t, byte('\n'), data[len(data)-1], "file must end on a complete line")
+	}
+
+	var out []map[string]any
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var v map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &v), "line %q", scanner.Text())
+		out = append(out, v)
+	}
+	require.NoError(t, scanner.Err())
+	return out
+}
+
+func TestLineWriter_OnlyWritesCompleteLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.jsonl")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+
+	lw := NewLineWriter(file)
+	lw.flushBytes = 1
+
+	_, err = lw.Write([]byte(`{"id":1}` + "\n" + `{"id":`))
+	require.NoError(t, err)
+
+	// Simulate a crash: read the file without closing the writer.
+	lines := readJSONLines(t, path)
+	assert.Len(t, lines, 1)
+
+	_, err = lw.Write([]byte("2}\n" + `{"id":3`))
+	require.NoError(t, err)
+
+	lines = readJSONLines(t, path)
+	require.Len(t, lines, 2)
+	assert.Equal(t, float64(2), lines[1]["id"])
+	require.NoError(t, lw.Close())
+}
+
+func TestLineWriter_CloseWritesFinalUnterminatedLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ids")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+
+	lw := NewLineWriter(file)
+	_, err = lw.Write([]byte("c-1\x00c-2\x00"))
+	require.NoError(t, err)
+	require.NoError(t, lw.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "c-1\x00c-2\x00", string(data))
+}
+
+func TestLineWriter_SyncCadence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.jsonl")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+
+	lw := NewLineWriter(file)
+	lw.syncLines = 2
+	for i := 0; i < 5; i++ {
+		_, err := fmt.Fprintf(lw, "{\"id\":%d}\n", i)
+		require.NoError(t, err)
+	}
+
+	// Four lines were synced; the fifth is still buffered.
+	assert.Len(t, readJSONLines(t, path), 4)
+	assert.Equal(t, 4, lw.syncedAt)
+	require.NoError(t, lw.Close())
+	assert.Len(t, readJSONLines(t, path), 5)
+}
+
+// cancelingItem cancels the stream's context while it is being encoded,
+// simulating an interrupt mid-export.
+type cancelingItem struct {
+	ID     int
+	cancel context.CancelFunc
+}
+
+func (c cancelingItem) MarshalJSON() ([]byte, error) {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	return json.Marshal(map[string]int{"id": c.ID})
+}
+
+func TestFormatter_JSONL_CancelMidStream(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "export.jsonl")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	lw := NewLineWriter(file)
+	lw.flushBytes = 1
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = WithJSONL(ctx, true)
+
+	items := make([]cancelingItem, 10)
+	for i := range items {
+		items[i] = cancelingItem{ID: i}
+	}
+	items[3].cancel = cancel
+
+	f := New(lw, lw, FormatJSON, "never")
+	err = f.OutputFiltered(ctx, func() {}, map[string]any{"data": items})
+	require.ErrorIs(t, err, context.Canceled)
+	require.NoError(t, lw.Close())
+
+	lines := readJSONLines(t, path)
+	require.Len(t, lines, 4)
+	for i, line := range lines {
+		assert.Equal(t, float64(i), line["id"])
+	}
+}
@@ -0,0 +1,81 @@
+package outfmt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// outputIDs writes the id of each item in data (or of data itself, for a
+// single resource) followed by a NUL byte. --sort-by still orders the items.
+// Nothing is written unless every item has an id.
+func (f *Formatter) outputIDs(data any) error {
+	if f.sortBy != "" {
+		sorted, err := transformItems(data, func(v any) (any, error) {
+			return sortItems(v, f.sortBy, f.sortDesc, f.sortKeys)
+		})
+		if err != nil {
+			return err
+		}
+		data = sorted
+	}
+	if extracted, ok := extractData(data); ok {
+		data = extracted
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var generic any
+	if err := dec.Decode(&generic); err != nil {
+		return err
+	}
+
+	var items []any
+	switch v := generic.(type) {
+	case []any:
+		items = v
+	case map[string]any:
+		items = []any{v}
+	default:
+		return fmt.Errorf("--print0 needs a list or a resource with an id")
+	}
+
+	var buf bytes.Buffer
+	for i, item := range items {
+		id, err := itemID(item)
+		if err != nil {
+			return fmt.Errorf("--print0: item %d: %w", i, err)
+		}
+		buf.WriteString(id)
+		buf.WriteByte(0)
+	}
+	_, err = f.out.Write(buf.Bytes())
+	return err
+}
+
+// itemID returns the "id" field of a decoded JSON object.
+func itemID(item any) (string, error) {
+	obj, ok := item.(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("not an object")
+	}
+	var id string
+	switch v := obj["id"].(type) {
+	case string:
+		id = v
+	case json.Number:
+		id = v.String()
+	}
+	if id == "" {
+		return "", fmt.Errorf("no id")
+	}
+	if strings.ContainsRune(id, 0) {
+		return "", fmt.Errorf("id %q contains a NUL byte", id)
+	}
+	return id, nil
+}
@@ -0,0 +1,83 @@
+package outfmt
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputIDs_List(t *testing.T) {
+	var out, errOut bytes.Buffer
+	f := New(&out, &errOut, FormatID0, "never")
+
+	data := map[string]any{
+		"data": []any{
+			map[string]any{"id": "c1", "title": "Alpha"},
+			map[string]any{"id": "c 2", "title": "Beta"},
+			map[string]any{"id": 42, "title": "Gamma"},
+		},
+		"page": map[string]any{"next": "abc"},
+	}
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {
+		f.PrintText("table output")
+	}, data))
+
+	assert.Equal(t, "c1\x00c 2\x0042\x00", out.String())
+	assert.Empty(t, errOut.String())
+}
+
+func TestOutputIDs_SingleResourceAndSort(t *testing.T) {
+	var out bytes.Buffer
+	f := New(&out, &out, FormatID0, "never")
+	require.NoError(t, f.Output(func() {}, map[string]any{"id": "c1", "title": "Alpha"}))
+	assert.Equal(t, "c1\x00", out.String())
+
+	out.Reset()
+	f.SetSort("title", true)
+	require.NoError(t, f.Output(func() {}, []any{
+		map[string]any{"id": "a", "title": "Alpha"},
+		map[string]any{"id": "b", "title": "Beta"},
+	}))
+	assert.Equal(t, "b\x00a\x00", out.String())
+}
+
+func TestOutputIDs_MissingIDWritesNothing(t *testing.T) {
+	var out bytes.Buffer
+	f := New(&out, &out, FormatID0, "never")
+
+	err := f.Output(func() {}, []any{map[string]any{"id": "c1"}, map[string]any{"name": "no id"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "item 1: no id")
+	assert.Empty(t, out.String())
+}
+
+func TestPrintText_ID0GoesToStderr(t *testing.T) {
+	var out, errOut bytes.Buffer
+	f := New(&out, &errOut, FormatID0, "never")
+	f.PrintText("hello")
+	assert.Empty(t, out.String())
+	assert.Equal(t, "hello\n", errOut.String())
+}
+
+func TestOutputIDs_ToLineWriterFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ids")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	lw := NewLineWriter(file)
+
+	f := New(lw, lw, FormatID0, "never")
+	require.NoError(t, f.Output(func() {}, []any{
+		map[string]any{"id": "c1"},
+		map[string]any{"id": "c2"},
+	}))
+	require.NoError(t, lw.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "c1\x00c2\x00", string(data))
+}
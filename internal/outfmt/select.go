@@ -0,0 +1,173 @@
+package outfmt
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// selectNode is one level of a --select path tree. A leaf keeps the whole
+// value beneath it.
+type selectNode struct {
+	leaf     bool
+	children map[string]*selectNode
+}
+
+// parseSelectPaths builds a path tree from dotted paths like
+// "data.*.worker.name". Segments match keys the same way as SetColumns; "*"
+// matches every key or array element.
+func parseSelectPaths(paths []string) (*selectNode, error) {
+	root := &selectNode{}
+	for _, p := range paths {
+		if strings.TrimSpace(p) == "" {
+			continue
+		}
+		node := root
+		for _, seg := range strings.Split(p, ".") {
+			seg = strings.TrimSpace(seg)
+			if seg == "" {
+				return nil, fmt.Errorf("invalid --select path %q (empty segment)", p)
+			}
+			if node.children == nil {
+				node.children = map[string]*selectNode{}
+			}
+			key := seg
+			if key != "*" {
+				key = normalizeColumn(seg)
+			}
+			child, ok := node.children[key]
+			if !ok {
+				child = &selectNode{}
+				node.children[key] = child
+			}
+			node = child
+		}
+		node.leaf = true
+	}
+	return root, nil
+}
+
+// ValidateSelectPaths reports a malformed --select path before any request
+// is made.
+func ValidateSelectPaths(paths []string) error {
+	_, err := parseSelectPaths(paths)
+	return err
+}
+
+// unwrapped returns the tree for data that had its envelope removed
+// (--data-only), so "data.*.id" and "*.id" select the same keys.
+func (n *selectNode) unwrapped() *selectNode {
+	out := &selectNode{leaf: n.leaf, children: map[string]*selectNode{}}
+	for k, child := range n.children {
+		if k == "data" || k == "items" {
+			if child.leaf {
+				return &selectNode{leaf: true}
+			}
+			for ck, cc := range child.children {
+				out.children[ck] = mergeSelectNodes(out.children[ck], cc)
+			}
+			continue
+		}
+		out.children[k] = mergeSelectNodes(out.children[k], child)
+	}
+	return out
+}
+
+func mergeSelectNodes(a, b *selectNode) *selectNode {
+	if a == nil {
+		return b
+	}
+	out := &selectNode{leaf: a.leaf || b.leaf, children: map[string]*selectNode{}}
+	for k, c := range a.children {
+		out.children[k] = c
+	}
+	for k, c := range b.children {
+		out.children[k] = mergeSelectNodes(out.children[k], c)
+	}
+	return out
+}
+
+// selectPaths prunes data to the keys named by paths. Arrays are traversed
+// element by element, so "data.worker.name" and "data.*.worker.name" are
+// equivalent for list output. Keys that no path names are dropped. When data
+// has no data/items envelope (--data-only, --jsonl items), a leading "data."
+// or "items." segment is ignored so the same paths work either way.
+func selectPaths(data any, paths []string) (any, error) {
+	root, err := parseSelectPaths(paths)
+	if err != nil {
+		return nil, err
+	}
+	if len(root.children) == 0 {
+		return data, nil
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var generic any
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return nil, err
+	}
+	if !hasEnvelope(generic) {
+		root = root.unwrapped()
+	}
+	out, _ := pruneSelected(generic, root)
+	return out, nil
+}
+
+// pruneSelected returns the selected part of v and whether anything in v was
+// selected at all.
+func pruneSelected(v any, n *selectNode) (any, bool) {
+	if n.leaf {
+		return v, true
+	}
+	switch val := v.(type) {
+	case map[string]any:
+		out := make(map[string]any)
+		for k, child := range val {
+			next := n.children[normalizeColumn(k)]
+			if star := n.children["*"]; star != nil {
+				next = mergeSelectNodes(next, star)
+			}
+			if next == nil {
+				continue
+			}
+			if pruned, ok := pruneSelected(child, next); ok {
+				out[k] = pruned
+			}
+		}
+		return out, len(out) > 0
+	case []any:
+		elem := n
+		if star := n.children["*"]; star != nil {
+			elem = star
+		}
+		out := make([]any, 0, len(val))
+		for _, item := range val {
+			pruned, ok := pruneSelected(item, elem)
+			switch item.(type) {
+			case map[string]any, []any:
+				// Keep list positions aligned even when an item lacks the path.
+				ok = true
+			}
+			if ok {
+				out = append(out, pruned)
+			}
+		}
+		// An empty list is still the selected value, not a missing key.
+		return out, true
+	default:
+		return nil, false
+	}
+}
+
+func hasEnvelope(v any) bool {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return false
+	}
+	_, data := m["data"]
+	_, items := m["items"]
+	return data || items
+}
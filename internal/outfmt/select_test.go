@@ -0,0 +1,94 @@
+package outfmt
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func selectTestData() map[string]any {
+	return map[string]any{
+		"data": []any{
+			map[string]any{"id": "c1", "status": "active", "worker": map[string]any{"name": "Alice", "email": "a@example.com"}},
+			map[string]any{"id": "c2", "status": "draft", "worker": nil},
+		},
+		"page": map[string]any{"next": "abc"},
+	}
+}
+
+func TestSelectPaths_NestedWildcard(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+	f.SetSelect([]string{"data.*.worker.name", "data.*.id"})
+
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, selectTestData()))
+	assert.JSONEq(t, `{"data":[{"id":"c1","worker":{"name":"Alice"}},{"id":"c2"}]}`, buf.String())
+}
+
+func TestSelectPaths_DropsUnselectedKeys(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+	f.SetSelect([]string{"page", "data.status"})
+
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, selectTestData()))
+	assert.JSONEq(t, `{"data":[{"status":"active"},{"status":"draft"}],"page":{"next":"abc"}}`, buf.String())
+}
+
+func TestSelectPaths_WithDataOnly(t *testing.T) {
+	for _, paths := range [][]string{{"data.*.worker.email"}, {"*.worker.email"}, {"worker.email"}} {
+		var buf bytes.Buffer
+		f := New(&buf, &buf, FormatJSON, "never")
+		f.SetPrettyJSON(false)
+		f.SetDataOnly(true)
+		f.SetSelect(paths)
+
+		require.NoError(t, f.OutputFiltered(context.Background(), func() {}, selectTestData()))
+		assert.JSONEq(t, `[{"worker":{"email":"a@example.com"}},{}]`, buf.String(), "paths %v", paths)
+	}
+}
+
+func TestSelectPaths_ComposesWithColumns(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+	f.SetColumns([]string{"id", "worker"})
+	f.SetSelect([]string{"data.*.worker.name", "data.*.status"})
+
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, selectTestData()))
+	// status was already removed by --columns.
+	assert.JSONEq(t, `{"data":[{"worker":{"name":"Alice"}},{}]}`, buf.String())
+}
+
+func TestSelectPaths_KeepsEnvelopeMetadata(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+	f.SetSelect([]string{"data.id"})
+
+	ctx := WithOperation(context.Background(), "contracts.list")
+	require.NoError(t, f.OutputFiltered(ctx, func() {}, selectTestData()))
+	assert.JSONEq(t, `{"data":[{"id":"c1"},{"id":"c2"}],"operation":"contracts.list"}`, buf.String())
+}
+
+func TestSelectPaths_IgnoredForText(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, &buf, FormatText, "never")
+	f.SetSelect([]string{"data.id"})
+
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {
+		table := f.NewTable("ID", "STATUS")
+		table.AddRow("c1", "active")
+		table.Render()
+	}, selectTestData()))
+	assert.Contains(t, buf.String(), "STATUS")
+}
+
+func TestValidateSelectPaths(t *testing.T) {
+	assert.NoError(t, ValidateSelectPaths([]string{"data.*.worker.name", "page"}))
+	assert.ErrorContains(t, ValidateSelectPaths([]string{"data..name"}), "empty segment")
+}
@@ -0,0 +1,224 @@
+package outfmt
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SortKeyFunc computes a --sort-by value that is not a field of the item,
+// such as tenure derived from a start date. item is the list item as a JSON
+// object. It returns a float64 or string, or nil when the item has no value
+// (nil sorts last).
+type SortKeyFunc func(item map[string]any) any
+
+// SetSortKey registers name as a computed --sort-by key. It applies to JSON
+// list items and to table rows added with AddItemRow. A field of the same
+// name on the items takes precedence.
+func (f *Formatter) SetSortKey(name string, fn SortKeyFunc) {
+	if f.sortKeys == nil {
+		f.sortKeys = map[string]SortKeyFunc{}
+	}
+	f.sortKeys[normalizeColumn(name)] = fn
+}
+
+// computedSortValues applies the computed key for column to each item, or
+// returns ok=false when column is not a computed key.
+func computedSortValues(computed map[string]SortKeyFunc, column string, items []any) (values []any, ok bool, err error) {
+	fn, ok := computed[normalizeColumn(column)]
+	if !ok {
+		return nil, false, nil
+	}
+	values = make([]any, len(items))
+	for i, item := range items {
+		obj, isObj := item.(map[string]any)
+		if !isObj && item != nil {
+			// Typed items from AddItemRow are compared via their JSON form.
+			b, err := json.Marshal(item)
+			if err != nil {
+				return nil, true, err
+			}
+			if err := json.Unmarshal(b, &obj); err != nil {
+				return nil, true, fmt.Errorf("cannot sort by %q: items are not objects", column)
+			}
+		}
+		if obj != nil {
+			values[i] = fn(obj)
+		}
+	}
+	return values, true, nil
+}
+
+type sortKind int
+
+const (
+	sortString sortKind = iota
+	sortNumeric
+	sortDate
+)
+
+// sortOrder returns a stable permutation that orders values. A column sorts
+// numerically when every non-empty value is a number, chronologically when
+// every non-empty value is a YYYY-MM-DD date (timestamps included), and
+// case-insensitively otherwise. Empty values always sort last.
+func sortOrder(values []any, desc bool) []int {
+	kind := detectSortKind(values)
+
+	type key struct {
+		num     float64
+		str     string
+		missing bool
+	}
+	keys := make([]key, len(values))
+	for i, v := range values {
+		s, ok := sortText(v)
+		if !ok {
+			keys[i] = key{missing: true}
+			continue
+		}
+		switch kind {
+		case sortNumeric:
+			n, _ := parseSortNumber(v)
+			keys[i] = key{num: n}
+		case sortDate:
+			t, _ := parseSortDate(s)
+			keys[i] = key{num: float64(t.Unix())}
+		default:
+			keys[i] = key{str: strings.ToLower(s)}
+		}
+	}
+
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := keys[order[i]], keys[order[j]]
+		if a.missing || b.missing {
+			return !a.missing && b.missing
+		}
+		if desc {
+			a, b = b, a
+		}
+		if kind == sortString {
+			return a.str < b.str
+		}
+		return a.num < b.num
+	})
+	return order
+}
+
+func detectSortKind(values []any) sortKind {
+	numeric, date, seen := true, true, false
+	for _, v := range values {
+		s, ok := sortText(v)
+		if !ok {
+			continue
+		}
+		seen = true
+		if _, ok := parseSortNumber(v); !ok {
+			numeric = false
+		}
+		if _, ok := parseSortDate(s); !ok {
+			date = false
+		}
+	}
+	switch {
+	case !seen:
+		return sortString
+	case numeric:
+		return sortNumeric
+	case date:
+		return sortDate
+	default:
+		return sortString
+	}
+}
+
+// sortText renders a value for comparison; ok is false for empty values.
+func sortText(v any) (string, bool) {
+	switch val := v.(type) {
+	case nil:
+		return "", false
+	case string:
+		s := strings.TrimSpace(val)
+		return s, s != "" && s != "-"
+	case map[string]any, []any:
+		return "", false
+	default:
+		return fmt.Sprint(val), true
+	}
+}
+
+func parseSortNumber(v any) (float64, bool) {
+	switch val := v.(type) {
+	case float64:
+		return val, true
+	case string:
+		n, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(val), ",", ""), 64)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func parseSortDate(s string) (time.Time, bool) {
+	if len(s) < 10 {
+		return time.Time{}, false
+	}
+	t, err := time.Parse("2006-01-02", s[:10])
+	return t, err == nil
+}
+
+// sortItems orders the JSON objects in v by key, or by the computed key of
+// that name when the objects have no such field. Non-list values are
+// returned unchanged.
+func sortItems(v any, column string, desc bool, computed map[string]SortKeyFunc) (any, error) {
+	items, ok := v.([]any)
+	if !ok || len(items) == 0 {
+		return v, nil
+	}
+
+	keys := make(map[string]string)
+	for _, item := range items {
+		if obj, ok := item.(map[string]any); ok {
+			for k := range obj {
+				keys[normalizeColumn(k)] = k
+			}
+		}
+	}
+	if len(keys) == 0 {
+		return v, nil
+	}
+
+	var values []any
+	if key, ok := keys[normalizeColumn(column)]; ok {
+		values = make([]any, len(items))
+		for i, item := range items {
+			if obj, ok := item.(map[string]any); ok {
+				values[i] = obj[key]
+			}
+		}
+	} else {
+		var err error
+		values, ok, err = computedSortValues(computed, column, items)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			for name := range computed {
+				keys[name] = name
+			}
+			return nil, unknownColumnError(column, sortedKeys(keys))
+		}
+	}
+
+	sorted := make([]any, len(items))
+	for i, idx := range sortOrder(values, desc) {
+		sorted[i] = items[idx]
+	}
+	return sorted, nil
+}
@@ -0,0 +1,210 @@
+package outfmt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timestampLayouts are the string formats --normalize-timestamps accepts, in
+// the order they are tried. Layouts without a zone are read as UTC.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// timestampWarning describes a timestamp field that could not be parsed.
+type timestampWarning struct {
+	Path  string
+	Value string
+}
+
+// isTimestampKey reports whether a JSON key names a timestamp field, e.g.
+// created_at, start_date, createdAt, or timestamp.
+func isTimestampKey(key string) bool {
+	if strings.HasSuffix(key, "At") || strings.HasSuffix(key, "Date") {
+		return true
+	}
+	k := normalizeColumn(key)
+	switch k {
+	case "date", "timestamp", "created", "updated":
+		return true
+	}
+	for _, suffix := range []string{"_at", "_date", "_time", "_timestamp"} {
+		if strings.HasSuffix(k, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Plausible Unix epoch ranges for numbers under timestamp keys that are not
+// epoch keys: seconds from 1973 up to the year 5138, and milliseconds from
+// 1973 up to 5138. Smaller numbers (counts, YYYYMMDD dates) are not epochs.
+const (
+	minEpochSeconds = 1e8
+	minEpochMillis  = 1e11
+	maxEpochMillis  = 1e14
+)
+
+// isEpochKey reports whether a JSON key is known to hold Unix epoch numbers,
+// e.g. timestamp or sent_timestamp.
+func isEpochKey(key string) bool {
+	k := normalizeColumn(key)
+	return k == "timestamp" || strings.HasSuffix(k, "_timestamp")
+}
+
+// ParseTimestamp parses a server timestamp: an RFC 3339 or date-only string,
+// or Unix epoch seconds (milliseconds when the value is too large to be
+// seconds) as a number or numeric string. Sub-second precision is kept.
+func ParseTimestamp(v any) (time.Time, bool) {
+	switch val := v.(type) {
+	case json.Number:
+		return parseEpoch(val.String())
+	case float64:
+		return parseEpoch(strconv.FormatFloat(val, 'f', -1, 64))
+	case string:
+		s := strings.TrimSpace(val)
+		for _, layout := range timestampLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, true
+			}
+		}
+		return parseEpoch(s)
+	}
+	return time.Time{}, false
+}
+
+// parseEpoch reads epoch seconds or milliseconds. Plain decimals are split
+// on the point so fractions survive exactly; exponent forms go through
+// float64.
+func parseEpoch(s string) (time.Time, bool) {
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(n) || math.IsInf(n, 0) || n < 0 {
+		return time.Time{}, false
+	}
+	// 1e11 seconds is the year 5138; anything larger is milliseconds.
+	millis := n >= minEpochMillis
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if strings.ContainsAny(s, "eE+") || len(frac) > 9 {
+		if millis {
+			n /= 1000
+		}
+		sec, f := math.Modf(n)
+		return time.Unix(int64(sec), int64(math.Round(f*1e9))), true
+	}
+	w, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if millis {
+		// Milliseconds: the fraction is in units of 1e-3 s.
+		frac = (frac + "000000")[:6]
+		nsFrac, _ := strconv.ParseInt(frac, 10, 64)
+		return time.Unix(w/1000, (w%1000)*int64(time.Millisecond)+nsFrac), true
+	}
+	frac = (frac + "000000000")[:9]
+	ns, _ := strconv.ParseInt(frac, 10, 64)
+	return time.Unix(w, ns), true
+}
+
+// plausibleEpoch reports whether a numeric value under a timestamp key that
+// is not an epoch key looks like an epoch rather than, say, a count.
+func plausibleEpoch(v any) bool {
+	var s string
+	switch val := v.(type) {
+	case json.Number:
+		s = val.String()
+	case string:
+		s = strings.TrimSpace(val)
+	default:
+		return true
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		// Not a number: a date string, parsed by layout.
+		return true
+	}
+	return n >= minEpochSeconds && n < maxEpochMillis
+}
+
+// normalizeTimestamps rewrites recognized timestamp fields in data as RFC 3339
+// UTC strings, keeping any fractional seconds. Numbers are read as epochs
+// only under epoch keys (see isEpochKey) or when they fall in a plausible
+// epoch range. Values that look like timestamps by key but cannot be parsed
+// are left as they are and reported, ordered by path. Empty strings and nulls
+// are left alone silently.
+func normalizeTimestamps(data any) (any, []timestampWarning, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var generic any
+	if err := dec.Decode(&generic); err != nil {
+		return nil, nil, err
+	}
+
+	var warnings []timestampWarning
+	var walk func(v any, path string) any
+	walk = func(v any, path string) any {
+		switch val := v.(type) {
+		case map[string]any:
+			for k, child := range val {
+				childPath := joinPath(path, k)
+				if isTimestampKey(k) && isTimestampCandidate(child) {
+					t, ok := ParseTimestamp(child)
+					if ok && !isEpochKey(k) && !plausibleEpoch(child) {
+						ok = false
+					}
+					if ok {
+						val[k] = t.UTC().Format(time.RFC3339Nano)
+					} else {
+						warnings = append(warnings, timestampWarning{Path: childPath, Value: fmt.Sprint(child)})
+					}
+					continue
+				}
+				val[k] = walk(child, childPath)
+			}
+			return val
+		case []any:
+			for i, item := range val {
+				val[i] = walk(item, joinPath(path, strconv.Itoa(i)))
+			}
+			return val
+		default:
+			return v
+		}
+	}
+	out := walk(generic, "")
+	sort.Slice(warnings, func(i, j int) bool { return warnings[i].Path < warnings[j].Path })
+	return out, warnings, nil
+}
+
+// isTimestampCandidate reports whether v is a scalar worth normalizing.
+func isTimestampCandidate(v any) bool {
+	switch val := v.(type) {
+	case string:
+		return strings.TrimSpace(val) != ""
+	case json.Number:
+		return true
+	}
+	return false
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
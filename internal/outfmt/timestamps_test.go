@@ -0,0 +1,113 @@
+package outfmt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTimestamps_MixedFormats(t *testing.T) {
+	var out, errOut bytes.Buffer
+	f := New(&out, &errOut, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+	f.SetNormalizeTimestamps(true)
+
+	data := []any{
+		map[string]any{"id": "c1", "created_at": 1705314600, "updated_at": "1705314600000"},
+		map[string]any{"id": "c2", "created_at": "2024-01-15T18:30:00+08:00", "startDate": "2024-02-01"},
+		map[string]any{"id": "c3", "created_at": "2024-01-15T10:30:00.5Z", "end_date": nil, "title": "2024-01-15"},
+	}
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, data))
+
+	assert.JSONEq(t, `{"data":[
+		{"id":"c1","created_at":"2024-01-15T10:30:00Z","updated_at":"2024-01-15T10:30:00Z"},
+		{"id":"c2","created_at":"2024-01-15T10:30:00Z","startDate":"2024-02-01T00:00:00Z"},
+		{"id":"c3","created_at":"2024-01-15T10:30:00.5Z","end_date":null,"title":"2024-01-15"}
+	]}`, out.String())
+	assert.Empty(t, errOut.String())
+}
+
+func TestNormalizeTimestamps_UnparseablePassesThroughWithWarning(t *testing.T) {
+	var out, errOut bytes.Buffer
+	f := New(&out, &errOut, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+	f.SetNormalizeTimestamps(true)
+
+	data := map[string]any{"id": "c1", "created_at": "last tuesday", "start_date": ""}
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, data))
+
+	assert.JSONEq(t, `{"data":{"id":"c1","created_at":"last tuesday","start_date":""}}`, out.String())
+	assert.Contains(t, errOut.String(), `created_at="last tuesday"`)
+	assert.NotContains(t, errOut.String(), "start_date")
+}
+
+func TestNormalizeTimestamps_NumbersNeedEpochKeyOrPlausibleRange(t *testing.T) {
+	data := map[string]any{
+		"timestamp":      5,
+		"created_at":     1705314600.25,
+		"sent_at":        "1705314600123",
+		"start_date":     20240115,
+		"processed_date": 42,
+	}
+	out, warnings, err := normalizeTimestamps(data)
+	require.NoError(t, err)
+
+	got := out.(map[string]any)
+	assert.Equal(t, "1970-01-01T00:00:05Z", got["timestamp"], "epoch keys accept any epoch")
+	assert.Equal(t, "2024-01-15T10:30:00.25Z", got["created_at"])
+	assert.Equal(t, "2024-01-15T10:30:00.123Z", got["sent_at"])
+	assert.Equal(t, "20240115", fmt.Sprint(got["start_date"]), "YYYYMMDD is not an epoch")
+	assert.Equal(t, "42", fmt.Sprint(got["processed_date"]))
+
+	require.Len(t, warnings, 2)
+	assert.Equal(t, "processed_date", warnings[0].Path)
+	assert.Equal(t, "start_date", warnings[1].Path)
+}
+
+func TestNormalizeTimestamps_WarningsSortedByPath(t *testing.T) {
+	data := map[string]any{"z_at": "soon", "a_at": "later", "m": map[string]any{"b_at": "never"}}
+	_, warnings, err := normalizeTimestamps(data)
+	require.NoError(t, err)
+	paths := make([]string, len(warnings))
+	for i, w := range warnings {
+		paths[i] = w.Path
+	}
+	assert.Equal(t, []string{"a_at", "m.b_at", "z_at"}, paths)
+}
+
+func TestParseTimestamp_KeepsSubSecondPrecision(t *testing.T) {
+	ts, ok := ParseTimestamp(json.Number("1705314600.123456789"))
+	require.True(t, ok)
+	assert.Equal(t, 123456789, ts.Nanosecond())
+
+	ts, ok = ParseTimestamp("1705314600123.5")
+	require.True(t, ok)
+	assert.Equal(t, int64(1705314600), ts.Unix())
+	assert.Equal(t, 123500000, ts.Nanosecond())
+
+	_, ok = ParseTimestamp("last tuesday")
+	assert.False(t, ok)
+}
+
+func TestNormalizeTimestamps_OffByDefault(t *testing.T) {
+	var out bytes.Buffer
+	f := New(&out, &out, FormatJSON, "never")
+	f.SetPrettyJSON(false)
+
+	require.NoError(t, f.OutputFiltered(context.Background(), func() {}, map[string]any{"created_at": "2024-01-15T18:30:00+08:00"}))
+	assert.JSONEq(t, `{"data":{"created_at":"2024-01-15T18:30:00+08:00"}}`, out.String())
+}
+
+func TestIsTimestampKey(t *testing.T) {
+	for _, key := range []string{"created_at", "start_date", "createdAt", "timestamp", "Date", "sent-at"} {
+		assert.True(t, isTimestampKey(key), key)
+	}
+	for _, key := range []string{"id", "status", "date_of_birth", "format", "amount"} {
+		assert.False(t, isTimestampKey(key), key)
+	}
+}
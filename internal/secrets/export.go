@@ -0,0 +1,220 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/99designs/keyring"
+)
+
+const (
+	exportFormat     = "deel-cli-credentials"
+	exportVersion    = 1
+	exportKDF        = "pbkdf2-sha256"
+	exportCipher     = "aes-256-gcm"
+	exportIterations = 600_000
+)
+
+var (
+	// ErrBadPassphrase is returned by ImportAccounts when the blob cannot be
+	// decrypted, which almost always means the passphrase is wrong.
+	ErrBadPassphrase = errors.New("wrong passphrase or corrupted export")
+	errNoPassphrase  = errors.New("a passphrase is required to encrypt or decrypt an export")
+)
+
+// exportEnvelope is the encrypted blob written by ExportAccounts. Only the
+// ciphertext carries account data; everything else is needed to decrypt it.
+type exportEnvelope struct {
+	Format     string `json:"format"`
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations"`
+	Cipher     string `json:"cipher"`
+	Salt       string `json:"salt"`
+	Nonce      string `json:"nonce"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// exportedAccount is one account inside the decrypted payload.
+type exportedAccount struct {
+	Name      string                     `json:"name"`
+	Token     string                     `json:"token"`
+	CreatedAt time.Time                  `json:"created_at"`
+	Metadata  map[string]string          `json:"metadata,omitempty"`
+	Profiles  map[string]exportedProfile `json:"profiles,omitempty"`
+}
+
+// exportedProfile is one named token of an exported account.
+type exportedProfile struct {
+	Token     string    `json:"token"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportAccounts reads the named accounts from store and returns them as a
+// passphrase-encrypted blob. Tokens only ever leave the store encrypted.
+func ExportAccounts(store Store, names []string, passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, errNoPassphrase
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no accounts to export")
+	}
+
+	accounts := make([]exportedAccount, 0, len(names))
+	for _, name := range names {
+		creds, err := store.Get(name)
+		if err != nil {
+			return nil, fmt.Errorf("read account %q: %w", name, err)
+		}
+		account := exportedAccount{
+			Name:      creds.Name,
+			Token:     creds.Token,
+			CreatedAt: creds.CreatedAt,
+			Metadata:  creds.Metadata,
+		}
+		for profile, p := range creds.Profiles {
+			if account.Profiles == nil {
+				account.Profiles = map[string]exportedProfile{}
+			}
+			account.Profiles[profile] = exportedProfile{Token: p.Token, CreatedAt: p.CreatedAt}
+		}
+		accounts = append(accounts, account)
+	}
+	plaintext, err := json.Marshal(accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	gcm, err := exportCipherFor(passphrase, salt, exportIterations)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	env := exportEnvelope{
+		Format:     exportFormat,
+		Version:    exportVersion,
+		KDF:        exportKDF,
+		Iterations: exportIterations,
+		Cipher:     exportCipher,
+		Salt:       base64.StdEncoding.EncodeToString(salt),
+		Nonce:      base64.StdEncoding.EncodeToString(nonce),
+	}
+	env.Ciphertext = base64.StdEncoding.EncodeToString(gcm.Seal(nil, nonce, plaintext, env.additionalData()))
+	return json.MarshalIndent(env, "", "  ")
+}
+
+// ImportAccounts decrypts blob and writes its accounts into store, returning
+// their names. Nothing is written unless every account can be imported: an
+// account that already exists is an error unless overwrite is set.
+func ImportAccounts(store Store, blob []byte, passphrase string, overwrite bool) ([]string, error) {
+	if passphrase == "" {
+		return nil, errNoPassphrase
+	}
+	var env exportEnvelope
+	if err := json.Unmarshal(blob, &env); err != nil || env.Format != exportFormat {
+		return nil, fmt.Errorf("not a deel credentials export")
+	}
+	if env.Version != exportVersion || env.KDF != exportKDF || env.Cipher != exportCipher {
+		return nil, fmt.Errorf("unsupported export (version %d, %s, %s)", env.Version, env.KDF, env.Cipher)
+	}
+	if env.Iterations < 1 || env.Iterations > 10*exportIterations {
+		return nil, fmt.Errorf("invalid export: %d iterations is out of range", env.Iterations)
+	}
+
+	salt, err1 := base64.StdEncoding.DecodeString(env.Salt)
+	nonce, err2 := base64.StdEncoding.DecodeString(env.Nonce)
+	ciphertext, err3 := base64.StdEncoding.DecodeString(env.Ciphertext)
+	if err := errors.Join(err1, err2, err3); err != nil {
+		return nil, fmt.Errorf("invalid export: %w", err)
+	}
+	gcm, err := exportCipherFor(passphrase, salt, env.Iterations)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, fmt.Errorf("invalid export: bad nonce")
+	}
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, env.additionalData())
+	if err != nil {
+		return nil, ErrBadPassphrase
+	}
+
+	var accounts []exportedAccount
+	if err := json.Unmarshal(plaintext, &accounts); err != nil {
+		return nil, fmt.Errorf("invalid export payload: %w", err)
+	}
+
+	var conflicts []string
+	for _, a := range accounts {
+		if normalize(a.Name) == "" || (a.Token == "" && len(a.Profiles) == 0) {
+			return nil, fmt.Errorf("invalid export: account without a name or token")
+		}
+		if overwrite {
+			continue
+		}
+		if _, err := store.Get(a.Name); err == nil {
+			conflicts = append(conflicts, normalize(a.Name))
+		} else if !errors.Is(err, keyring.ErrKeyNotFound) {
+			return nil, fmt.Errorf("check account %q: %w", a.Name, err)
+		}
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return nil, fmt.Errorf("%w: %s", ErrAccountExists, strings.Join(conflicts, ", "))
+	}
+
+	names := make([]string, 0, len(accounts))
+	for _, a := range accounts {
+		creds := Credentials{Token: a.Token, CreatedAt: a.CreatedAt}
+		for profile, p := range a.Profiles {
+			if creds.Profiles == nil {
+				creds.Profiles = map[string]Profile{}
+			}
+			creds.Profiles[profile] = Profile{Token: p.Token, CreatedAt: p.CreatedAt}
+		}
+		if err := store.Set(a.Name, creds); err != nil {
+			return names, fmt.Errorf("write account %q: %w", a.Name, err)
+		}
+		if err := store.SetMetadata(a.Name, a.Metadata); err != nil {
+			return names, fmt.Errorf("write metadata for %q: %w", a.Name, err)
+		}
+		names = append(names, normalize(a.Name))
+	}
+	return names, nil
+}
+
+// additionalData binds the envelope header to the ciphertext so it cannot be
+// altered (e.g. to weaken the KDF) without failing decryption.
+func (e exportEnvelope) additionalData() []byte {
+	return fmt.Appendf(nil, "%s/%d/%s/%d/%s", e.Format, e.Version, e.KDF, e.Iterations, e.Cipher)
+}
+
+func exportCipherFor(passphrase string, salt []byte, iterations int) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, iterations, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,105 @@
+package secrets
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportAccounts_RoundTrip(t *testing.T) {
+	src := newTestStore()
+	created := time.Date(2026, 2, 3, 4, 5, 6, 0, time.UTC)
+	require.NoError(t, src.Set("prod", Credentials{Token: "tok-prod", CreatedAt: created}))
+	require.NoError(t, src.SetMetadata("prod", map[string]string{"env": "prod"}))
+	require.NoError(t, src.Set("staging", Credentials{Token: "tok-staging"}))
+
+	blob, err := ExportAccounts(src, []string{"prod", "staging"}, "pass phrase")
+	require.NoError(t, err)
+	assert.NotContains(t, string(blob), "tok-prod")
+	assert.NotContains(t, string(blob), "tok-staging")
+
+	dst := newTestStore()
+	names, err := ImportAccounts(dst, blob, "pass phrase", false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"prod", "staging"}, names)
+
+	got, err := dst.Get("prod")
+	require.NoError(t, err)
+	assert.Equal(t, "tok-prod", got.Token)
+	assert.True(t, created.Equal(got.CreatedAt))
+	assert.Equal(t, map[string]string{"env": "prod"}, got.Metadata)
+
+	got, err = dst.Get("staging")
+	require.NoError(t, err)
+	assert.Equal(t, "tok-staging", got.Token)
+}
+
+func TestImportAccounts_WrongPassphrase(t *testing.T) {
+	src := newTestStore()
+	require.NoError(t, src.Set("prod", Credentials{Token: "tok"}))
+	blob, err := ExportAccounts(src, []string{"prod"}, "right")
+	require.NoError(t, err)
+
+	dst := newTestStore()
+	_, err = ImportAccounts(dst, blob, "wrong", false)
+	assert.ErrorIs(t, err, ErrBadPassphrase)
+	keys, err := dst.Keys()
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestImportAccounts_TamperedHeaderFails(t *testing.T) {
+	src := newTestStore()
+	require.NoError(t, src.Set("prod", Credentials{Token: "tok"}))
+	blob, err := ExportAccounts(src, []string{"prod"}, "pw")
+	require.NoError(t, err)
+
+	var env exportEnvelope
+	require.NoError(t, json.Unmarshal(blob, &env))
+	env.Iterations = 1
+	tampered, err := json.Marshal(env)
+	require.NoError(t, err)
+
+	_, err = ImportAccounts(newTestStore(), tampered, "pw", false)
+	assert.ErrorIs(t, err, ErrBadPassphrase)
+}
+
+func TestImportAccounts_ExistingAccount(t *testing.T) {
+	src := newTestStore()
+	require.NoError(t, src.Set("prod", Credentials{Token: "tok-new"}))
+	require.NoError(t, src.Set("dev", Credentials{Token: "tok-dev"}))
+	blob, err := ExportAccounts(src, []string{"dev", "prod"}, "pw")
+	require.NoError(t, err)
+
+	dst := newTestStore()
+	require.NoError(t, dst.Set("prod", Credentials{Token: "tok-old"}))
+
+	_, err = ImportAccounts(dst, blob, "pw", false)
+	assert.ErrorIs(t, err, ErrAccountExists)
+	assert.Contains(t, err.Error(), "prod")
+	_, err = dst.Get("dev")
+	assert.Error(t, err, "nothing is imported when any account conflicts")
+
+	names, err := ImportAccounts(dst, blob, "pw", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev", "prod"}, names)
+	got, err := dst.Get("prod")
+	require.NoError(t, err)
+	assert.Equal(t, "tok-new", got.Token)
+}
+
+func TestExportImportAccounts_RequirePassphrase(t *testing.T) {
+	_, err := ExportAccounts(newTestStore(), []string{"prod"}, "")
+	assert.ErrorIs(t, err, errNoPassphrase)
+	_, err = ImportAccounts(newTestStore(), []byte(`{}`), "", false)
+	assert.ErrorIs(t, err, errNoPassphrase)
+}
+
+func TestImportAccounts_NotAnExport(t *testing.T) {
+	_, err := ImportAccounts(newTestStore(), []byte(`{"hello":"world"}`), "pw", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a deel credentials export")
+}
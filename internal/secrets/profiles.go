@@ -0,0 +1,107 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/99designs/keyring"
+)
+
+// ErrProfileNotFound is returned when an account has no profile of the
+// requested name.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// SplitProfile splits an "account/profile" reference such as "prod/readonly".
+// A reference without a slash names the account only.
+func SplitProfile(ref string) (account, profile string) {
+	account, profile, _ = strings.Cut(ref, "/")
+	return normalize(account), normalize(profile)
+}
+
+// ProfileNames returns the account's profile names in sorted order.
+func (c Credentials) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ForProfile returns the credentials with Token set to the named profile's
+// token. An empty profile selects the default token, or the only profile when
+// the account has no default token.
+func (c Credentials) ForProfile(profile string) (Credentials, error) {
+	profile = normalize(profile)
+	if profile == "" {
+		if c.Token != "" {
+			return c, nil
+		}
+		if len(c.Profiles) != 1 {
+			return Credentials{}, fmt.Errorf("account %q has no default token; pick a profile with --profile (%s)", c.Name, strings.Join(c.ProfileNames(), ", "))
+		}
+		profile = c.ProfileNames()[0]
+	}
+	p, ok := c.Profiles[profile]
+	if !ok {
+		available := "none"
+		if len(c.Profiles) > 0 {
+			available = strings.Join(c.ProfileNames(), ", ")
+		}
+		return Credentials{}, fmt.Errorf("%w: account %q has no profile %q (profiles: %s)", ErrProfileNotFound, c.Name, profile, available)
+	}
+	c.Token, c.CreatedAt, c.Profile = p.Token, p.CreatedAt, profile
+	return c, nil
+}
+
+// SetToken stores token as account's default token, creating the account
+// when it does not exist yet. Profiles and metadata are kept.
+func SetToken(store Store, account, token string) error {
+	account = normalize(account)
+	creds, err := store.Get(account)
+	if err != nil && !errors.Is(err, keyring.ErrKeyNotFound) {
+		return fmt.Errorf("read account %q: %w", account, err)
+	}
+	creds.Token, creds.CreatedAt = token, time.Time{}
+	return store.Set(account, creds)
+}
+
+// SetProfile stores token as the named profile of account, creating the
+// account when it does not exist yet. The default token, other profiles and
+// metadata are kept.
+func SetProfile(store Store, account, profile, token string) error {
+	account, profile = normalize(account), normalize(profile)
+	if account == "" || profile == "" {
+		return fmt.Errorf("missing account or profile name")
+	}
+	creds, err := store.Get(account)
+	if err != nil && !errors.Is(err, keyring.ErrKeyNotFound) {
+		return fmt.Errorf("read account %q: %w", account, err)
+	}
+	if creds.Profiles == nil {
+		creds.Profiles = map[string]Profile{}
+	}
+	creds.Profiles[profile] = Profile{Token: token, CreatedAt: time.Now().UTC()}
+	return store.Set(account, creds)
+}
+
+// DeleteProfile removes the named profile from account. The account itself is
+// deleted when that leaves it with no token at all.
+func DeleteProfile(store Store, account, profile string) error {
+	account, profile = normalize(account), normalize(profile)
+	creds, err := store.Get(account)
+	if err != nil {
+		return err
+	}
+	if _, ok := creds.Profiles[profile]; !ok {
+		return fmt.Errorf("%w: account %q has no profile %q", ErrProfileNotFound, account, profile)
+	}
+	delete(creds.Profiles, profile)
+	if creds.Token == "" && len(creds.Profiles) == 0 {
+		return store.Delete(account)
+	}
+	return store.Set(account, creds)
+}
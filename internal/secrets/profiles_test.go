@@ -0,0 +1,122 @@
+package secrets
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/99designs/keyring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitProfile(t *testing.T) {
+	account, profile := SplitProfile("Prod/ReadOnly")
+	assert.Equal(t, "prod", account)
+	assert.Equal(t, "readonly", profile)
+
+	account, profile = SplitProfile("prod")
+	assert.Equal(t, "prod", account)
+	assert.Empty(t, profile)
+}
+
+func TestProfiles_SingleTokenUnchanged(t *testing.T) {
+	store := newTestStore()
+	require.NoError(t, store.Set("prod", Credentials{Token: "tok"}))
+
+	creds, err := store.Get("prod")
+	require.NoError(t, err)
+	assert.Nil(t, creds.Profiles)
+
+	resolved, err := creds.ForProfile("")
+	require.NoError(t, err)
+	assert.Equal(t, "tok", resolved.Token)
+	assert.Empty(t, resolved.Profile)
+
+	_, err = creds.ForProfile("admin")
+	assert.True(t, errors.Is(err, ErrProfileNotFound), "err = %v", err)
+}
+
+func TestSetProfile_KeepsDefaultTokenAndMetadata(t *testing.T) {
+	store := newTestStore()
+	require.NoError(t, store.Set("prod", Credentials{Token: "tok-default"}))
+	require.NoError(t, store.SetMetadata("prod", map[string]string{"env": "prod"}))
+
+	require.NoError(t, SetProfile(store, "prod", "ReadOnly", "tok-ro"))
+	require.NoError(t, SetProfile(store, "prod", "admin", "tok-admin"))
+
+	creds, err := store.Get("prod")
+	require.NoError(t, err)
+	assert.Equal(t, "tok-default", creds.Token)
+	assert.Equal(t, map[string]string{"env": "prod"}, creds.Metadata)
+	assert.Equal(t, []string{"admin", "readonly"}, creds.ProfileNames())
+
+	ro, err := creds.ForProfile("readonly")
+	require.NoError(t, err)
+	assert.Equal(t, "tok-ro", ro.Token)
+	assert.Equal(t, "readonly", ro.Profile)
+
+	def, err := creds.ForProfile("")
+	require.NoError(t, err)
+	assert.Equal(t, "tok-default", def.Token)
+}
+
+func TestSetProfile_NewAccountWithoutDefault(t *testing.T) {
+	store := newTestStore()
+	require.NoError(t, SetProfile(store, "prod", "readonly", "tok-ro"))
+
+	creds, err := store.Get("prod")
+	require.NoError(t, err)
+	assert.Empty(t, creds.Token)
+
+	// The only profile is picked when there is no default token.
+	only, err := creds.ForProfile("")
+	require.NoError(t, err)
+	assert.Equal(t, "tok-ro", only.Token)
+
+	require.NoError(t, SetProfile(store, "prod", "admin", "tok-admin"))
+	creds, err = store.Get("prod")
+	require.NoError(t, err)
+	_, err = creds.ForProfile("")
+	assert.ErrorContains(t, err, "pick a profile with --profile (admin, readonly)")
+}
+
+func TestDeleteProfile(t *testing.T) {
+	store := newTestStore()
+	require.NoError(t, SetProfile(store, "prod", "readonly", "tok-ro"))
+	require.NoError(t, SetProfile(store, "prod", "admin", "tok-admin"))
+
+	require.NoError(t, DeleteProfile(store, "prod", "admin"))
+	creds, err := store.Get("prod")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"readonly"}, creds.ProfileNames())
+
+	assert.True(t, errors.Is(DeleteProfile(store, "prod", "admin"), ErrProfileNotFound))
+
+	// Removing the last token removes the account.
+	require.NoError(t, DeleteProfile(store, "prod", "readonly"))
+	_, err = store.Get("prod")
+	assert.True(t, errors.Is(err, keyring.ErrKeyNotFound))
+}
+
+func TestRenameAndExport_KeepProfiles(t *testing.T) {
+	store := newTestStore()
+	require.NoError(t, store.Set("prod", Credentials{Token: "tok"}))
+	require.NoError(t, SetProfile(store, "prod", "readonly", "tok-ro"))
+
+	require.NoError(t, Rename(store, "prod", "production"))
+	creds, err := store.Get("production")
+	require.NoError(t, err)
+	assert.Equal(t, "tok-ro", creds.Profiles["readonly"].Token)
+
+	blob, err := ExportAccounts(store, []string{"production"}, "pass")
+	require.NoError(t, err)
+	assert.NotContains(t, string(blob), "tok-ro")
+
+	dst := newTestStore()
+	_, err = ImportAccounts(dst, blob, "pass", false)
+	require.NoError(t, err)
+	got, err := dst.Get("production")
+	require.NoError(t, err)
+	assert.Equal(t, "tok", got.Token)
+	assert.Equal(t, "tok-ro", got.Profiles["readonly"].Token)
+}
@@ -37,6 +37,7 @@ type Store interface {
 	Get(name string) (Credentials, error)
 	Delete(name string) error
 	List() ([]Credentials, error)
+	SetMetadata(name string, metadata map[string]string) error
 }
 
 // KeyringStore implements Store using the OS keychain
@@ -49,39 +50,115 @@ type Credentials struct {
 	Name      string    `json:"name"`
 	Token     string    `json:"-"`
 	CreatedAt time.Time `json:"created_at"`
+	// Metadata holds free-form, non-secret annotations (environment, org name,
+	// notes). It is stored in its own keyring item, separate from the token.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Profiles holds extra named tokens for the same account, e.g. separate
+	// read-only and admin tokens. An account may have a default Token,
+	// profiles, or both.
+	Profiles map[string]Profile `json:"profiles,omitempty"`
+	// Profile names the profile whose token is in Token when the credentials
+	// were resolved with ForProfile; empty for the default token.
+	Profile string `json:"profile,omitempty"`
+}
+
+// Profile is one named token of an account.
+type Profile struct {
+	Token     string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type storedCredentials struct {
+	Token     string                   `json:"token,omitempty"`
+	CreatedAt time.Time                `json:"created_at"`
+	Profiles  map[string]storedProfile `json:"profiles,omitempty"`
+}
+
+type storedProfile struct {
 	Token     string    `json:"token"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// Backend selects where credentials are stored.
+type Backend string
+
+const (
+	// BackendAuto uses the OS keychain and falls back to the encrypted file
+	// store when no keychain is available.
+	BackendAuto Backend = "auto"
+	// BackendKeychain requires the OS keychain.
+	BackendKeychain Backend = "keychain"
+	// BackendFile uses the passphrase-encrypted file store under the config dir.
+	BackendFile Backend = "file"
+)
+
+// ParseBackend converts a --store value. An empty value means BackendAuto.
+func ParseBackend(value string) (Backend, error) {
+	switch b := Backend(strings.ToLower(strings.TrimSpace(value))); b {
+	case "":
+		return BackendAuto, nil
+	case BackendAuto, BackendKeychain, BackendFile:
+		return b, nil
+	}
+	return "", fmt.Errorf("invalid store %q (must be 'auto', 'keychain', or 'file')", value)
+}
+
+var (
+	backendMu sync.Mutex
+	backend   = BackendAuto
+)
+
+// SetBackend selects the backend OpenDefault uses (see --store).
+func SetBackend(b Backend) {
+	backendMu.Lock()
+	defer backendMu.Unlock()
+	backend = b
+}
+
+func currentBackend() Backend {
+	backendMu.Lock()
+	defer backendMu.Unlock()
+	return backend
+}
+
 // OpenDefault opens the default keyring store
 func OpenDefault() (Store, error) {
-	ring, err := openKeyring(runtime.GOOS, os.Getenv("DBUS_SESSION_BUS_ADDRESS"))
+	ring, err := openKeyring(runtime.GOOS, os.Getenv("DBUS_SESSION_BUS_ADDRESS"), currentBackend(), keyring.Open)
 	if err != nil {
 		return nil, err
 	}
 	return &KeyringStore{ring: ring}, nil
 }
 
-func openKeyring(goos string, dbusAddr string) (keyring.Keyring, error) {
+// openKeyring opens the keyring for b. With BackendAuto the OS keychain is
+// tried first and the encrypted file store is used when it cannot be opened
+// (or straight away on headless Linux, where there is no keychain to try).
+func openKeyring(goos string, dbusAddr string, b Backend, open func(keyring.Config) (keyring.Keyring, error)) (keyring.Keyring, error) {
 	keyringDir, err := ensureKeyringDir()
 	if err != nil {
 		return nil, fmt.Errorf("ensure keyring dir: %w", err)
 	}
 
-	var allowedBackends []keyring.BackendType
-	if shouldForceFileBackend(goos, dbusAddr) {
-		allowedBackends = []keyring.BackendType{keyring.FileBackend}
-	}
-
-	ring, err := keyring.Open(keyring.Config{
+	cfg := keyring.Config{
 		ServiceName:      config.AppName,
-		AllowedBackends:  allowedBackends,
 		FileDir:          keyringDir,
 		FilePasswordFunc: fileKeyringPasswordFunc(),
-	})
+	}
+	fileOnly := []keyring.BackendType{keyring.FileBackend}
+
+	switch {
+	case b == BackendFile, b == BackendAuto && shouldForceFileBackend(goos, dbusAddr):
+		cfg.AllowedBackends = fileOnly
+	default:
+		cfg.AllowedBackends = systemBackends()
+	}
+
+	ring, err := open(cfg)
+	if err != nil && b == BackendAuto && cfg.AllowedBackends[0] != keyring.FileBackend {
+		slog.Debug("keychain unavailable, using encrypted file store", "error", err)
+		cfg.AllowedBackends = fileOnly
+		ring, err = open(cfg)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("open keyring: %w", err)
 	}
@@ -89,6 +166,22 @@ func openKeyring(goos string, dbusAddr string) (keyring.Keyring, error) {
 	return ring, nil
 }
 
+// systemBackends lists the available OS keychain backends, excluding the
+// encrypted file store. The slice is never empty so callers can tell it apart
+// from "any backend"; an unavailable backend just fails to open.
+func systemBackends() []keyring.BackendType {
+	var out []keyring.BackendType
+	for _, b := range keyring.AvailableBackends() {
+		if b != keyring.FileBackend {
+			out = append(out, b)
+		}
+	}
+	if len(out) == 0 {
+		out = []keyring.BackendType{keyring.SecretServiceBackend}
+	}
+	return out
+}
+
 func ensureKeyringDir() (string, error) {
 	keyringDir, err := resolveKeyringDir()
 	if err != nil {
@@ -151,8 +244,14 @@ func shouldForceFileBackend(goos string, dbusAddr string) bool {
 	return goos == "linux" && strings.TrimSpace(dbusAddr) == ""
 }
 
+// fileKeyringPasswordFunc reads the file store passphrase from
+// DEEL_STORE_PASSPHRASE, or the older DEEL_KEYRING_PASSWORD, prompting on a
+// terminal when neither is set.
 func fileKeyringPasswordFunc() keyring.PromptFunc {
-	password, passwordSet := os.LookupEnv(keyringPasswordEnv)
+	password, passwordSet := os.LookupEnv(config.EnvStorePassphrase)
+	if !passwordSet {
+		password, passwordSet = os.LookupEnv(keyringPasswordEnv)
+	}
 	return fileKeyringPasswordFuncFrom(password, passwordSet, term.IsTerminal(int(os.Stdin.Fd())))
 }
 
@@ -167,7 +266,7 @@ func fileKeyringPasswordFuncFrom(password string, passwordSet bool, isTTY bool)
 	}
 
 	return func(_ string) (string, error) {
-		return "", fmt.Errorf("%w; set %s", errNoTTY, keyringPasswordEnv)
+		return "", fmt.Errorf("%w; set %s", errNoTTY, config.EnvStorePassphrase)
 	}
 }
 
@@ -182,17 +281,32 @@ func (s *KeyringStore) Set(name string, creds Credentials) error {
 	if name == "" {
 		return fmt.Errorf("missing account name")
 	}
-	if creds.Token == "" {
+	if creds.Token == "" && len(creds.Profiles) == 0 {
 		return fmt.Errorf("missing token")
 	}
 	if creds.CreatedAt.IsZero() {
 		creds.CreatedAt = time.Now().UTC()
 	}
 
-	payload, err := json.Marshal(storedCredentials{
+	stored := storedCredentials{
 		Token:     creds.Token,
 		CreatedAt: creds.CreatedAt,
-	})
+	}
+	for profile, p := range creds.Profiles {
+		profile = normalize(profile)
+		if profile == "" || p.Token == "" {
+			return fmt.Errorf("profile of account %q is missing a name or token", name)
+		}
+		if p.CreatedAt.IsZero() {
+			p.CreatedAt = creds.CreatedAt
+		}
+		if stored.Profiles == nil {
+			stored.Profiles = map[string]storedProfile{}
+		}
+		stored.Profiles[profile] = storedProfile{Token: p.Token, CreatedAt: p.CreatedAt}
+	}
+
+	payload, err := json.Marshal(stored)
 	if err != nil {
 		return err
 	}
@@ -218,10 +332,22 @@ func (s *KeyringStore) Get(name string) (Credentials, error) {
 		return Credentials{}, err
 	}
 
+	metadata, err := s.getMetadata(name)
+	if err != nil {
+		return Credentials{}, err
+	}
+
 	creds := Credentials{
 		Name:      name,
 		Token:     stored.Token,
 		CreatedAt: stored.CreatedAt,
+		Metadata:  metadata,
+	}
+	for profile, p := range stored.Profiles {
+		if creds.Profiles == nil {
+			creds.Profiles = map[string]Profile{}
+		}
+		creds.Profiles[profile] = Profile{Token: p.Token, CreatedAt: p.CreatedAt}
 	}
 
 	// Warn if credentials are older than 90 days (backwards compatible with zero time)
@@ -241,7 +367,55 @@ func (s *KeyringStore) Delete(name string) error {
 	if name == "" {
 		return fmt.Errorf("missing account name")
 	}
-	return s.ring.Remove(credentialKey(name))
+	if err := s.ring.Remove(credentialKey(name)); err != nil {
+		return err
+	}
+	if err := s.ring.Remove(metadataKey(name)); err != nil && !errors.Is(err, keyring.ErrKeyNotFound) {
+		return err
+	}
+	return nil
+}
+
+// SetMetadata replaces the metadata for an existing account. An empty map
+// clears it. The token is left untouched.
+func (s *KeyringStore) SetMetadata(name string, metadata map[string]string) error {
+	name = normalize(name)
+	if name == "" {
+		return fmt.Errorf("missing account name")
+	}
+	if _, err := s.ring.Get(credentialKey(name)); err != nil {
+		return err
+	}
+	if len(metadata) == 0 {
+		if err := s.ring.Remove(metadataKey(name)); err != nil && !errors.Is(err, keyring.ErrKeyNotFound) {
+			return err
+		}
+		return nil
+	}
+
+	payload, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	return s.ring.Set(keyring.Item{
+		Key:  metadataKey(name),
+		Data: payload,
+	})
+}
+
+func (s *KeyringStore) getMetadata(name string) (map[string]string, error) {
+	item, err := s.ring.Get(metadataKey(name))
+	if errors.Is(err, keyring.ErrKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var metadata map[string]string
+	if err := json.Unmarshal(item.Data, &metadata); err != nil {
+		return nil, fmt.Errorf("decode metadata for account %q: %w", name, err)
+	}
+	return metadata, nil
 }
 
 // List returns all stored credentials
@@ -265,6 +439,62 @@ func (s *KeyringStore) List() ([]Credentials, error) {
 	return out, nil
 }
 
+// ErrNotFound is returned by Get when no account of that name is stored.
+var ErrNotFound = keyring.ErrKeyNotFound
+
+// ErrAccountExists is returned by Rename when the new name is already taken.
+var ErrAccountExists = errors.New("account already exists")
+
+// Rename moves the credentials and metadata stored under oldName to newName.
+// The new entry is written and verified before the old one is deleted, so a
+// failure partway through never loses the token: if writing the new entry
+// fails it is rolled back, and if deleting the old entry fails both remain.
+func Rename(store Store, oldName, newName string) error {
+	oldName, newName = normalize(oldName), normalize(newName)
+	if oldName == "" || newName == "" {
+		return fmt.Errorf("missing account name")
+	}
+	if oldName == newName {
+		return fmt.Errorf("account %q already has that name", oldName)
+	}
+
+	creds, err := store.Get(oldName)
+	if err != nil {
+		return fmt.Errorf("read account %q: %w", oldName, err)
+	}
+	if _, err := store.Get(newName); err == nil {
+		return fmt.Errorf("%w: %q", ErrAccountExists, newName)
+	} else if !errors.Is(err, keyring.ErrKeyNotFound) {
+		return fmt.Errorf("check account %q: %w", newName, err)
+	}
+
+	rollback := func(cause error) error {
+		if err := store.Delete(newName); err != nil && !errors.Is(err, keyring.ErrKeyNotFound) {
+			return fmt.Errorf("%w (cleanup of %q also failed: %v)", cause, newName, err)
+		}
+		return cause
+	}
+	if err := store.Set(newName, creds); err != nil {
+		return rollback(fmt.Errorf("write account %q: %w", newName, err))
+	}
+	if len(creds.Metadata) > 0 {
+		if err := store.SetMetadata(newName, creds.Metadata); err != nil {
+			return rollback(fmt.Errorf("write metadata for %q: %w", newName, err))
+		}
+	}
+	if moved, err := store.Get(newName); err != nil || moved.Token != creds.Token {
+		if err == nil {
+			err = errors.New("token mismatch")
+		}
+		return rollback(fmt.Errorf("verify account %q: %w", newName, err))
+	}
+
+	if err := store.Delete(oldName); err != nil {
+		return fmt.Errorf("account copied to %q but removing %q failed (both now exist): %w", newName, oldName, err)
+	}
+	return nil
+}
+
 // ParseCredentialKey extracts the account name from a credential key
 func ParseCredentialKey(k string) (name string, ok bool) {
 	const prefix = "account:"
@@ -282,6 +512,10 @@ func credentialKey(name string) string {
 	return fmt.Sprintf("account:%s", name)
 }
 
+func metadataKey(name string) string {
+	return fmt.Sprintf("metadata:%s", name)
+}
+
 func normalize(s string) string {
 	return strings.ToLower(strings.TrimSpace(s))
 }
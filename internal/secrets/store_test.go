@@ -1,6 +1,8 @@
 package secrets
 
 import (
+	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -157,6 +159,143 @@ func TestStore_SetDefaultsCreatedAt(t *testing.T) {
 	assert.False(t, got.CreatedAt.After(after))
 }
 
+func TestStore_SetMetadata(t *testing.T) {
+	s := newTestStore()
+	require.NoError(t, s.Set("acct", Credentials{Token: "tok"}))
+
+	require.NoError(t, s.SetMetadata("ACCT", map[string]string{"env": "prod", "org": "Acme"}))
+
+	got, err := s.Get("acct")
+	require.NoError(t, err)
+	assert.Equal(t, "tok", got.Token)
+	assert.Equal(t, map[string]string{"env": "prod", "org": "Acme"}, got.Metadata)
+
+	// Metadata lives in its own item; the secret payload is unchanged.
+	item, err := s.ring.Get(credentialKey("acct"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(item.Data), "Acme")
+	meta, err := s.ring.Get(metadataKey("acct"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(meta.Data), "tok")
+}
+
+func TestStore_SetMetadataKeepsTokenOnReSet(t *testing.T) {
+	s := newTestStore()
+	require.NoError(t, s.Set("acct", Credentials{Token: "tok"}))
+	require.NoError(t, s.SetMetadata("acct", map[string]string{"env": "prod"}))
+	require.NoError(t, s.Set("acct", Credentials{Token: "tok-2"}))
+
+	got, err := s.Get("acct")
+	require.NoError(t, err)
+	assert.Equal(t, "tok-2", got.Token)
+	assert.Equal(t, "prod", got.Metadata["env"])
+}
+
+func TestStore_SetMetadataClear(t *testing.T) {
+	s := newTestStore()
+	require.NoError(t, s.Set("acct", Credentials{Token: "tok"}))
+	require.NoError(t, s.SetMetadata("acct", map[string]string{"env": "prod"}))
+	require.NoError(t, s.SetMetadata("acct", nil))
+
+	got, err := s.Get("acct")
+	require.NoError(t, err)
+	assert.Empty(t, got.Metadata)
+}
+
+func TestStore_SetMetadataUnknownAccount(t *testing.T) {
+	s := newTestStore()
+	assert.Error(t, s.SetMetadata("missing", map[string]string{"env": "prod"}))
+}
+
+func TestStore_DeleteRemovesMetadata(t *testing.T) {
+	s := newTestStore()
+	require.NoError(t, s.Set("acct", Credentials{Token: "tok"}))
+	require.NoError(t, s.SetMetadata("acct", map[string]string{"env": "prod"}))
+	require.NoError(t, s.Delete("acct"))
+
+	keys, err := s.Keys()
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestStore_ListIncludesMetadataWithoutToken(t *testing.T) {
+	s := newTestStore()
+	require.NoError(t, s.Set("alpha", Credentials{Token: "secret-token"}))
+	require.NoError(t, s.SetMetadata("alpha", map[string]string{"env": "staging"}))
+
+	list, err := s.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "staging", list[0].Metadata["env"])
+
+	out, err := json.Marshal(list)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `"metadata":{"env":"staging"}`)
+	assert.NotContains(t, string(out), "secret-token")
+}
+
+func TestRename(t *testing.T) {
+	s := newTestStore()
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, s.Set("stagin", Credentials{Token: "tok", CreatedAt: created}))
+	require.NoError(t, s.SetMetadata("stagin", map[string]string{"env": "staging"}))
+
+	require.NoError(t, Rename(s, "stagin", "Staging"))
+
+	got, err := s.Get("staging")
+	require.NoError(t, err)
+	assert.Equal(t, "tok", got.Token)
+	assert.True(t, created.Equal(got.CreatedAt))
+	assert.Equal(t, map[string]string{"env": "staging"}, got.Metadata)
+
+	_, err = s.Get("stagin")
+	assert.ErrorIs(t, err, keyring.ErrKeyNotFound)
+}
+
+func TestRename_NewNameExists(t *testing.T) {
+	s := newTestStore()
+	require.NoError(t, s.Set("old", Credentials{Token: "tok-old"}))
+	require.NoError(t, s.Set("new", Credentials{Token: "tok-new"}))
+
+	err := Rename(s, "old", "new")
+	assert.ErrorIs(t, err, ErrAccountExists)
+
+	got, err := s.Get("new")
+	require.NoError(t, err)
+	assert.Equal(t, "tok-new", got.Token)
+	got, err = s.Get("old")
+	require.NoError(t, err)
+	assert.Equal(t, "tok-old", got.Token)
+}
+
+func TestRename_MissingOld(t *testing.T) {
+	err := Rename(newTestStore(), "ghost", "new")
+	assert.ErrorIs(t, err, keyring.ErrKeyNotFound)
+}
+
+// failingMetadataStore fails SetMetadata so Rename has to roll back.
+type failingMetadataStore struct{ *KeyringStore }
+
+func (failingMetadataStore) SetMetadata(string, map[string]string) error {
+	return errors.New("keychain locked")
+}
+
+func TestRename_FailureKeepsOldAccount(t *testing.T) {
+	inner := newTestStore()
+	require.NoError(t, inner.Set("old", Credentials{Token: "tok"}))
+	require.NoError(t, inner.SetMetadata("old", map[string]string{"env": "prod"}))
+
+	err := Rename(failingMetadataStore{inner}, "old", "new")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "keychain locked")
+
+	got, err := inner.Get("old")
+	require.NoError(t, err)
+	assert.Equal(t, "tok", got.Token)
+	_, err = inner.Get("new")
+	assert.ErrorIs(t, err, keyring.ErrKeyNotFound)
+}
+
 func TestShouldForceFileBackend(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -196,7 +335,84 @@ func TestFileKeyringPasswordFuncFrom_NoTTY(t *testing.T) {
 	require.Error(t, err)
 	assert.Empty(t, password)
 	assert.ErrorIs(t, err, errNoTTY)
-	assert.Contains(t, err.Error(), keyringPasswordEnv)
+	assert.Contains(t, err.Error(), config.EnvStorePassphrase)
+}
+
+func TestParseBackend(t *testing.T) {
+	for in, want := range map[string]Backend{"": BackendAuto, "auto": BackendAuto, "Keychain": BackendKeychain, " file ": BackendFile} {
+		got, err := ParseBackend(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	_, err := ParseBackend("vault")
+	assert.Error(t, err)
+}
+
+// recordingOpen records the backends each open attempt allowed and fails
+// every attempt that does not allow only the file backend when failSystem is set.
+func recordingOpen(failSystem bool, attempts *[][]keyring.BackendType) func(keyring.Config) (keyring.Keyring, error) {
+	return func(cfg keyring.Config) (keyring.Keyring, error) {
+		*attempts = append(*attempts, cfg.AllowedBackends)
+		if failSystem && !(len(cfg.AllowedBackends) == 1 && cfg.AllowedBackends[0] == keyring.FileBackend) {
+			return nil, keyring.ErrNoAvailImpl
+		}
+		return keyring.NewArrayKeyring(nil), nil
+	}
+}
+
+func TestOpenKeyring_AutoFallsBackToFile(t *testing.T) {
+	t.Setenv(config.EnvCredentialsDir, t.TempDir())
+	var attempts [][]keyring.BackendType
+
+	_, err := openKeyring("darwin", "", BackendAuto, recordingOpen(true, &attempts))
+	require.NoError(t, err)
+	require.Len(t, attempts, 2)
+	assert.NotContains(t, attempts[0], keyring.FileBackend)
+	assert.Equal(t, []keyring.BackendType{keyring.FileBackend}, attempts[1])
+}
+
+func TestOpenKeyring_KeychainDoesNotFallBack(t *testing.T) {
+	t.Setenv(config.EnvCredentialsDir, t.TempDir())
+	var attempts [][]keyring.BackendType
+
+	_, err := openKeyring("darwin", "", BackendKeychain, recordingOpen(true, &attempts))
+	require.Error(t, err)
+	assert.Len(t, attempts, 1)
+}
+
+func TestOpenKeyring_FileForced(t *testing.T) {
+	t.Setenv(config.EnvCredentialsDir, t.TempDir())
+	var attempts [][]keyring.BackendType
+
+	_, err := openKeyring("linux", "unix:path=/run/user/1000/bus", BackendFile, recordingOpen(false, &attempts))
+	require.NoError(t, err)
+	assert.Equal(t, [][]keyring.BackendType{{keyring.FileBackend}}, attempts)
+}
+
+func TestOpenKeyring_FileStoreRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(config.EnvCredentialsDir, dir)
+	t.Setenv(config.EnvStorePassphrase, "correct horse")
+
+	ring, err := openKeyring("linux", "", BackendFile, keyring.Open)
+	require.NoError(t, err)
+	s := &KeyringStore{ring: ring}
+	require.NoError(t, s.Set("ci", Credentials{Token: "tok-ci"}))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	for _, e := range entries {
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "tok-ci", "token must be encrypted at rest")
+	}
+
+	reopened, err := openKeyring("linux", "", BackendFile, keyring.Open)
+	require.NoError(t, err)
+	got, err := (&KeyringStore{ring: reopened}).Get("ci")
+	require.NoError(t, err)
+	assert.Equal(t, "tok-ci", got.Token)
 }
 
 func TestEnsureKeyringDir(t *testing.T) {